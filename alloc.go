@@ -0,0 +1,104 @@
+package base58
+
+import (
+	"math/bits"
+	"sync"
+)
+
+/*
+Buffer Allocation
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+An Encoding normally allocates its scratch and output buffers with make.
+With an Allocator, those buffers come from Get. Scratch buffers go back
+through Put as soon as they are done with, so latency-sensitive services can
+recycle them or keep them off the GC heap:
+
+	enc := base58.StdEncoding.WithAllocator(base58.NewPoolAllocator())
+
+Buffers returned to the caller, such as the result of EncodeToBytes or
+DecodeToBytes, also come from Get and are never Put. The caller may Put them
+when done. Strings returned by EncodeToString are copies, as Go strings
+always are. An arena-backed allocator (GOEXPERIMENT=arenas) implements Get
+with arena.MakeSlice and makes Put a no-op. Stream decoders take their
+number and output buffers from the allocator too and return them once
+drained or closed. The constant-time functions keep their own buffers.
+*/
+
+// source of byte buffers for an Encoding
+type Allocator interface {
+	// return a buffer of length n, with arbitrary contents
+	Get(n int) []byte
+	// take back a buffer from Get that is no longer used
+	Put(b []byte)
+}
+
+// return a copy of enc that takes its buffers from a, nil restores make.
+// The allocator does not change the encoding, so Equal ignores it and it
+// is not serialized.
+func (enc *Encoding) WithAllocator(a Allocator) *Encoding {
+	e := *enc
+	e.allocator = a
+	return &e
+}
+
+// return the allocator of enc, nil if buffers come from make
+func (enc *Encoding) Allocator() Allocator {
+	return enc.allocator
+}
+
+// return a buffer of length n from the allocator of enc
+func (enc *Encoding) get(n int) []byte {
+	return getBuffer(enc.allocator, n)
+}
+
+// return a buffer of length n from a, or from make if a is nil or returns
+// too small a buffer
+func getBuffer(a Allocator, n int) []byte {
+	if a != nil {
+		if b := a.Get(n); cap(b) >= n {
+			return b[:n]
+		}
+	}
+	return make([]byte, n)
+}
+
+// return b to a if not nil
+func putBuffer(a Allocator, b []byte) {
+	if a != nil && cap(b) > 0 {
+		a.Put(b)
+	}
+}
+
+// Allocator recycling buffers through sync.Pools of power-of-two size
+// classes, safe for concurrent use
+type PoolAllocator struct {
+	pools [bits.UintSize]sync.Pool
+}
+
+// return a new PoolAllocator
+func NewPoolAllocator() *PoolAllocator {
+	return new(PoolAllocator)
+}
+
+// return a pooled buffer of length n
+func (p *PoolAllocator) Get(n int) []byte {
+	class := bits.Len(uint(max(n, 1) - 1))
+	if b, ok := p.pools[class].Get().(*[]byte); ok {
+		return (*b)[:n]
+	}
+	return make([]byte, n, 1<<class)
+}
+
+// return b to the pool of its size class
+func (p *PoolAllocator) Put(b []byte) {
+	if cap(b) == 0 {
+		return
+	}
+	// the largest class whose buffers b can hold
+	class := bits.Len(uint(cap(b))) - 1
+	b = b[:0]
+	p.pools[class].Put(&b)
+}
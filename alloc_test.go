@@ -0,0 +1,185 @@
+package base58_test
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+// allocator handing out garbage-filled buffers and checking that only
+// its own outstanding buffers come back
+type countingAllocator struct {
+	t    *testing.T
+	mu   sync.Mutex
+	gets int
+	puts int
+	ends map[*byte]bool
+}
+
+func newCountingAllocator(t *testing.T) *countingAllocator {
+	return &countingAllocator{t: t, ends: make(map[*byte]bool)}
+}
+
+func (a *countingAllocator) Get(n int) []byte {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.gets++
+	b := bytes.Repeat([]byte{0xff}, n+1)
+	a.ends[&b[n]] = true
+	return b[:n]
+}
+
+func (a *countingAllocator) Put(b []byte) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.puts++
+	b = b[:cap(b)]
+	end := &b[len(b)-1]
+	if !a.ends[end] {
+		a.t.Error("Put received a buffer that is not outstanding")
+	}
+	delete(a.ends, end)
+}
+
+func (a *countingAllocator) outstanding() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.gets - a.puts
+}
+
+func TestWithAllocator(t *testing.T) {
+	skipDash := func(c byte, pos int) (byte, bool, error) { return 0, c == '_', nil }
+	encodings := []*base58.Encoding{
+		base58.StdEncoding,
+		base58.StdEncoding.WithPadWidth(50),
+		base58.StdEncoding.WithSeparator('-', 4).WithWrap(16),
+		base58.RippleEncoding.Lenient().WithIgnore("_"),
+		base58.StdEncoding.WithInvalidHandler(skipDash),
+		base58.StdEncoding.Secure().WithSeparator('-', 4),
+		base58.StdEncoding.WithMaxDecodedLen(64),
+	}
+	inputs := [][]byte{
+		{},
+		{0},
+		{0, 0, 1, 2, 3},
+		append([]byte{0, 0}, bytes.Repeat([]byte{0xa5}, 32)...),
+	}
+	for _, plain := range encodings {
+		alloc := newCountingAllocator(t)
+		enc := plain.WithAllocator(alloc)
+		testEqual(t, "WithAllocator Equal = %v, want %v", plain.Equal(plain.WithAllocator(nil)), enc.Equal(plain))
+		testEqual(t, "Allocator = %v, want %v", base58.Allocator(alloc), enc.Allocator())
+		for _, in := range inputs {
+			want := plain.EncodeToString(in)
+			plainDecoded, err := plain.DecodeString(want)
+			if err != nil {
+				t.Fatalf("DecodeString(%q): %v", want, err)
+			}
+
+			encoded := enc.EncodeToBytes(in)
+			testEqual(t, "EncodeToBytes = %q, want %q", want, string(encoded))
+			testEqual(t, "EncodeToBytes outstanding = %v, want %v", 1, alloc.outstanding())
+			alloc.Put(encoded)
+
+			src := []byte(want)
+			decoded, err := enc.DecodeToBytes(src)
+			if err != nil {
+				t.Fatalf("DecodeToBytes(%q): %v", want, err)
+			}
+			testEqual(t, "DecodeToBytes = %q, want %q", string(plainDecoded), string(decoded))
+			testEqual(t, "DecodeToBytes input = %q, want %q", want, string(src))
+			testEqual(t, "DecodeToBytes outstanding = %v, want %v", 1, alloc.outstanding())
+			alloc.Put(decoded)
+
+			decoded, err = enc.DecodeString(want)
+			if err != nil {
+				t.Fatalf("DecodeString(%q): %v", want, err)
+			}
+			testEqual(t, "DecodeString = %q, want %q", string(plainDecoded), string(decoded))
+			alloc.Put(decoded)
+
+			dst := make([]byte, len(plainDecoded)+1)
+			n, err := enc.Decode(dst, src)
+			testEqual(t, "Decode error = %v, want %v", nil, err)
+			testEqual(t, "Decode = %q, want %q", string(plainDecoded), string(dst[:n]))
+			testEqual(t, "Decode outstanding = %v, want %v", 0, alloc.outstanding())
+		}
+		if _, err := enc.DecodeString("0OIl"); err == nil {
+			t.Error("DecodeString accepted invalid input")
+		}
+		testEqual(t, "failed decode outstanding = %v, want %v", 0, alloc.outstanding())
+		if alloc.gets == 0 {
+			t.Error("allocator was never used")
+		}
+	}
+}
+
+func TestWithAllocatorNil(t *testing.T) {
+	enc := base58.StdEncoding.WithAllocator(newCountingAllocator(t)).WithAllocator(nil)
+	testEqual(t, "Allocator = %v, want %v", nil, enc.Allocator())
+	testEqual(t, "EncodeToString = %q, want %q", "112", enc.EncodeToString([]byte{0, 0, 1}))
+}
+
+func TestPoolAllocator(t *testing.T) {
+	p := base58.NewPoolAllocator()
+	for _, n := range []int{0, 1, 7, 8, 9, 100, 4096} {
+		b := p.Get(n)
+		testEqual(t, "Get len = %v, want %v", n, len(b))
+		if cap(b) < n {
+			t.Errorf("Get(%d) has capacity %d", n, cap(b))
+		}
+		p.Put(b)
+	}
+	p.Put(make([]byte, 5, 13))
+	if b := p.Get(8); cap(b) < 8 {
+		t.Errorf("Get(8) has capacity %d", cap(b))
+	}
+
+	enc := base58.StdEncoding.WithAllocator(p)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			in := bytes.Repeat([]byte{byte(i)}, 10+i)
+			for j := 0; j < 100; j++ {
+				encoded := enc.EncodeToBytes(in)
+				decoded, err := enc.DecodeToBytes(encoded)
+				if err != nil || !bytes.Equal(decoded, in) {
+					t.Errorf("round trip of %x: %x, %v", in, decoded, err)
+					return
+				}
+				p.Put(encoded)
+				p.Put(decoded)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestDecodeStringAllocs(t *testing.T) {
+	// without an allocator only the result is allocated
+	s := base58.StdEncoding.EncodeToString([]byte("ok"))
+	if n := testing.AllocsPerRun(100, func() { base58.StdEncoding.DecodeString(s) }); n > 1 {
+		t.Errorf("DecodeString(%q) made %v allocations, want 1", s, n)
+	}
+}
+
+func BenchmarkDecodeStringAllocs(b *testing.B) {
+	s := base58.StdEncoding.EncodeToString([]byte("ok"))
+	encodings := map[string]*base58.Encoding{
+		"default": base58.StdEncoding,
+		"pool":    base58.StdEncoding.WithAllocator(new(base58.PoolAllocator)),
+		"secure":  base58.StdEncoding.Secure(),
+	}
+	for name, enc := range encodings {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				enc.DecodeString(s)
+			}
+		})
+	}
+}
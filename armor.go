@@ -0,0 +1,157 @@
+package base58
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"sort"
+	"strings"
+)
+
+/*
+ASCII Armor
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+A self-describing text block for config files and backup blobs, modeled
+after PEM and OpenPGP armor:
+
+	-----BEGIN BASE58 BACKUP-----
+	Version: 1
+
+	2ukVBARx4fMCUZXaHR1XvNbb3HgzmGYFEEThDa86tN2q8oU
+	=98mwg
+	-----END BASE58 BACKUP-----
+
+The payload is StdEncoding wrapped at ArmorLineLen columns, the "=" line
+holds the base58 CRC-32 (IEEE) of the decoded payload.
+*/
+
+// characters per payload line in armored output
+const ArmorLineLen = 64
+
+const (
+	armorBegin = "-----BEGIN BASE58"
+	armorEnd   = "-----END BASE58"
+	armorDash  = "-----"
+)
+
+// armored block is malformed
+var ErrInvalidArmor = errors.New("base58: invalid armor")
+
+// decoded armor block
+type Armor struct {
+	Type    string            // block type after "BASE58", may be empty
+	Headers map[string]string // optional key-value headers
+	Data    []byte            // decoded payload
+}
+
+var armorEncoding = StdEncoding.WithWrap(ArmorLineLen)
+
+// wrap data in an armored text block with optional type and headers,
+// headers are written in sorted key order
+func EncodeArmor(blockType string, headers map[string]string, data []byte) (string, error) {
+	if strings.ContainsAny(blockType, "-\r\n") {
+		return "", fmt.Errorf("%w: bad type %q", ErrInvalidArmor, blockType)
+	}
+	keys := make([]string, 0, len(headers))
+	for k, v := range headers {
+		if k == "" || strings.ContainsAny(k, ":\r\n") || strings.ContainsAny(v, "\r\n") {
+			return "", fmt.Errorf("%w: bad header %q", ErrInvalidArmor, k)
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	label := armorLabel(blockType)
+	var sb strings.Builder
+	sb.WriteString(armorBegin + label + armorDash + "\n")
+	for _, k := range keys {
+		sb.WriteString(k + ": " + headers[k] + "\n")
+	}
+	if len(keys) > 0 {
+		sb.WriteString("\n")
+	}
+	if len(data) > 0 {
+		sb.WriteString(armorEncoding.EncodeToString(data) + "\n")
+	}
+	sb.WriteString("=" + StdEncoding.EncodeToString(armorChecksum(data)) + "\n")
+	sb.WriteString(armorEnd + label + armorDash + "\n")
+	return sb.String(), nil
+}
+
+// parse an armored text block, verifying its checksum
+func DecodeArmor(s string) (*Armor, error) {
+	lines := strings.Split(strings.ReplaceAll(strings.TrimSpace(s), "\r\n", "\n"), "\n")
+	if len(lines) < 3 {
+		return nil, fmt.Errorf("%w: too few lines", ErrInvalidArmor)
+	}
+
+	first, last := lines[0], lines[len(lines)-1]
+	if !strings.HasPrefix(first, armorBegin) || !strings.HasSuffix(first, armorDash) {
+		return nil, fmt.Errorf("%w: missing BEGIN line", ErrInvalidArmor)
+	}
+	label := strings.TrimSuffix(strings.TrimPrefix(first, armorBegin), armorDash)
+	if label != "" && label[0] != ' ' {
+		return nil, fmt.Errorf("%w: missing BEGIN line", ErrInvalidArmor)
+	}
+	if last != armorEnd+label+armorDash {
+		return nil, fmt.Errorf("%w: END line does not match BEGIN", ErrInvalidArmor)
+	}
+	a := &Armor{Type: strings.TrimPrefix(label, " ")}
+	lines = lines[1 : len(lines)-1]
+
+	// headers run until the first blank line, if any
+	if len(lines) > 0 && strings.Contains(lines[0], ":") {
+		a.Headers = make(map[string]string)
+		for len(lines) > 0 && lines[0] != "" {
+			k, v, ok := strings.Cut(lines[0], ":")
+			if !ok {
+				return nil, fmt.Errorf("%w: bad header line %q", ErrInvalidArmor, lines[0])
+			}
+			a.Headers[strings.TrimSpace(k)] = strings.TrimSpace(v)
+			lines = lines[1:]
+		}
+		if len(lines) == 0 {
+			return nil, fmt.Errorf("%w: missing blank line after headers", ErrInvalidArmor)
+		}
+		lines = lines[1:]
+	}
+
+	if len(lines) == 0 || !strings.HasPrefix(lines[len(lines)-1], "=") {
+		return nil, fmt.Errorf("%w: missing checksum line", ErrInvalidArmor)
+	}
+	sum, err := StdEncoding.DecodeString(lines[len(lines)-1][1:])
+	if err != nil {
+		return nil, fmt.Errorf("%w: bad checksum line", ErrInvalidArmor)
+	}
+
+	var payload strings.Builder
+	for _, line := range lines[:len(lines)-1] {
+		payload.WriteString(strings.TrimSpace(line))
+	}
+	if payload.Len() > 0 {
+		if a.Data, err = StdEncoding.DecodeString(payload.String()); err != nil {
+			return nil, err
+		}
+	}
+	if string(sum) != string(armorChecksum(a.Data)) {
+		return nil, ErrChecksumMismatch
+	}
+	return a, nil
+}
+
+// " TYPE" or "" for an untyped block
+func armorLabel(blockType string) string {
+	if blockType == "" {
+		return ""
+	}
+	return " " + blockType
+}
+
+// big-endian CRC-32 (IEEE) of data
+func armorChecksum(data []byte) []byte {
+	return binary.BigEndian.AppendUint32(nil, crc32.ChecksumIEEE(data))
+}
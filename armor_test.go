@@ -0,0 +1,70 @@
+package base58_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestArmor(t *testing.T) {
+	want := "-----BEGIN BASE58 BACKUP-----\n" +
+		"Version: 1\n" +
+		"\n" +
+		bigtest.encoded + "\n" +
+		"=98mwg\n" +
+		"-----END BASE58 BACKUP-----\n"
+	s, err := base58.EncodeArmor("BACKUP", map[string]string{"Version": "1"}, []byte(bigtest.decoded))
+	if err != nil {
+		t.Fatalf("EncodeArmor failed: %v", err)
+	}
+	testEqual(t, "EncodeArmor: got %q, want %q", want, s)
+
+	a, err := base58.DecodeArmor(strings.ReplaceAll(want, "\n", "\r\n"))
+	if err != nil {
+		t.Fatalf("DecodeArmor failed: %v", err)
+	}
+	testEqual(t, "DecodeArmor type: got %q, want %q", "BACKUP", a.Type)
+	testEqual(t, "DecodeArmor header: got %q, want %q", "1", a.Headers["Version"])
+	testEqual(t, "DecodeArmor data: got %q, want %q", bigtest.decoded, string(a.Data))
+
+	// long payloads wrap at ArmorLineLen
+	data := []byte(strings.Repeat(bigtest.decoded, 4))
+	s, _ = base58.EncodeArmor("", nil, data)
+	for _, line := range strings.Split(s, "\n") {
+		if len(line) > base58.ArmorLineLen {
+			t.Errorf("EncodeArmor: line of %d characters exceeds %d", len(line), base58.ArmorLineLen)
+		}
+	}
+	a, err = base58.DecodeArmor(s)
+	if err != nil || string(a.Data) != string(data) || a.Type != "" || a.Headers != nil {
+		t.Errorf("DecodeArmor(untyped): got %+v, %v", a, err)
+	}
+}
+
+func TestArmorErrors(t *testing.T) {
+	if _, err := base58.EncodeArmor("BAD\nTYPE", nil, nil); !errors.Is(err, base58.ErrInvalidArmor) {
+		t.Errorf("EncodeArmor(bad type): got %v, want %v", err, base58.ErrInvalidArmor)
+	}
+	if _, err := base58.EncodeArmor("", map[string]string{"a:b": "c"}, nil); !errors.Is(err, base58.ErrInvalidArmor) {
+		t.Errorf("EncodeArmor(bad header): got %v, want %v", err, base58.ErrInvalidArmor)
+	}
+
+	good, _ := base58.EncodeArmor("KEY", nil, []byte("sure."))
+	tests := []struct {
+		in   string
+		want error
+	}{
+		{"", base58.ErrInvalidArmor},
+		{strings.Replace(good, "BEGIN", "START", 1), base58.ErrInvalidArmor},
+		{strings.Replace(good, "END BASE58 KEY", "END BASE58 OTHER", 1), base58.ErrInvalidArmor},
+		{strings.Replace(good, "=", "", 1), base58.ErrInvalidArmor},
+		{strings.Replace(good, "E2XFRyo", "E2XFRyp", 1), base58.ErrChecksumMismatch},
+	}
+	for _, tt := range tests {
+		if _, err := base58.DecodeArmor(tt.in); !errors.Is(err, tt.want) {
+			t.Errorf("DecodeArmor(%q): got %v, want %v", tt.in, err, tt.want)
+		}
+	}
+}
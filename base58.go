@@ -2,8 +2,14 @@ package base58
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"io"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 )
 
 /*
@@ -31,13 +37,57 @@ changelog:
 	stable release
 */
 
-// standard base58 alphabet used in Bitcoin
-const BitcoinAlphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+const (
+	// standard base58 alphabet used in Bitcoin
+	BitcoinAlphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	// base58 alphabet used in Ripple (XRP) addresses and keys
+	RippleAlphabet = "rpshnaf39wBUDNEGHJKLM4PQRST7VWXYZ2bcdeCg65jkm8oFqi1tuvAxyz"
+	// base58 alphabet used in Flickr short URLs, lowercase before uppercase
+	FlickrAlphabet = "123456789abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ"
+	// base58 digits used by GMP mpz_get_str/mpz_set_str: 0-9, A-Z, a-v
+	GMPAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuv"
+)
+
+// largest supported radix, the printable non-space ASCII characters
+const maxRadix = 94
 
-// radix-58 encoding/decoding scheme
+// radix-58 (or arbitrary radix) encoding/decoding scheme
 type Encoding struct {
-	encode  [58]byte
+	encode  [maxRadix]byte
 	reverse [256]int8
+	base    int
+	name    string
+
+	padWidth  int  // fixed encoded width, 0 for none
+	padSize   int  // decoded bytes of a padded encoding
+	sep       byte // group separator
+	groupSize int  // characters per separated group, 0 for none
+
+	ignore    [256]bool // characters skipped on decode
+	hasIgnore bool
+
+	lineLen int  // encoded characters per line, 0 for no wrapping
+	lenient bool // skip ASCII whitespace on decode
+
+	onInvalid InvalidCharFunc // recovery policy for invalid characters
+
+	validators []Validator // checks run on decoded data, nil for none
+
+	zero byte // character for leading zero bytes, 0 for encode[0]
+
+	secure bool // clear scratch buffers before returning
+
+	littleEndian bool // payload is a little-endian number
+
+	maxInput   int // maximum input length on decode, 0 for none
+	maxDecoded int // maximum decoded length, 0 for none
+
+	instr Instrumentation // event hook, nil for none
+	trace *slog.Logger    // debug logger, nil for none
+
+	progress ProgressFunc // stream progress callback, nil for none
+
+	allocator Allocator // buffer source, nil for make
 }
 
 // encode with 58-char alphabet
@@ -45,48 +95,180 @@ func NewEncoding(alphabet string) *Encoding {
 	if len(alphabet) != 58 {
 		panic("base58 alphabet must be 58 characters")
 	}
-	enc := new(Encoding)
-	for i := 0; i < 58; i++ {
-		enc.encode[i] = alphabet[i]
+	return newEncoding(alphabet)
+}
+
+// encode with an alphabet of 2 to 94 unique printable ASCII characters,
+// the radix is the alphabet length
+func NewRadixEncoding(alphabet string) *Encoding {
+	if len(alphabet) < 2 || len(alphabet) > maxRadix {
+		panic("base58: radix alphabet must be 2 to 94 characters")
 	}
+	for i := 0; i < len(alphabet); i++ {
+		if alphabet[i] <= ' ' || alphabet[i] > '~' {
+			panic("base58: radix alphabet must be printable non-space ASCII")
+		}
+		if strings.IndexByte(alphabet[:i], alphabet[i]) >= 0 {
+			panic("base58: radix alphabet contains duplicate characters")
+		}
+	}
+	return newEncoding(alphabet)
+}
+
+// encode with alphabet and its precomputed decode table, where decode[c]
+// is the digit value of c or -1, as emitted by cmd/base58gen, and give it
+// a display name. The tables are only checked against each other instead
+// of being derived. Panics if they disagree or the alphabet is invalid.
+func NewEncodingTables(name, alphabet string, decode *[256]int8) *Encoding {
+	if len(alphabet) < 2 || len(alphabet) > maxRadix {
+		panic("base58: radix alphabet must be 2 to 94 characters")
+	}
+	for i := 0; i < len(alphabet); i++ {
+		if alphabet[i] <= ' ' || alphabet[i] > '~' {
+			panic("base58: radix alphabet must be printable non-space ASCII")
+		}
+	}
+	valid := 0
+	for c, v := range decode {
+		if v == -1 {
+			continue
+		}
+		if int(v) >= len(alphabet) || int(alphabet[v]) != c {
+			panic("base58: decode table does not match alphabet")
+		}
+		valid++
+	}
+	if valid != len(alphabet) {
+		panic("base58: decode table does not match alphabet")
+	}
+	enc := &Encoding{base: len(alphabet), reverse: *decode, name: name}
+	copy(enc.encode[:], alphabet)
+	return enc
+}
+
+func newEncoding(alphabet string) *Encoding {
+	enc := new(Encoding)
+	enc.base = len(alphabet)
+	copy(enc.encode[:], alphabet)
 	for i := 0; i < 256; i++ {
 		enc.reverse[i] = -1
 	}
-	for i := 0; i < 58; i++ {
+	for i := 0; i < enc.base; i++ {
 		enc.reverse[alphabet[i]] = int8(i)
 	}
 	return enc
 }
 
-// std bitcoin base58 encoding
-var StdEncoding = NewEncoding(BitcoinAlphabet)
+var (
+	// std bitcoin base58 encoding
+	StdEncoding = newNamedEncoding("bitcoin", BitcoinAlphabet)
+	// ripple (XRP) base58 encoding
+	RippleEncoding = newNamedEncoding("ripple", RippleAlphabet)
+	// flickr short URL base58 encoding
+	FlickrEncoding = newNamedEncoding("flickr", FlickrAlphabet)
+	// gmp base58 encoding, leading '0' digits map to leading zero bytes
+	GMPEncoding = newNamedEncoding("gmp", GMPAlphabet)
+)
+
+// encode with 58-char alphabet and attach a display name
+func newNamedEncoding(name, alphabet string) *Encoding {
+	enc := NewEncoding(alphabet)
+	enc.name = name
+	return enc
+}
+
+// return the alphabet backing enc
+func (enc *Encoding) Alphabet() string {
+	return string(enc.encode[:enc.base])
+}
+
+// return the radix of enc, 58 unless created with NewRadixEncoding
+func (enc *Encoding) Radix() int {
+	return enc.base
+}
+
+// return the name of a predefined encoding, or "custom"
+func (enc *Encoding) Name() string {
+	if enc.name == "" {
+		return "custom"
+	}
+	return enc.name
+}
+
+// return a human-readable description of enc
+func (enc *Encoding) String() string {
+	if enc.name == "" {
+		return "base" + strconv.Itoa(enc.base) + "(custom:" + enc.Alphabet() + ")"
+	}
+	return "base" + strconv.Itoa(enc.base) + "(" + enc.name + ")"
+}
 
-// encode src to base58 and write to dst
+// report whether enc and other encode and decode identically
+func (enc *Encoding) Equal(other *Encoding) bool {
+	if enc == nil || other == nil || enc == other {
+		return enc == other
+	}
+	// handlers and validators cannot be compared
+	if enc.onInvalid != nil || other.onInvalid != nil || enc.HasValidators() || other.HasValidators() {
+		return false
+	}
+	return enc.base == other.base && enc.encode == other.encode &&
+		enc.padWidth == other.padWidth &&
+		enc.sep == other.sep && enc.groupSize == other.groupSize &&
+		enc.ignore == other.ignore && enc.lineLen == other.lineLen &&
+		enc.lenient == other.lenient && enc.zero == other.zero &&
+		enc.secure == other.secure && enc.littleEndian == other.littleEndian &&
+		enc.maxInput == other.maxInput && enc.maxDecoded == other.maxDecoded
+}
+
+// encode src to base58 and write to dst, which may overlap src since the
+// encoding is complete before it is copied
 func (enc *Encoding) Encode(dst, src []byte) int {
 	s := enc.EncodeToBytes(src)
 	copy(dst, s)
+	enc.release(s)
 	return len(s)
 }
 
 // return base58 encoding as bytes
 func (enc *Encoding) EncodeToBytes(src []byte) []byte {
-	zeros := 0
-	for zeros < len(src) && src[zeros] == 0 {
-		zeros++
-	}
-	input := make([]byte, len(src))
-	copy(input, src)
-	var b58 []byte
-	for len(input) > 0 && !allZero(input) {
-		var remainder int
-		input, remainder = divmod(input, 58)
-		b58 = append(b58, byte(remainder))
-	}
-	for i := 0; i < zeros; i++ {
-		b58 = append(b58, 0)
-	}
-	reverseBytes(b58)
+	start := enc.traceStart()
+	num := enc.bigEndian(src)
+	var encoded []byte
+	if enc.padWidth > 0 {
+		encoded = enc.encodeWidth(num, enc.padWidth)
+	} else {
+		encoded = enc.encodeDigits(num)
+	}
+	if enc.littleEndian {
+		enc.release(num)
+	}
+	if enc.groupSize > 0 {
+		grouped := enc.group(encoded)
+		enc.retire(encoded, grouped)
+		encoded = grouped
+	}
+	if enc.lineLen > 0 {
+		wrapped := enc.wrap(encoded)
+		enc.retire(encoded, wrapped)
+		encoded = wrapped
+	}
+	enc.observeEncode(len(src))
+	enc.traceDone("encode", enc.radixPath(), start, len(src), len(encoded), nil)
+	return encoded
+}
+
+// convert src to base58 digits and map them to the alphabet
+func (enc *Encoding) encodeDigits(src []byte) []byte {
+	b58, _ := convertRadixContext(context.Background(), enc.allocator, src, 256, enc.base)
+	zero := enc.zeroDigit()
+	leading := true
 	for i, v := range b58 {
+		if leading && v == 0 {
+			b58[i] = zero
+			continue
+		}
+		leading = false
 		b58[i] = enc.encode[v]
 	}
 	return b58
@@ -94,49 +276,244 @@ func (enc *Encoding) EncodeToBytes(src []byte) []byte {
 
 // return base58 encoding as string
 func (enc *Encoding) EncodeToString(src []byte) string {
-	return string(enc.EncodeToBytes(src))
+	encoded := enc.EncodeToBytes(src)
+	defer enc.release(encoded)
+	return string(encoded)
 }
 
-// decode src from base58 and write to dst
+// decode src from base58 and write to dst, which may overlap src since
+// the result is complete before it is copied
 func (enc *Encoding) Decode(dst, src []byte) (int, error) {
 	res, err := enc.DecodeToBytes(src)
 	if err != nil {
 		return 0, err
 	}
 	copy(dst, res)
+	enc.release(res)
 	return len(res), nil
 }
 
 // decode src from base58 to bytes
 func (enc *Encoding) DecodeToBytes(src []byte) ([]byte, error) {
+	start := enc.traceStart()
+	decoded, err := enc.observeDecode(enc.validated(enc.decodeToBytes(context.Background(), src)))
+	enc.traceDone("decode", enc.radixPath(), start, len(src), len(decoded), err)
+	return decoded, err
+}
+
+// report whether enc has neither an allocator nor Secure, so scratch
+// buffers need no bookkeeping
+func (enc *Encoding) unpooled() bool {
+	return enc.allocator == nil && !enc.secure
+}
+
+// DecodeToBytes for unpooled encodings. Scratch buffers come from make and are
+// never released, which keeps src and short intermediate buffers off the
+// heap.
+func (enc *Encoding) decodePlain(src []byte) ([]byte, error) {
+	start := enc.traceStart()
+	inLen := len(src)
+	decoded, err := enc.decodePlainDigits(src)
+	decoded, err = enc.observeDecode(enc.validated(decoded, err))
+	enc.traceDone("decode", enc.radixPath(), start, inLen, len(decoded), err)
+	return decoded, err
+}
+
+func (enc *Encoding) decodePlainDigits(src []byte) ([]byte, error) {
+	if err := enc.checkInputLen(len(src)); err != nil {
+		return nil, err
+	}
+	if enc.onInvalid != nil {
+		substituted, err := enc.substituteInvalid(src)
+		if err != nil {
+			return nil, err
+		}
+		src = substituted
+	}
+	if enc.groupSize > 0 || enc.hasIgnore || enc.lineLen > 0 || enc.lenient {
+		src = enc.stripIgnored(src)
+	}
+	if enc.padWidth > 0 {
+		if err := enc.checkPadCount(len(src), len(src) > 0 && enc.isZeroDigit(src[0])); err != nil {
+			return nil, err
+		}
+	}
+	decoded, err := enc.decodeDigitsPlain(src)
+	if err != nil {
+		return nil, err
+	}
+	if enc.padWidth > 0 {
+		decoded = enc.padDecoded(decoded)
+	}
+	if enc.littleEndian {
+		reverseBytes(decoded)
+	}
+	return decoded, nil
+}
+
+func (enc *Encoding) decodeToBytes(ctx context.Context, src []byte) ([]byte, error) {
+	if err := enc.checkInputLen(len(src)); err != nil {
+		return nil, err
+	}
+	// scratch copy of src, nil while src is the caller's buffer
+	var owned []byte
+	defer func() { enc.release(owned) }()
+	if enc.secure {
+		// work on an owned copy so every intermediate buffer can be cleared
+		owned = enc.get(len(src))
+		copy(owned, src)
+		src = owned
+	}
+	if enc.onInvalid != nil {
+		substituted, err := enc.substituteInvalid(src)
+		if err != nil {
+			return nil, err
+		}
+		if !sameBuffer(src, substituted) {
+			enc.release(owned)
+			owned, src = substituted, substituted
+		}
+	}
+	if enc.groupSize > 0 || enc.hasIgnore || enc.lineLen > 0 || enc.lenient {
+		stripped := enc.stripIgnored(src)
+		if !sameBuffer(src, stripped) {
+			enc.release(owned)
+			owned, src = stripped, stripped
+		}
+	}
+	if enc.padWidth > 0 {
+		if err := enc.checkPadCount(len(src), len(src) > 0 && enc.isZeroDigit(src[0])); err != nil {
+			return nil, err
+		}
+	}
+	decoded, err := enc.decodeDigits(ctx, src)
+	if err != nil {
+		return nil, err
+	}
+	if enc.padWidth > 0 {
+		decoded = enc.padDecoded(decoded)
+	}
+	if enc.littleEndian {
+		reverseBytes(decoded)
+	}
+	return decoded, nil
+}
+
+// map src to base58 digits and convert them to bytes
+func (enc *Encoding) decodeDigits(ctx context.Context, src []byte) ([]byte, error) {
+	digits := enc.get(len(src))
+	defer enc.release(digits)
+	if err := enc.mapDigits(digits, src); err != nil {
+		return nil, err
+	}
+	if err := enc.checkMinDecodedLen(digits); err != nil {
+		return nil, err
+	}
+	decoded, err := convertRadixContext(ctx, enc.allocator, digits, enc.base, 256)
+	if err != nil {
+		return nil, err
+	}
+	if err := enc.checkDecodedLen(decoded); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+// decodeDigits with buffers from make, for unpooled encodings
+func (enc *Encoding) decodeDigitsPlain(src []byte) ([]byte, error) {
 	digits := make([]byte, len(src))
+	if err := enc.mapDigits(digits, src); err != nil {
+		return nil, err
+	}
+	if err := enc.checkMinDecodedLen(digits); err != nil {
+		return nil, err
+	}
+	decoded := convertRadix(digits, enc.base, 256)
+	if err := enc.checkDecodedLen(decoded); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+// write the digit values of the characters of src to digits
+func (enc *Encoding) mapDigits(digits, src []byte) error {
+	leading := true
 	for i, c := range src {
 		val := enc.reverse[c]
+		if leading && enc.zero != 0 && c == enc.zero {
+			val = 0
+		} else if val != 0 {
+			leading = false
+		}
 		if val == -1 {
-			return nil, errors.New("base58: invalid character")
+			return errInvalidCharacter
 		}
 		digits[i] = byte(val)
 	}
-	zeros := 0
-	for zeros < len(digits) && digits[zeros] == 0 {
-		zeros++
+	return nil
+}
+
+// character outside the alphabet, where the offset is not reported
+var errInvalidCharacter = errors.New("base58: invalid character")
+
+// invalid character at the given input byte offset
+type CorruptInputError int64
+
+func (e CorruptInputError) Error() string {
+	return "base58: illegal data at input byte " + strconv.FormatInt(int64(e), 10)
+}
+
+// decode the longest valid base58 prefix of src, returning the decoded
+// bytes and the number of input bytes consumed. If src contains an
+// invalid character, err is a CorruptInputError holding its offset.
+// Characters skipped by the encoding's options are consumed; fixed pad
+// width is not enforced.
+func (enc *Encoding) DecodePrefix(src []byte) (decoded []byte, consumed int, err error) {
+	start := enc.traceStart()
+	defer func() {
+		enc.observeDecode(decoded, err)
+		enc.traceDone("decode", "prefix", start, len(src), len(decoded), err)
+	}()
+	if err := enc.checkInputLen(len(src)); err != nil {
+		return nil, 0, err
+	}
+	digits := enc.get(len(src))[:0]
+	for consumed < len(src) {
+		c := src[consumed]
+		if enc.zero != 0 && c == enc.zero && allZero(digits) {
+			digits = append(digits, 0)
+		} else if val := enc.reverse[c]; val != -1 {
+			digits = append(digits, byte(val))
+		} else if !enc.ignored(c) {
+			err = CorruptInputError(consumed)
+			break
+		}
+		consumed++
+	}
+	decoded, _ = convertRadixContext(context.Background(), enc.allocator, digits, enc.base, 256)
+	enc.release(digits)
+	if enc.padWidth > 0 {
+		decoded = enc.padDecoded(decoded)
 	}
-	var b256 []byte
-	for len(digits) > 0 && !allZero(digits) {
-		var remainder int
-		digits, remainder = divmod58(digits, 256)
-		b256 = append(b256, byte(remainder))
+	if enc.littleEndian {
+		reverseBytes(decoded)
 	}
-	for i := 0; i < zeros; i++ {
-		b256 = append(b256, 0)
+	if lerr := enc.checkDecodedLen(decoded); lerr != nil {
+		return nil, 0, lerr
 	}
-	reverseBytes(b256)
-	return b256, nil
+	return decoded, consumed, err
 }
 
 // decode s from base58
 func (enc *Encoding) DecodeString(s string) ([]byte, error) {
-	return enc.DecodeToBytes([]byte(s))
+	if enc.unpooled() {
+		// the copy and scratch buffers of short strings stay on the stack
+		return enc.decodePlain([]byte(s))
+	}
+	src := enc.get(len(s))
+	copy(src, s)
+	defer enc.release(src)
+	return enc.DecodeToBytes(src)
 }
 
 // check if all bytes are zero
@@ -149,6 +526,14 @@ func allZero(b []byte) bool {
 	return true
 }
 
+// return b without its leading zero bytes
+func trimLeadingZeros(b []byte) []byte {
+	for len(b) > 0 && b[0] == 0 {
+		b = b[1:]
+	}
+	return b
+}
+
 // reverse bytes in place
 func reverseBytes(b []byte) {
 	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
@@ -156,83 +541,422 @@ func reverseBytes(b []byte) {
 	}
 }
 
-// divmod for base256 number
-func divmod(number []byte, divisor int) ([]byte, int) {
+// divide number, with digits in the given base, by divisor in place and
+// return the remainder
+func divmod(number []byte, base, divisor int) int {
+	// base58 conversions divide by constants, which the compiler turns into
+	// multiplications instead of hardware division
+	switch {
+	case base == 256 && divisor == 58:
+		return divmod256by58(number)
+	case base == 58 && divisor == 256:
+		return divmod58by256(number)
+	}
 	var remainder int
-	quotient := make([]byte, 0, len(number))
-	for _, digit := range number {
-		accumulator := int(digit) + remainder*256
-		quotientDigit := accumulator / divisor
+	for i, digit := range number {
+		accumulator := int(digit) + remainder*base
+		number[i] = byte(accumulator / divisor)
 		remainder = accumulator % divisor
-		if len(quotient) > 0 || quotientDigit != 0 {
-			quotient = append(quotient, byte(quotientDigit))
-		}
 	}
-	return quotient, remainder
+	return remainder
 }
 
-// divmod for base58 number
-func divmod58(number []byte, divisor int) ([]byte, int) {
-	var remainder int
-	quotient := make([]byte, 0, len(number))
-	for _, digit := range number {
-		accumulator := int(digit) + remainder*58
-		quotientDigit := accumulator / divisor
-		remainder = accumulator % divisor
-		if len(quotient) > 0 || quotientDigit != 0 {
-			quotient = append(quotient, byte(quotientDigit))
-		}
+// divmod of base-256 digits by 58
+func divmod256by58(number []byte) int {
+	var remainder uint
+	for i, digit := range number {
+		accumulator := uint(digit) + remainder<<8
+		number[i] = byte(accumulator / 58)
+		remainder = accumulator % 58
+	}
+	return int(remainder)
+}
+
+// divmod of base-58 digits by 256
+func divmod58by256(number []byte) int {
+	var remainder uint
+	for i, digit := range number {
+		accumulator := uint(digit) + remainder*58
+		number[i] = byte(accumulator >> 8)
+		remainder = accumulator & 0xff
 	}
-	return quotient, remainder
+	return int(remainder)
 }
 
+// write to an encoder after Close
+var ErrClosed = errors.New("base58: write to closed encoder")
+
+// read from a decoder after Close
+var ErrDecoderClosed = errors.New("base58: read from closed decoder")
+
 type encoder struct {
-	enc *Encoding
-	w   io.Writer
-	buf bytes.Buffer
+	enc    *Encoding
+	w      io.Writer
+	buf    bytes.Buffer
+	closed bool
+	err    error
 }
 
 // buffer data
 func (e *encoder) Write(p []byte) (int, error) {
-	return e.buf.Write(p)
+	if e.closed {
+		return 0, ErrClosed
+	}
+	n, err := e.buf.Write(p)
+	e.progressed()
+	return n, err
+}
+
+// buffer s, implementing io.StringWriter
+func (e *encoder) WriteString(s string) (int, error) {
+	if e.closed {
+		return 0, ErrClosed
+	}
+	n, err := e.buf.WriteString(s)
+	e.progressed()
+	return n, err
 }
 
-// encode and write buffered data
+// buffer c, implementing io.ByteWriter
+func (e *encoder) WriteByte(c byte) error {
+	if e.closed {
+		return ErrClosed
+	}
+	err := e.buf.WriteByte(c)
+	e.progressed()
+	return err
+}
+
+// encode and write buffered data. Close is idempotent, later calls return
+// the result of the first.
 func (e *encoder) Close() error {
+	if e.closed {
+		return e.err
+	}
+	e.closed = true
+	n := int64(e.buf.Len())
 	encoded := e.enc.EncodeToBytes(e.buf.Bytes())
-	_, err := e.w.Write(encoded)
-	return err
+	e.buf.Reset()
+	_, e.err = e.w.Write(encoded)
+	if e.err == nil && e.enc.progress != nil {
+		e.enc.progress(n, n)
+	}
+	return e.err
 }
 
-// base58 stream encoder
+// report the bytes buffered so far to the progress callback
+func (e *encoder) progressed() {
+	if e.enc.progress != nil {
+		e.enc.progress(int64(e.buf.Len()), -1)
+	}
+}
+
+// base58 stream encoder, writes after Close fail with ErrClosed. The
+// encoder also implements io.StringWriter and io.ByteWriter.
 func NewEncoder(enc *Encoding, w io.Writer) io.WriteCloser {
 	return &encoder{enc: enc, w: w}
 }
 
+// read buffers of stream decoders, shared while they are reading
+var decoderBufPool = sync.Pool{New: func() any { return new([4096]byte) }}
+
 type decoder struct {
-	enc *Encoding
-	r   io.Reader
-	buf bytes.Buffer
+	enc    *Encoding
+	r      io.Reader
+	closer io.Closer   // closed with the decoder, nil for none
+	buf    *[4096]byte // read buffer from decoderBufPool, nil when idle
+
+	// little-endian base-256 value of the digits after the leading zeros,
+	// and pending digits not yet folded into it
+	num     []byte
+	pending uint64
+	mult    uint64
+
+	start   time.Time // first Read, when tracing
+	limit   int64     // maximum decoded bytes, -1 for none
+	zeros   int       // leading zero digits
+	leading bool      // still in the leading zero digits
+	count   int       // digits seen
+	pos     int       // input offset
+	out     []byte
+	outBuf  []byte // full buffer backing out
+	err     error
+	done    bool
+	closed  bool
 }
 
-// read decoded data
+// read decoded data. Input is folded into the decoded number as it arrives,
+// so only the number is held in memory; the output is available at EOF,
+// as every decoded byte depends on all input digits.
 func (d *decoder) Read(p []byte) (int, error) {
-	if d.buf.Len() == 0 {
-		_, err := d.buf.ReadFrom(d.r)
-		if err != nil && err != io.EOF {
-			return 0, err
+	if d.closed {
+		return 0, ErrDecoderClosed
+	}
+	if d.start.IsZero() {
+		d.start = d.enc.traceStart()
+	}
+	if !d.done && d.err == nil && d.buf == nil {
+		d.buf = decoderBufPool.Get().(*[4096]byte)
+	}
+	for !d.done && d.err == nil {
+		n, err := d.r.Read(d.buf[:])
+		if ferr := d.feed(d.buf[:n]); ferr != nil {
+			d.err = ferr
+			d.observe()
+			break
 		}
-		decoded, err := d.enc.DecodeToBytes(d.buf.Bytes())
-		if err != nil {
-			return 0, err
+		if err == io.EOF {
+			d.err = d.finish()
+			d.done = true
+			d.observe()
+		} else if err != nil {
+			// return the decoding of the truncated input, checked like a
+			// complete one, then report the error on the next Read. It is
+			// a different number, not a prefix of the payload.
+			if ferr := d.finish(); ferr != nil {
+				// nothing is returned, the failed check joins the error
+				err = errors.Join(err, ferr)
+			}
+			d.err = &ReadError{Offset: int64(d.pos), Err: err}
+			d.observe()
 		}
-		d.buf.Reset()
-		d.buf.Write(decoded)
 	}
-	return d.buf.Read(p)
+	d.releaseReadBuf()
+	if len(d.out) > 0 {
+		n := copy(p, d.out)
+		d.out = d.out[n:]
+		if len(d.out) == 0 {
+			d.enc.release(d.outBuf)
+			d.out, d.outBuf = nil, nil
+		}
+		return n, nil
+	}
+	if d.outBuf != nil {
+		// empty output
+		d.enc.release(d.outBuf)
+		d.outBuf = nil
+	}
+	if d.err != nil {
+		return 0, d.err
+	}
+	return 0, io.EOF
+}
+
+// return the read buffer to the pool once no more input is read
+func (d *decoder) releaseReadBuf() {
+	if d.buf != nil && (d.done || d.err != nil || d.closed) {
+		if d.enc.secure {
+			clear(d.buf[:])
+		}
+		decoderBufPool.Put(d.buf)
+		d.buf = nil
+	}
+}
+
+// release the internal buffers and close the wrapped reader if the decoder
+// owns it. Close is idempotent, later calls return nil and reads fail with
+// ErrDecoderClosed.
+func (d *decoder) Close() error {
+	if d.closed {
+		return nil
+	}
+	d.closed = true
+	d.releaseReadBuf()
+	d.enc.release(d.num)
+	d.enc.release(d.outBuf)
+	d.num, d.out, d.outBuf = nil, nil, nil
+	if d.closer != nil {
+		return d.closer.Close()
+	}
+	return nil
+}
+
+// report the end of decoding to the instrumentation and trace
+func (d *decoder) observe() {
+	d.enc.observeDecode(d.out, d.err)
+	d.enc.traceDone("decode", "stream", d.start, d.pos, len(d.out), d.err)
+}
+
+// fold the digits of chunk into the decoded number
+func (d *decoder) feed(chunk []byte) error {
+	enc := d.enc
+	for _, c := range chunk {
+		pos := d.pos
+		d.pos++
+		if c == '\r' || c == '\n' || enc.ignored(c) {
+			continue
+		}
+		val := enc.reverse[c]
+		if d.leading && enc.zero != 0 && c == enc.zero {
+			val = 0
+		}
+		if val == -1 && enc.onInvalid != nil {
+			repl, skip, err := enc.onInvalid(c, pos)
+			if err != nil {
+				return err
+			}
+			if skip {
+				continue
+			}
+			val = enc.reverse[repl]
+		}
+		if val == -1 {
+			return errInvalidCharacter
+		}
+		d.count++
+		if d.leading && val == 0 {
+			d.zeros++
+		} else {
+			d.leading = false
+			d.pending = d.pending*uint64(enc.base) + uint64(val)
+			d.mult *= uint64(enc.base)
+			if d.mult > 1<<54/uint64(enc.base) {
+				d.fold()
+			}
+		}
+		if err := d.checkLimit(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// report a LimitError once the decoded size exceeds the limit
+func (d *decoder) checkLimit() error {
+	n := d.zeros + len(d.num)
+	if d.enc.padWidth > 0 {
+		// padded results have PadSize bytes unless the value overflows
+		n = max(d.enc.padSize, len(d.num))
+	}
+	if d.limit >= 0 && int64(n) > d.limit {
+		return &LimitError{Limit: d.limit}
+	}
+	return nil
+}
+
+// num = num*mult + pending
+func (d *decoder) fold() {
+	carry := d.pending
+	for i := range d.num {
+		carry += uint64(d.num[i]) * d.mult
+		d.num[i] = byte(carry)
+		carry >>= 8
+	}
+	for carry > 0 {
+		if len(d.num) == cap(d.num) {
+			grown := d.enc.get(max(2*cap(d.num), 16))[:len(d.num)]
+			copy(grown, d.num)
+			d.enc.release(d.num)
+			d.num = grown
+		}
+		d.num = append(d.num, byte(carry))
+		carry >>= 8
+	}
+	d.pending, d.mult = 0, 1
+}
+
+// convert the decoded number to output bytes
+func (d *decoder) finish() error {
+	if d.enc.padWidth > 0 {
+		if err := d.enc.checkPadCount(d.count, d.zeros > 0); err != nil {
+			return err
+		}
+	}
+	d.fold()
+	if err := d.checkLimit(); err != nil {
+		return err
+	}
+	d.convert()
+	if err := d.enc.runValidators(d.out); err != nil {
+		d.enc.release(d.outBuf)
+		d.out, d.outBuf = nil, nil
+		return err
+	}
+	return nil
+}
+
+// convert the folded number to output bytes
+func (d *decoder) convert() {
+	d.outBuf = d.enc.get(d.zeros + len(d.num))
+	out := d.outBuf[:d.zeros]
+	clear(out)
+	for i := len(d.num) - 1; i >= 0; i-- {
+		out = append(out, d.num[i])
+	}
+	if d.enc.padWidth > 0 {
+		// padDecoded swaps in a new buffer for results it must extend
+		grow := len(out) < d.enc.padSize
+		out = d.enc.padDecoded(out)
+		if grow {
+			d.outBuf = out
+		}
+	}
+	if d.enc.littleEndian {
+		reverseBytes(out)
+	}
+	d.out = out
+	// the number is no longer needed once converted
+	d.enc.release(d.num)
+	d.num = nil
+}
+
+// base58 stream decoder, CR and LF in the input are ignored. Input is
+// consumed incrementally and held as the decoded number rather than text.
+// The decoded length limit of enc, if any, applies as for NewDecoderLimit.
+// Close releases the internal buffers, which come from the allocator of
+// enc if it has one, but leaves r open.
+func NewDecoder(enc *Encoding, r io.Reader) io.ReadCloser {
+	if enc.maxDecoded > 0 {
+		return NewDecoderLimit(enc, r, int64(enc.maxDecoded))
+	}
+	return NewDecoderLimit(enc, r, -1)
+}
+
+// error from the reader wrapped by a stream decoder. The decoder first
+// returns the decoding of the input read before the error, as with
+// DecodePrefix, and the error on the following Read, so callers receive
+// both, e.g. from io.ReadAll.
+//
+// That data is the decoding of the truncated input, NOT a prefix of the
+// payload: base58 is positional, so every byte changes with the missing
+// digits. It only helps to report or inspect what arrived, and io.Copy
+// writes it to its destination before returning the error. It passes the
+// pad width, length limit and validators of the encoding like a complete
+// decode; if it fails them nothing is returned and the failure is joined
+// to Err.
+type ReadError struct {
+	Offset int64 // input bytes read before the error
+	Err    error
+}
+
+func (e *ReadError) Error() string {
+	return "base58: read error at input byte " + strconv.FormatInt(e.Offset, 10) + ": " + e.Err.Error()
+}
+
+func (e *ReadError) Unwrap() error {
+	return e.Err
+}
+
+// decoded size exceeds the limit of a NewDecoderLimit decoder
+type LimitError struct {
+	Limit int64
+}
+
+func (e *LimitError) Error() string {
+	return "base58: decoded data exceeds limit of " + strconv.FormatInt(e.Limit, 10) + " bytes"
+}
+
+// base58 stream decoder that fails with a *LimitError once the decoded
+// size exceeds maxDecodedBytes, a negative limit disables the check. The
+// check runs as input arrives, so an endless source is cut off early.
+func NewDecoderLimit(enc *Encoding, r io.Reader, maxDecodedBytes int64) io.ReadCloser {
+	return &decoder{enc: enc, r: enc.withProgress(r), mult: 1, leading: true, limit: maxDecodedBytes}
 }
 
-// base58 stream decoder
-func NewDecoder(enc *Encoding, r io.Reader) io.Reader {
-	return &decoder{enc: enc, r: r}
+// base58 stream decoder like NewDecoder that also closes r at Close, for
+// decoders that own their source such as a file or request body
+func NewDecoderCloser(enc *Encoding, r io.ReadCloser) io.ReadCloser {
+	d := NewDecoder(enc, r).(*decoder)
+	d.closer = r
+	return d
 }
@@ -2,6 +2,7 @@ package base58_test
 
 import (
 	"bytes"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -229,6 +230,63 @@ func (r *faultInjectReader) Read(p []byte) (int, error) {
 	return n, nr.err
 }
 
+func TestEncoderClose(t *testing.T) {
+	encoders := map[string]func(io.Writer) io.WriteCloser{
+		"NewEncoder": func(w io.Writer) io.WriteCloser { return base58.NewEncoder(base58.StdEncoding, w) },
+		"NewCheckEncoder": func(w io.Writer) io.WriteCloser {
+			return base58.NewCheckEncoder(base58.StdCheckEncoding, w)
+		},
+		"NewBlockEncoder": func(w io.Writer) io.WriteCloser { return base58.NewBlockEncoder(base58.StdEncoding, w) },
+	}
+	for name, newEncoder := range encoders {
+		bb := &strings.Builder{}
+		w := newEncoder(bb)
+		io.WriteString(w, "sure.")
+		if err := w.Close(); err != nil {
+			t.Fatalf("%s Close failed: %v", name, err)
+		}
+		out := bb.String()
+		if n, err := w.Write([]byte("more")); n != 0 || err != base58.ErrClosed {
+			t.Errorf("%s Write after Close: got %d, %v, want 0, %v", name, n, err, base58.ErrClosed)
+		}
+		if err := w.Close(); err != nil {
+			t.Errorf("%s second Close: got %v, want nil", name, err)
+		}
+		testEqual(t, name+" output after second Close: got %q, want %q", out, bb.String())
+	}
+}
+
+func TestEncoderStringByteWriter(t *testing.T) {
+	encoders := map[string]func(io.Writer) io.WriteCloser{
+		"NewEncoder": func(w io.Writer) io.WriteCloser { return base58.NewEncoder(base58.StdEncoding, w) },
+		"NewCheckEncoder": func(w io.Writer) io.WriteCloser {
+			return base58.NewCheckEncoder(base58.StdCheckEncoding, w)
+		},
+		"NewBlockEncoder": func(w io.Writer) io.WriteCloser { return base58.NewBlockEncoderSize(base58.StdEncoding, w, 3) },
+	}
+	for name, newEncoder := range encoders {
+		want := &strings.Builder{}
+		w := newEncoder(want)
+		w.Write([]byte("\xffsure."))
+		w.Close()
+
+		got := &strings.Builder{}
+		w = newEncoder(got)
+		sw, ok := w.(io.StringWriter)
+		bw, ok2 := w.(io.ByteWriter)
+		if !ok || !ok2 {
+			t.Fatalf("%s: encoder does not implement io.StringWriter and io.ByteWriter", name)
+		}
+		bw.WriteByte(0xff)
+		sw.WriteString("sure.")
+		w.Close()
+		testEqual(t, name+" WriteString/WriteByte: got %q, want %q", want.String(), got.String())
+		if err := bw.WriteByte('x'); err != base58.ErrClosed {
+			t.Errorf("%s WriteByte after Close: got %v, want %v", name, err, base58.ErrClosed)
+		}
+	}
+}
+
 func TestDecoderIssue3577(t *testing.T) {
 	next := make(chan nextRead, 10)
 	wantErr := errors.New("my error")
@@ -240,20 +298,307 @@ func TestDecoderIssue3577(t *testing.T) {
 		nextc:  next,
 	})
 	errc := make(chan error, 1)
+	var partial []byte
 	go func() {
-		_, err := io.ReadAll(d)
+		var err error
+		partial, err = io.ReadAll(d)
 		errc <- err
 	}()
 	select {
 	case err := <-errc:
-		if err != wantErr {
+		if !errors.Is(err, wantErr) {
 			t.Errorf("Fault injection: got error %v; want %v", err, wantErr)
 		}
+		var rerr *base58.ReadError
+		if !errors.As(err, &rerr) || rerr.Offset != 15 {
+			t.Errorf("Fault injection: got error %v; want *ReadError at offset 15", err)
+		}
+		// the 15 characters read before the error are not lost
+		want, _ := base58.StdEncoding.DecodeString("2ukVBARx4fMCUZX")
+		if string(partial) != string(want) {
+			t.Errorf("Fault injection: got partial data %x; want %x", partial, want)
+		}
 	case <-time.After(5 * time.Second):
 		t.Errorf("Timeout: Decoder blocked without returning an error")
 	}
 }
 
+func TestDecoderPartialThenError(t *testing.T) {
+	// the partial data comes without an error, the error on the next Read
+	wantErr := errors.New("my error")
+	next := make(chan nextRead, 10)
+	next <- nextRead{8, nil}
+	next <- nextRead{0, wantErr}
+	d := base58.NewDecoder(base58.StdEncoding, &faultInjectReader{source: "2ukVBARx4fMCUZX", nextc: next})
+	want, _ := base58.StdEncoding.DecodeString("2ukVBARx")
+	p := make([]byte, 64)
+	n, err := d.Read(p)
+	if err != nil || string(p[:n]) != string(want) {
+		t.Errorf("first Read = %x, %v; want %x, nil", p[:n], err, want)
+	}
+	if n, err := d.Read(p); n != 0 || !errors.Is(err, wantErr) {
+		t.Errorf("second Read = %d, %v; want 0, %v", n, err, wantErr)
+	}
+
+	// the truncated decoding goes through the validators like a complete one
+	rejected := errors.New("rejected")
+	enc := base58.StdEncoding.WithValidator(func([]byte) error { return rejected })
+	next <- nextRead{8, nil}
+	next <- nextRead{0, wantErr}
+	d = base58.NewDecoder(enc, &faultInjectReader{source: "2ukVBARx4fMCUZX", nextc: next})
+	n, err = d.Read(p)
+	if n != 0 || !errors.Is(err, wantErr) || !errors.Is(err, rejected) {
+		t.Errorf("Read with a rejecting validator = %x, %v; want no data and both errors", p[:n], err)
+	}
+}
+
+func TestDecoderEarlyError(t *testing.T) {
+	// an invalid character is reported without waiting for EOF
+	next := make(chan nextRead, 10)
+	next <- nextRead{5, nil}
+	d := base58.NewDecoder(base58.StdEncoding, &faultInjectReader{source: "2ukV0BARx", nextc: next})
+	errc := make(chan error, 1)
+	go func() {
+		_, err := io.ReadAll(d)
+		errc <- err
+	}()
+	select {
+	case err := <-errc:
+		if err == nil {
+			t.Errorf("Decoder: expected invalid character error")
+		}
+	case <-time.After(5 * time.Second):
+		t.Errorf("Timeout: Decoder waited for EOF before reporting an invalid character")
+	}
+}
+
+type closeRecorder struct {
+	io.Reader
+	closed int
+}
+
+func (c *closeRecorder) Close() error {
+	c.closed++
+	return nil
+}
+
+func TestDecoderClose(t *testing.T) {
+	src := &closeRecorder{Reader: strings.NewReader(bigtest.encoded)}
+	d := base58.NewDecoder(base58.StdEncoding, src)
+	p := make([]byte, 4)
+	if _, err := io.ReadFull(d, p); err != nil || string(p) != bigtest.decoded[:4] {
+		t.Fatalf("Read: got %q, %v, want %q", p, err, bigtest.decoded[:4])
+	}
+	testEqual(t, "Close() = %v, want %v", nil, d.Close())
+	testEqual(t, "Close() again = %v, want %v", nil, d.Close())
+	if n, err := d.Read(p); n != 0 || err != base58.ErrDecoderClosed {
+		t.Errorf("Read after Close: got %d, %v, want 0, %v", n, err, base58.ErrDecoderClosed)
+	}
+	testEqual(t, "NewDecoder source closed %v times, want %v", 0, src.closed)
+
+	src = &closeRecorder{Reader: strings.NewReader(bigtest.encoded)}
+	dc := base58.NewDecoderCloser(base58.StdEncoding, src)
+	decoded, err := io.ReadAll(dc)
+	if err != nil || string(decoded) != bigtest.decoded {
+		t.Errorf("NewDecoderCloser: got %q, %v, want %q", decoded, err, bigtest.decoded)
+	}
+	dc.Close()
+	dc.Close()
+	testEqual(t, "NewDecoderCloser source closed %v times, want %v", 1, src.closed)
+}
+
+func TestDecoderAllocator(t *testing.T) {
+	alloc := newCountingAllocator(t)
+	enc := base58.StdEncoding.WithAllocator(alloc)
+
+	// drained decoders return their buffers without Close
+	decoded, err := io.ReadAll(base58.NewDecoder(enc, strings.NewReader(bigtest.encoded)))
+	if err != nil || string(decoded) != bigtest.decoded {
+		t.Errorf("NewDecoder: got %q, %v, want %q", decoded, err, bigtest.decoded)
+	}
+	testEqual(t, "drained decoder outstanding = %v, want %v", 0, alloc.outstanding())
+
+	// abandoned decoders return them at Close
+	d := base58.NewDecoder(enc, strings.NewReader(bigtest.encoded))
+	if _, err := d.Read(make([]byte, 1)); err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	if alloc.outstanding() == 0 {
+		t.Errorf("decoder holds no allocator buffers before Close")
+	}
+	d.Close()
+	testEqual(t, "closed decoder outstanding = %v, want %v", 0, alloc.outstanding())
+	if alloc.gets == 0 {
+		t.Error("decoder did not use the allocator")
+	}
+}
+
+func TestDecoderOptions(t *testing.T) {
+	encs := []*base58.Encoding{
+		base58.StdEncoding.WithPadWidth(12),
+		base58.StdEncoding.WithSeparator('-', 4),
+		base58.StdEncoding.WithZeroDigit('0'),
+		base58.StdEncoding.Lenient(),
+		base58.NewRadixEncoding("01"),
+	}
+	for _, enc := range encs {
+		for _, s := range []string{"", "\x00\x00sure.", "sure.", "\x00"} {
+			encoded := enc.EncodeToString([]byte(s))
+			want, wantErr := enc.DecodeString(encoded)
+			got, err := io.ReadAll(base58.NewDecoder(enc, strings.NewReader(encoded)))
+			if (err != nil) != (wantErr != nil) || !bytes.Equal(got, want) {
+				t.Errorf("%v stream decoding of %q: got %q, %v, want %q, %v", enc, encoded, got, err, want, wantErr)
+			}
+		}
+	}
+}
+
+func TestDecoderLimit(t *testing.T) {
+	decoded, err := io.ReadAll(base58.NewDecoderLimit(base58.StdEncoding, strings.NewReader(bigtest.encoded), int64(len(bigtest.decoded))))
+	if err != nil || string(decoded) != bigtest.decoded {
+		t.Errorf("NewDecoderLimit(exact): got %q, %v", decoded, err)
+	}
+
+	var le *base58.LimitError
+	_, err = io.ReadAll(base58.NewDecoderLimit(base58.StdEncoding, strings.NewReader(bigtest.encoded), int64(len(bigtest.decoded)-1)))
+	if !errors.As(err, &le) || le.Limit != int64(len(bigtest.decoded)-1) {
+		t.Errorf("NewDecoderLimit(short): got %v, want *LimitError", err)
+	}
+
+	// an endless source is cut off
+	endless := io.MultiReader(strings.NewReader("2"), infiniteReader('z'))
+	_, err = io.ReadAll(base58.NewDecoderLimit(base58.StdEncoding, endless, 1024))
+	if !errors.As(err, &le) {
+		t.Errorf("NewDecoderLimit(endless): got %v, want *LimitError", err)
+	}
+	// leading zeros count towards the limit
+	_, err = io.ReadAll(base58.NewDecoderLimit(base58.StdEncoding, infiniteReader('1'), 1024))
+	if !errors.As(err, &le) {
+		t.Errorf("NewDecoderLimit(endless zeros): got %v, want *LimitError", err)
+	}
+}
+
+type infiniteReader byte
+
+func (r infiniteReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = byte(r)
+	}
+	return len(p), nil
+}
+
+func TestEncodingIntrospection(t *testing.T) {
+	testEqual(t, "Alphabet(): got %q, want %q", base58.BitcoinAlphabet, base58.StdEncoding.Alphabet())
+	testEqual(t, "Name(): got %q, want %q", "bitcoin", base58.StdEncoding.Name())
+	testEqual(t, "String(): got %q, want %q", "base58(bitcoin)", base58.StdEncoding.String())
+	testEqual(t, "Name(): got %q, want %q", "custom", funnyEncoding.Name())
+	testEqual(t, "String(): got %q, want %q", "base58(custom:"+base58Alphabet+")", funnyEncoding.String())
+	if !base58.StdEncoding.Equal(funnyEncoding) {
+		t.Errorf("Equal: StdEncoding and funnyEncoding share an alphabet but compare unequal")
+	}
+	swapped := []byte(base58Alphabet)
+	swapped[0], swapped[1] = swapped[1], swapped[0]
+	if base58.StdEncoding.Equal(base58.NewEncoding(string(swapped))) {
+		t.Errorf("Equal: encodings with different alphabets compare equal")
+	}
+	if base58.StdEncoding.Equal(nil) {
+		t.Errorf("Equal: StdEncoding compares equal to nil")
+	}
+}
+
+func TestRippleEncoding(t *testing.T) {
+	testEqual(t, "RippleEncoding.Alphabet(): got %q, want %q", base58.RippleAlphabet, base58.RippleEncoding.Alphabet())
+	testEqual(t, "RippleEncoding.Name(): got %q, want %q", "ripple", base58.RippleEncoding.Name())
+	tests := []struct {
+		address, accountID string
+	}{
+		{"rrrrrrrrrrrrrrrrrrrrrhoLvTp", "0000000000000000000000000000000000000000"},        // ACCOUNT_ZERO
+		{"rrrrrrrrrrrrrrrrrrrrBZbvji", "0000000000000000000000000000000000000001"},         // ACCOUNT_ONE
+		{"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", "b5f762798a53d543a014caf8b297cff8f2f937e8"}, // genesis account
+	}
+	for _, tt := range tests {
+		version, accountID, err := base58.RippleCheckEncoding.DecodeVersion(tt.address, 1)
+		if err != nil {
+			t.Errorf("DecodeVersion(%q) failed: %v", tt.address, err)
+			continue
+		}
+		testEqual(t, "version: got %x, want %x", "\x00", string(version))
+		testEqual(t, "account ID: got %q, want %q", tt.accountID, hex.EncodeToString(accountID))
+		testEqual(t, "EncodeVersion: got %q, want %q", tt.address, base58.RippleCheckEncoding.EncodeVersion(version, accountID))
+	}
+}
+
+func TestFlickrEncoding(t *testing.T) {
+	testEqual(t, "FlickrEncoding.Name(): got %q, want %q", "flickr", base58.FlickrEncoding.Name())
+	flickrPairs := []testpair{
+		{"sure.", "e2wfqYN"},
+		{"\x00\x00hello", "11cM8DuyF"},
+		{"\xca\x33\xbb\x15", "6aLSHT"},
+	}
+	for _, p := range flickrPairs {
+		got := base58.FlickrEncoding.EncodeToString([]byte(p.decoded))
+		msg := fmt.Sprintf("FlickrEncoding.EncodeToString(%q): got %%q, want %%q", p.decoded)
+		testEqual(t, msg, p.encoded, got)
+		res, err := base58.FlickrEncoding.DecodeString(p.encoded)
+		if err != nil {
+			t.Errorf("FlickrEncoding.DecodeString(%q) failed: %v", p.encoded, err)
+			continue
+		}
+		msg = fmt.Sprintf("FlickrEncoding.DecodeString(%q): got %%q, want %%q", p.encoded)
+		testEqual(t, msg, p.decoded, string(res))
+	}
+}
+
+func TestGMPEncoding(t *testing.T) {
+	testEqual(t, "GMPEncoding.Name(): got %q, want %q", "gmp", base58.GMPEncoding.Name())
+	gmpPairs := []testpair{
+		{"sure.", "D1UEOuk"},
+		{"\x07\x5b\xcd\x15", "AqhNJ"}, // mpz_get_str(58, 123456789)
+		{"\x00\x07\x5b\xcd\x15", "0AqhNJ"},
+	}
+	for _, p := range gmpPairs {
+		got := base58.GMPEncoding.EncodeToString([]byte(p.decoded))
+		msg := fmt.Sprintf("GMPEncoding.EncodeToString(%q): got %%q, want %%q", p.decoded)
+		testEqual(t, msg, p.encoded, got)
+		res, err := base58.GMPEncoding.DecodeString(p.encoded)
+		if err != nil {
+			t.Errorf("GMPEncoding.DecodeString(%q) failed: %v", p.encoded, err)
+			continue
+		}
+		msg = fmt.Sprintf("GMPEncoding.DecodeString(%q): got %%q, want %%q", p.encoded)
+		testEqual(t, msg, p.decoded, string(res))
+	}
+}
+
+func TestDecodePrefix(t *testing.T) {
+	tests := []struct {
+		in       string
+		decoded  string
+		consumed int
+		err      error
+	}{
+		{"E2XFRyo", "sure.", 7, nil},
+		{"E2XFRyo is embedded", "sure.", 7, base58.CorruptInputError(7)},
+		{"E2XFRyo\"", "sure.", 7, base58.CorruptInputError(7)},
+		{"0E2XFRyo", "", 0, base58.CorruptInputError(0)},
+		{"", "", 0, nil},
+	}
+	for _, tt := range tests {
+		decoded, consumed, err := base58.StdEncoding.DecodePrefix([]byte(tt.in))
+		if string(decoded) != tt.decoded || consumed != tt.consumed || err != tt.err {
+			t.Errorf("DecodePrefix(%q): got %q, %d, %v, want %q, %d, %v",
+				tt.in, decoded, consumed, err, tt.decoded, tt.consumed, tt.err)
+		}
+	}
+
+	// skipped characters are consumed
+	decoded, consumed, err := base58.StdEncoding.WithIgnore("-").DecodePrefix([]byte("E2X-FRyo!"))
+	if string(decoded) != "sure." || consumed != 8 || err != base58.CorruptInputError(8) {
+		t.Errorf("DecodePrefix with ignore: got %q, %d, %v", decoded, consumed, err)
+	}
+	testEqual(t, "CorruptInputError: got %q, want %q", "base58: illegal data at input byte 8", err.Error())
+}
+
 func BenchmarkEncodeToString(b *testing.B) {
 	data := make([]byte, 8192)
 	b.SetBytes(int64(len(data)))
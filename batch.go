@@ -0,0 +1,174 @@
+package base58
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+/*
+Batch Encoding
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+EncodeBatch and DecodeBatch convert many small values at once, e.g. lists of
+addresses or hashes. The items are split into chunks converted by a pool of
+workers. Each worker reuses its own scratch buffers across items, and the
+decoded results of a chunk share one allocation, so the per-item cost is
+little more than the conversion itself:
+
+	encoded := base58.StdEncoding.EncodeBatch(hashes)
+	decoded, errs := base58.StdEncoding.DecodeBatch(addresses)
+
+Results are in input order. An encoding with its own allocator keeps using
+it for the scratch buffers instead of the per-worker ones.
+*/
+
+// items converted by one worker at a time
+const batchChunk = 256
+
+// option for EncodeBatch and DecodeBatch
+type BatchOption func(*batchConfig)
+
+type batchConfig struct {
+	workers int
+}
+
+// convert with at most n goroutines instead of GOMAXPROCS, n < 1 is ignored
+func WithBatchWorkers(n int) BatchOption {
+	return func(c *batchConfig) {
+		if n >= 1 {
+			c.workers = n
+		}
+	}
+}
+
+// return the base58 encoding of every item of src
+func (enc *Encoding) EncodeBatch(src [][]byte, opts ...BatchOption) []string {
+	out := make([]string, len(src))
+	enc.runBatch(len(src), opts, func(w *Encoding, lo, hi int) {
+		for i := lo; i < hi; i++ {
+			encoded := w.EncodeToBytes(src[i])
+			out[i] = string(encoded)
+			w.release(encoded)
+		}
+	})
+	return out
+}
+
+// decode every item of src, returning the decoded items and, if any item
+// failed, the error of every item with nil for the ones that succeeded.
+// The decoded item of a failed item is nil.
+func (enc *Encoding) DecodeBatch(src []string, opts ...BatchOption) ([][]byte, []error) {
+	out := make([][]byte, len(src))
+	var errs []error
+	var errsOnce sync.Once
+	var mu sync.Mutex
+	enc.runBatch(len(src), opts, func(w *Encoding, lo, hi int) {
+		size := 0
+		for _, s := range src[lo:hi] {
+			size += w.DecodedLen(len(s))
+		}
+		// results of the chunk share one allocation, capped so appending
+		// to one cannot overwrite the next
+		slab := make([]byte, 0, size)
+		for i := lo; i < hi; i++ {
+			decoded, err := w.DecodeString(src[i])
+			if err != nil {
+				errsOnce.Do(func() { errs = make([]error, len(src)) })
+				mu.Lock()
+				errs[i] = err
+				mu.Unlock()
+				continue
+			}
+			if len(slab)+len(decoded) <= cap(slab) {
+				start := len(slab)
+				slab = append(slab, decoded...)
+				out[i] = slab[start:len(slab):len(slab)]
+			} else {
+				out[i] = append([]byte{}, decoded...)
+			}
+			w.release(decoded)
+		}
+	})
+	return out, errs
+}
+
+// call convert for consecutive chunks [lo, hi) of n items on a pool of
+// workers, each with its own copy of enc
+func (enc *Encoding) runBatch(n int, opts []BatchOption, convert func(w *Encoding, lo, hi int)) {
+	c := batchConfig{workers: runtime.GOMAXPROCS(0)}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	chunks := (n + batchChunk - 1) / batchChunk
+	workers := min(c.workers, chunks)
+	var next atomic.Int64
+	work := func() {
+		w := enc
+		if enc.allocator == nil {
+			w = enc.WithAllocator(new(scratchAllocator))
+		}
+		for {
+			chunk := int(next.Add(1)) - 1
+			if chunk >= chunks {
+				return
+			}
+			lo := chunk * batchChunk
+			convert(w, lo, min(lo+batchChunk, n))
+		}
+	}
+	if workers <= 1 {
+		work()
+		return
+	}
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			work()
+		}()
+	}
+	wg.Wait()
+}
+
+// scratch buffers kept for reuse by one batch worker
+const scratchBuffers = 8
+
+// free list of the scratch buffers of one batch worker, not safe for
+// concurrent use
+type scratchAllocator struct {
+	free [][]byte
+}
+
+// return a free buffer of at least n bytes, or a new one
+func (s *scratchAllocator) Get(n int) []byte {
+	for i := len(s.free) - 1; i >= 0; i-- {
+		if b := s.free[i]; cap(b) >= n {
+			s.free[i] = s.free[len(s.free)-1]
+			s.free = s.free[:len(s.free)-1]
+			return b[:n]
+		}
+	}
+	return make([]byte, n)
+}
+
+// keep b for reuse, replacing the smallest free buffer once the list is
+// full
+func (s *scratchAllocator) Put(b []byte) {
+	if len(s.free) < scratchBuffers {
+		s.free = append(s.free, b[:0])
+		return
+	}
+	smallest := 0
+	for i, f := range s.free {
+		if cap(f) < cap(s.free[smallest]) {
+			smallest = i
+		}
+	}
+	if cap(b) > cap(s.free[smallest]) {
+		s.free[smallest] = b[:0]
+	}
+}
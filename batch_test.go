@@ -0,0 +1,96 @@
+package base58_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+// n distinct 32-byte hashes, every 7th with leading zero bytes
+func batchItems(n int) [][]byte {
+	items := make([][]byte, n)
+	for i := range items {
+		h := sha256.Sum256([]byte{byte(i), byte(i >> 8)})
+		if i%7 == 0 {
+			h[0], h[1] = 0, 0
+		}
+		items[i] = h[:]
+	}
+	return items
+}
+
+func TestEncodeDecodeBatch(t *testing.T) {
+	encodings := []*base58.Encoding{
+		base58.StdEncoding,
+		base58.FlickrEncoding.WithPadWidth(46),
+		base58.StdEncoding.WithSeparator('-', 4).Secure(),
+		base58.StdEncoding.WithAllocator(base58.NewPoolAllocator()),
+	}
+	for _, enc := range encodings {
+		for _, n := range []int{0, 1, 300, 2000} {
+			items := batchItems(n)
+			for _, workers := range []int{1, 4} {
+				encoded := enc.EncodeBatch(items, base58.WithBatchWorkers(workers))
+				testEqual(t, "EncodeBatch length = %d, want %d", n, len(encoded))
+				for i, s := range encoded {
+					if want := enc.EncodeToString(items[i]); s != want {
+						t.Fatalf("EncodeBatch item %d = %q, want %q", i, s, want)
+					}
+				}
+
+				decoded, errs := enc.DecodeBatch(encoded, base58.WithBatchWorkers(workers))
+				if errs != nil {
+					t.Fatalf("DecodeBatch: unexpected errors %v", errs)
+				}
+				testEqual(t, "DecodeBatch length = %d, want %d", n, len(decoded))
+				for i, d := range decoded {
+					want, _ := enc.DecodeString(encoded[i])
+					if string(d) != string(want) {
+						t.Fatalf("DecodeBatch item %d = %x, want %x", i, d, want)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDecodeBatchErrors(t *testing.T) {
+	src := []string{"2NEpo7TZRRrLZSi2U", "0OIl", "", "1112", "abc!"}
+	decoded, errs := base58.StdEncoding.DecodeBatch(src)
+	testEqual(t, "DecodeBatch errors length = %d, want %d", len(src), len(errs))
+	for i, s := range src {
+		want, wantErr := base58.StdEncoding.DecodeString(s)
+		if (errs[i] != nil) != (wantErr != nil) {
+			t.Errorf("DecodeBatch item %d error = %v, want %v", i, errs[i], wantErr)
+		}
+		if wantErr != nil {
+			testEqual(t, "DecodeBatch failed item = %v, want %v", true, decoded[i] == nil)
+		} else if string(decoded[i]) != string(want) {
+			t.Errorf("DecodeBatch item %d = %x, want %x", i, decoded[i], want)
+		}
+	}
+}
+
+func TestDecodeBatchAliasing(t *testing.T) {
+	// results share an allocation, appending to one must not change another
+	decoded, _ := base58.StdEncoding.DecodeBatch([]string{"2g", "a3gV"})
+	_ = append(decoded[0], 'x', 'y', 'z')
+	testEqual(t, "DecodeBatch second item = %q, want %q", "bbb", string(decoded[1]))
+}
+
+func BenchmarkEncodeBatch(b *testing.B) {
+	items := batchItems(10000)
+	b.SetBytes(int64(len(items) * 32))
+	for i := 0; i < b.N; i++ {
+		base58.StdEncoding.EncodeBatch(items)
+	}
+}
+
+func BenchmarkDecodeBatch(b *testing.B) {
+	encoded := base58.StdEncoding.EncodeBatch(batchItems(10000))
+	b.SetBytes(int64(len(encoded) * 44))
+	for i := 0; i < b.N; i++ {
+		base58.StdEncoding.DecodeBatch(encoded)
+	}
+}
@@ -0,0 +1,129 @@
+package base58
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+/*
+BIP32 Extended Key Serialization
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+An extended key is a 78-byte structure serialized with Base58Check:
+	4 bytes  version (xpub, xprv, tpub, ...)
+	1 byte   depth
+	4 bytes  parent key fingerprint
+	4 bytes  child number
+	32 bytes chain code
+	33 bytes public key, or 0x00 followed by the 32-byte private key
+https://github.com/bitcoin/bips/blob/master/bip-0032.mediawiki#serialization-format
+*/
+
+// length of a serialized BIP32 extended key in bytes
+const ExtendedKeyLen = 78
+
+// decoded data is not a well-formed BIP32 extended key
+var ErrInvalidExtendedKey = errors.New("base58: invalid extended key")
+
+// extended key version bytes, mapped to whether they denote a private key
+var extendedKeyVersions = map[[4]byte]bool{
+	{0x04, 0x88, 0xb2, 0x1e}: false, // xpub
+	{0x04, 0x88, 0xad, 0xe4}: true,  // xprv
+	{0x04, 0x35, 0x87, 0xcf}: false, // tpub
+	{0x04, 0x35, 0x83, 0x94}: true,  // tprv
+	{0x04, 0x9d, 0x7c, 0xb2}: false, // ypub
+	{0x04, 0x9d, 0x78, 0x78}: true,  // yprv
+	{0x04, 0x4a, 0x52, 0x62}: false, // upub
+	{0x04, 0x4a, 0x4e, 0x28}: true,  // uprv
+	{0x04, 0xb2, 0x47, 0x46}: false, // zpub
+	{0x04, 0xb2, 0x43, 0x0c}: true,  // zprv
+	{0x04, 0x5f, 0x1c, 0xf6}: false, // vpub
+	{0x04, 0x5f, 0x18, 0xbc}: true,  // vprv
+}
+
+// BIP32 extended key fields
+type ExtendedKey struct {
+	Version           [4]byte
+	Depth             byte
+	ParentFingerprint [4]byte
+	ChildNumber       uint32
+	ChainCode         [32]byte
+	Key               [33]byte // public key, or 0x00 followed by private key
+}
+
+// report whether k holds a private key
+func (k *ExtendedKey) IsPrivate() bool {
+	return k.Key[0] == 0x00
+}
+
+// return the Base58Check string for k
+func (k *ExtendedKey) String() string {
+	s, err := EncodeExtendedKey(k)
+	if err != nil {
+		return ""
+	}
+	return s
+}
+
+// check version, depth, parent and key fields for consistency
+func (k *ExtendedKey) validate() error {
+	private, ok := extendedKeyVersions[k.Version]
+	if !ok {
+		return fmt.Errorf("%w: unknown version %x", ErrInvalidExtendedKey, k.Version)
+	}
+	if k.Depth == 0 && (k.ParentFingerprint != [4]byte{} || k.ChildNumber != 0) {
+		return fmt.Errorf("%w: master key with non-zero parent fingerprint or child number", ErrInvalidExtendedKey)
+	}
+	switch k.Key[0] {
+	case 0x00:
+		if !private {
+			return fmt.Errorf("%w: private key with public version %x", ErrInvalidExtendedKey, k.Version)
+		}
+	case 0x02, 0x03:
+		if private {
+			return fmt.Errorf("%w: public key with private version %x", ErrInvalidExtendedKey, k.Version)
+		}
+	default:
+		return fmt.Errorf("%w: invalid key prefix %#02x", ErrInvalidExtendedKey, k.Key[0])
+	}
+	return nil
+}
+
+// validate and serialize k as a Base58Check string
+func EncodeExtendedKey(k *ExtendedKey) (string, error) {
+	if err := k.validate(); err != nil {
+		return "", err
+	}
+	b := make([]byte, 0, ExtendedKeyLen)
+	b = append(b, k.Depth)
+	b = append(b, k.ParentFingerprint[:]...)
+	b = binary.BigEndian.AppendUint32(b, k.ChildNumber)
+	b = append(b, k.ChainCode[:]...)
+	b = append(b, k.Key[:]...)
+	return CheckEncodeVersion(k.Version[:], b), nil
+}
+
+// decode, checksum-verify and validate a Base58Check extended key
+func DecodeExtendedKey(s string) (*ExtendedKey, error) {
+	decoded, err := CheckDecode(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(decoded) != ExtendedKeyLen {
+		return nil, fmt.Errorf("%w: length %d, want %d", ErrInvalidExtendedKey, len(decoded), ExtendedKeyLen)
+	}
+	k := new(ExtendedKey)
+	copy(k.Version[:], decoded[0:4])
+	k.Depth = decoded[4]
+	copy(k.ParentFingerprint[:], decoded[5:9])
+	k.ChildNumber = binary.BigEndian.Uint32(decoded[9:13])
+	copy(k.ChainCode[:], decoded[13:45])
+	copy(k.Key[:], decoded[45:78])
+	if err := k.validate(); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
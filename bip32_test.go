@@ -0,0 +1,69 @@
+package base58_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+// BIP32 test vector 1, chain m
+const (
+	testXpub = "xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8"
+	testXprv = "xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi"
+)
+
+func TestExtendedKey(t *testing.T) {
+	chainCode, _ := hex.DecodeString("873dff81c02f525623fd1fe5167eac3a55a049de3d314bb42ee227ffed37d508")
+	tests := []struct {
+		encoded string
+		key     string
+		private bool
+	}{
+		{testXpub, "0339a36013301597daef41fbe593a02cc513d0b55527ec2df1050e2e8ff49c85c2", false},
+		{testXprv, "00e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35", true},
+	}
+	for _, tt := range tests {
+		k, err := base58.DecodeExtendedKey(tt.encoded)
+		if err != nil {
+			t.Fatalf("DecodeExtendedKey(%q) failed: %v", tt.encoded, err)
+		}
+		testEqual(t, "Depth: got %d, want %d", byte(0), k.Depth)
+		testEqual(t, "ChildNumber: got %d, want %d", uint32(0), k.ChildNumber)
+		testEqual(t, "ChainCode: got %x, want %x", string(chainCode), string(k.ChainCode[:]))
+		testEqual(t, "Key: got %q, want %q", tt.key, hex.EncodeToString(k.Key[:]))
+		testEqual(t, "IsPrivate: got %v, want %v", tt.private, k.IsPrivate())
+
+		got, err := base58.EncodeExtendedKey(k)
+		if err != nil {
+			t.Fatalf("EncodeExtendedKey failed: %v", err)
+		}
+		testEqual(t, "EncodeExtendedKey: got %q, want %q", tt.encoded, got)
+	}
+}
+
+func TestExtendedKeyValidation(t *testing.T) {
+	k, err := base58.DecodeExtendedKey(testXpub)
+	if err != nil {
+		t.Fatalf("DecodeExtendedKey failed: %v", err)
+	}
+	mutations := map[string]func(k *base58.ExtendedKey){
+		"unknown version":     func(k *base58.ExtendedKey) { k.Version = [4]byte{1, 2, 3, 4} },
+		"master with parent":  func(k *base58.ExtendedKey) { k.ParentFingerprint[0] = 1 },
+		"master with child":   func(k *base58.ExtendedKey) { k.ChildNumber = 1 },
+		"bad key prefix":      func(k *base58.ExtendedKey) { k.Key[0] = 0x04 },
+		"private key in xpub": func(k *base58.ExtendedKey) { k.Key[0] = 0x00 },
+	}
+	for name, mutate := range mutations {
+		bad := *k
+		mutate(&bad)
+		if _, err := base58.EncodeExtendedKey(&bad); !errors.Is(err, base58.ErrInvalidExtendedKey) {
+			t.Errorf("EncodeExtendedKey(%s): got error %v, want %v", name, err, base58.ErrInvalidExtendedKey)
+		}
+	}
+	short := base58.CheckEncode(make([]byte, 77))
+	if _, err := base58.DecodeExtendedKey(short); !errors.Is(err, base58.ErrInvalidExtendedKey) {
+		t.Errorf("DecodeExtendedKey(77 bytes): got error %v, want %v", err, base58.ErrInvalidExtendedKey)
+	}
+}
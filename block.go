@@ -0,0 +1,281 @@
+package base58
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+/*
+Block Streaming Format
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+NewEncoder and NewDecoder buffer the whole payload, since base58 treats its
+input as one big number. The block format instead splits the input into
+fixed-size blocks and writes one self-delimiting frame per line:
+
+	base58(block || CRC-32(block)) '\n'
+
+so io.Copy over huge streams runs in constant memory and each frame can be
+decoded and verified on its own. The radix conversion is quadratic in the
+block size, smaller blocks are faster, larger blocks are more compact.
+*/
+
+const (
+	// block size used by NewBlockEncoder
+	DefaultBlockSize = 1024
+	// largest block size accepted by NewBlockEncoderSize and NewBlockDecoder
+	MaxBlockSize = 64 * 1024
+)
+
+var (
+	// block frame fails its CRC-32 check
+	ErrBlockChecksum = fmt.Errorf("%w: block", ErrChecksumMismatch)
+	// block frame is longer than a MaxBlockSize block can encode to
+	ErrFrameTooLong = errors.New("base58: block frame too long")
+)
+
+// checked encoding for block frames, formatting options that would break
+// the line framing are cleared
+func blockCheckEncoding(enc *Encoding) *CheckEncoding {
+	e := *enc
+	e.padWidth = 0
+	e.lineLen = 0
+	e.validators = nil
+	return NewCheckEncoding(&e, newCRC32, 4)
+}
+
+// longest frame a MaxBlockSize block encodes to in the given radix,
+// excluding the newline
+func maxFrameLen(base int) int {
+	return int(float64(MaxBlockSize+4)*8/math.Log2(float64(base))) + 2
+}
+
+// block stream encoder, see NewBlockEncoder
+type BlockEncoder struct {
+	ce     *CheckEncoding
+	w      io.Writer
+	buf    []byte
+	size   int
+	closed bool
+	err    error
+
+	// frame offsets and trailer flag, see NewBlockEncoderIndexed
+	index   *BlockIndex
+	trailer bool
+}
+
+// block stream encoder with DefaultBlockSize blocks
+func NewBlockEncoder(enc *Encoding, w io.Writer) *BlockEncoder {
+	return NewBlockEncoderSize(enc, w, DefaultBlockSize)
+}
+
+// block stream encoder with blocks of size bytes, 1 to MaxBlockSize
+func NewBlockEncoderSize(enc *Encoding, w io.Writer, size int) *BlockEncoder {
+	if size < 1 || size > MaxBlockSize {
+		panic("base58: block size must be between 1 and MaxBlockSize")
+	}
+	return &BlockEncoder{ce: blockCheckEncoding(enc), w: w, buf: make([]byte, 0, size), size: size}
+}
+
+// buffer p, writing a frame for every full block
+func (e *BlockEncoder) Write(p []byte) (int, error) {
+	return writeBlocks(e, p)
+}
+
+// buffer s, implementing io.StringWriter
+func (e *BlockEncoder) WriteString(s string) (int, error) {
+	return writeBlocks(e, s)
+}
+
+func writeBlocks[T string | []byte](e *BlockEncoder, p T) (int, error) {
+	if e.closed {
+		return 0, ErrClosed
+	}
+	if e.err != nil {
+		return 0, e.err
+	}
+	n := 0
+	for len(p) > 0 {
+		k := min(e.size-len(e.buf), len(p))
+		e.buf = append(e.buf, p[:k]...)
+		p = p[k:]
+		n += k
+		if len(e.buf) == e.size {
+			if err := e.writeFrame(); err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// buffer c, implementing io.ByteWriter
+func (e *BlockEncoder) WriteByte(c byte) error {
+	_, err := e.Write([]byte{c})
+	return err
+}
+
+// write the buffered partial block as a frame, so everything written so
+// far can be decoded by the other end, then flush w if it has a
+// Flush() error method (e.g. bufio.Writer). The encoder stays usable.
+func (e *BlockEncoder) Flush() error {
+	if e.closed {
+		return ErrClosed
+	}
+	if e.err == nil && len(e.buf) > 0 {
+		e.writeFrame()
+	}
+	if e.err != nil {
+		return e.err
+	}
+	if f, ok := e.w.(interface{ Flush() error }); ok {
+		e.err = f.Flush()
+	}
+	return e.err
+}
+
+// write the final partial block, if any, and the index trailer of an
+// indexed encoder. Close is idempotent and later writes fail with ErrClosed.
+func (e *BlockEncoder) Close() error {
+	if e.closed || e.err != nil {
+		e.closed = true
+		return e.err
+	}
+	e.closed = true
+	if len(e.buf) > 0 && e.writeFrame() != nil {
+		return e.err
+	}
+	if e.trailer {
+		return e.writeTrailer()
+	}
+	return nil
+}
+
+// encode and write the buffered block as one frame
+func (e *BlockEncoder) writeFrame() error {
+	frame := append([]byte(e.ce.EncodeToString(e.buf)), '\n')
+	if e.index != nil {
+		e.index.add(len(frame), len(e.buf))
+	}
+	e.buf = e.buf[:0]
+	if _, err := e.w.Write(frame); err != nil {
+		e.err = err
+	}
+	return e.err
+}
+
+// corrupt frame skipped by a resynchronizing block decoder
+type BlockError struct {
+	Frame  int   // index of the frame, counting non-blank lines from 0
+	Offset int64 // byte offset of the frame in the encoded stream
+	Err    error // ErrBlockChecksum, ErrFrameTooLong or a decode error
+}
+
+func (e *BlockError) Error() string {
+	return fmt.Sprintf("base58: frame %d at offset %d: %v", e.Frame, e.Offset, e.Err)
+}
+
+func (e *BlockError) Unwrap() error {
+	return e.Err
+}
+
+type blockDecoder struct {
+	ce        *CheckEncoding
+	r         *bufio.Reader
+	out       []byte
+	err       error
+	resync    bool
+	onCorrupt func(*BlockError)
+	frame     int
+	off       int64
+}
+
+// block stream decoder, each frame is verified before its block is
+// returned. Blank lines, CR and the '#' lines of an index trailer are
+// ignored.
+func NewBlockDecoder(enc *Encoding, r io.Reader) io.Reader {
+	return &blockDecoder{ce: blockCheckEncoding(enc), r: bufio.NewReaderSize(r, maxFrameLen(enc.base)+2)}
+}
+
+// block stream decoder that skips corrupt frames instead of failing,
+// resynchronizing on the next line, for recovering partially damaged
+// dumps. Each skipped frame is reported to onCorrupt, which may be nil.
+func NewBlockDecoderResync(enc *Encoding, r io.Reader, onCorrupt func(*BlockError)) io.Reader {
+	d := NewBlockDecoder(enc, r).(*blockDecoder)
+	d.resync, d.onCorrupt = true, onCorrupt
+	return d
+}
+
+// read decoded blocks, one frame at a time
+func (d *blockDecoder) Read(p []byte) (int, error) {
+	for len(d.out) == 0 {
+		if d.err != nil {
+			return 0, d.err
+		}
+		d.out, d.err = d.nextBlock()
+	}
+	n := copy(p, d.out)
+	d.out = d.out[n:]
+	return n, nil
+}
+
+// read and verify the next frame, skipping corrupt ones in resync mode
+func (d *blockDecoder) nextBlock() ([]byte, error) {
+	for {
+		block, err := d.readFrame()
+		var be *BlockError
+		if !errors.As(err, &be) {
+			return block, err
+		}
+		if !d.resync {
+			return nil, be.Err
+		}
+		if d.onCorrupt != nil {
+			d.onCorrupt(be)
+		}
+	}
+}
+
+// read and verify the next non-blank frame, corrupt frames are reported
+// as a *BlockError
+func (d *blockDecoder) readFrame() ([]byte, error) {
+	for {
+		start := d.off
+		line, err := d.r.ReadSlice('\n')
+		d.off += int64(len(line))
+		if err == bufio.ErrBufferFull {
+			// discard the rest of the oversized line
+			for err == bufio.ErrBufferFull {
+				line, err = d.r.ReadSlice('\n')
+				d.off += int64(len(line))
+			}
+			d.frame++
+			return nil, &BlockError{Frame: d.frame - 1, Offset: start, Err: ErrFrameTooLong}
+		}
+		if err != nil && (err != io.EOF || len(line) == 0) {
+			return nil, err
+		}
+		line = bytes.TrimRight(line, "\r\n")
+		if len(line) == 0 || line[0] == indexMarker && d.ce.enc.reverse[indexMarker] == -1 {
+			if err == io.EOF {
+				return nil, io.EOF
+			}
+			continue
+		}
+		d.frame++
+		block, derr := d.ce.DecodeString(string(line))
+		if errors.Is(derr, ErrChecksumMismatch) {
+			derr = ErrBlockChecksum
+		}
+		if derr != nil {
+			return nil, &BlockError{Frame: d.frame - 1, Offset: start, Err: derr}
+		}
+		return block, nil
+	}
+}
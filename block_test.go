@@ -0,0 +1,163 @@
+package base58_test
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestBlockEncoderDecoder(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 100, 1024, 1025, 5000} {
+		data := make([]byte, n)
+		rng.Read(data)
+		data = append(make([]byte, 3), data...) // leading zeros survive framing
+
+		var encoded bytes.Buffer
+		w := base58.NewBlockEncoderSize(base58.StdEncoding, &encoded, 512)
+		if _, err := io.Copy(w, bytes.NewReader(data)); err != nil {
+			t.Fatalf("block Encode(%d): %v", n, err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("block Close(%d): %v", n, err)
+		}
+		msg := fmt.Sprintf("block frames for %d bytes: got %%d, want %%d", len(data))
+		testEqual(t, msg, (len(data)+511)/512, strings.Count(encoded.String(), "\n"))
+
+		decoded, err := io.ReadAll(base58.NewBlockDecoder(base58.StdEncoding, &encoded))
+		if err != nil {
+			t.Fatalf("block Decode(%d): %v", n, err)
+		}
+		if !bytes.Equal(decoded, data) {
+			t.Errorf("block round trip of %d bytes failed", len(data))
+		}
+	}
+}
+
+func TestBlockDecoderFraming(t *testing.T) {
+	var encoded bytes.Buffer
+	w := base58.NewBlockEncoderSize(base58.StdEncoding, &encoded, 4)
+	io.WriteString(w, bigtest.decoded)
+	w.Close()
+	frames := strings.Split(strings.TrimSuffix(encoded.String(), "\n"), "\n")
+
+	// CRLF line endings, blank lines and a missing final newline are accepted
+	crlf := "\r\n" + strings.Join(frames, "\r\n\r\n")
+	decoded, err := io.ReadAll(base58.NewBlockDecoder(base58.StdEncoding, strings.NewReader(crlf)))
+	if err != nil || string(decoded) != bigtest.decoded {
+		t.Errorf("block Decode(CRLF): got %q, %v", decoded, err)
+	}
+
+	// blocks before a corrupt frame are returned, then the error
+	corrupt := []byte(frames[2])
+	corrupt[1] ^= 'z' ^ 'y'
+	if base58.StdEncoding.Validate(string(corrupt)) != nil {
+		corrupt[1] = 'z'
+	}
+	frames[2] = string(corrupt)
+	r := base58.NewBlockDecoder(base58.StdEncoding, strings.NewReader(strings.Join(frames, "\n")))
+	decoded, err = io.ReadAll(r)
+	if !errors.Is(err, base58.ErrBlockChecksum) || !errors.Is(err, base58.ErrChecksumMismatch) {
+		t.Errorf("block Decode(corrupt): got %v, want %v", err, base58.ErrBlockChecksum)
+	}
+	testEqual(t, "block Decode(corrupt) prefix: got %q, want %q", bigtest.decoded[:8], string(decoded))
+
+	long := strings.Repeat("2", 200000)
+	if _, err := io.ReadAll(base58.NewBlockDecoder(base58.StdEncoding, strings.NewReader(long))); err != base58.ErrFrameTooLong {
+		t.Errorf("block Decode(long frame): got %v, want %v", err, base58.ErrFrameTooLong)
+	}
+}
+
+func TestBlockEncoderFlush(t *testing.T) {
+	var encoded bytes.Buffer
+	bw := bufio.NewWriter(&encoded)
+	w := base58.NewBlockEncoder(base58.StdEncoding, bw)
+
+	io.WriteString(w, "sure.")
+	testEqual(t, "before Flush: got %d, want %d", 0, encoded.Len())
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush failed: %v", err)
+	}
+	// the flushed segment decodes on its own
+	decoded, err := io.ReadAll(base58.NewBlockDecoder(base58.StdEncoding, bytes.NewReader(encoded.Bytes())))
+	if err != nil || string(decoded) != "sure." {
+		t.Errorf("decode after Flush: got %q, %v", decoded, err)
+	}
+
+	io.WriteString(w, " sure?")
+	w.Flush()
+	w.Flush() // nothing buffered, no empty frame
+	w.Close()
+	testEqual(t, "frames after Flush: got %d, want %d", 2, strings.Count(encoded.String(), "\n"))
+	decoded, err = io.ReadAll(base58.NewBlockDecoder(base58.StdEncoding, &encoded))
+	if err != nil || string(decoded) != "sure. sure?" {
+		t.Errorf("decode of flushed stream: got %q, %v", decoded, err)
+	}
+}
+
+func TestBlockDecoderProgressive(t *testing.T) {
+	pr, pw := io.Pipe()
+	go func() {
+		w := base58.NewBlockEncoder(base58.StdEncoding, pw)
+		io.WriteString(w, "sure.")
+		w.Flush()
+		// the stream stays open until the first block has been read
+	}()
+	buf := make([]byte, 16)
+	done := make(chan int, 1)
+	go func() {
+		n, _ := base58.NewBlockDecoder(base58.StdEncoding, pr).Read(buf)
+		done <- n
+	}()
+	select {
+	case n := <-done:
+		testEqual(t, "progressive block Read: got %q, want %q", "sure.", string(buf[:n]))
+	case <-time.After(5 * time.Second):
+		t.Errorf("Timeout: block decoder waited for EOF")
+	}
+	pw.Close()
+}
+
+func TestBlockDecoderResync(t *testing.T) {
+	var encoded bytes.Buffer
+	w := base58.NewBlockEncoderSize(base58.StdEncoding, &encoded, 8)
+	io.WriteString(w, bigtest.decoded)
+	w.Close()
+	frames := strings.Split(strings.TrimSuffix(encoded.String(), "\n"), "\n")
+	frames[1] = "0" + frames[1][1:]         // invalid character
+	frames[2] = strings.Repeat("2", 200000) // oversized
+	frames[3] = frames[3][:len(frames[3])-1] + "z"
+	damaged := strings.Join(frames, "\n")
+
+	var skipped []*base58.BlockError
+	decoded, err := io.ReadAll(base58.NewBlockDecoderResync(base58.StdEncoding, strings.NewReader(damaged), func(e *base58.BlockError) {
+		skipped = append(skipped, e)
+	}))
+	if err != nil {
+		t.Fatalf("resync decode failed: %v", err)
+	}
+	testEqual(t, "resync output: got %q, want %q", bigtest.decoded[:8]+bigtest.decoded[32:], string(decoded))
+	if len(skipped) != 3 {
+		t.Fatalf("resync: got %d skipped frames, want 3", len(skipped))
+	}
+	for i, e := range skipped {
+		testEqual(t, "skipped frame index: got %d, want %d", i+1, e.Frame)
+		testEqual(t, "skipped frame offset: got %d, want %d", int64(strings.Index(damaged, frames[i+1])), e.Offset)
+	}
+	if !errors.Is(skipped[1], base58.ErrFrameTooLong) || !errors.Is(skipped[2], base58.ErrBlockChecksum) {
+		t.Errorf("resync errors: got %v, %v", skipped[1], skipped[2])
+	}
+
+	// without resync the first corrupt frame fails the stream
+	if _, err := io.ReadAll(base58.NewBlockDecoder(base58.StdEncoding, strings.NewReader(damaged))); err == nil {
+		t.Errorf("block Decode(damaged): expected error")
+	}
+}
@@ -0,0 +1,337 @@
+package base58
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"slices"
+	"sort"
+	"sync"
+)
+
+/*
+Seekable Block Index
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Frames of the block format decode independently, so with the offset of
+every frame a byte range of the payload can be served by decoding only the
+frames that overlap it. NewBlockEncoderIndexed records a BlockIndex while
+writing, and either appends it to the stream as a trailer or leaves it to
+the caller to store as a sidecar:
+
+	w := base58.NewBlockEncoderIndexed(base58.StdEncoding, f, base58.DefaultBlockSize, true)
+	...
+	r, err := base58.NewBlockReaderAt(base58.StdEncoding, f, size)
+	section := io.NewSectionReader(r, start, length)
+
+The trailer is a run of '#' lines at the end of the stream, each holding a
+chunk of the serialized index as base58(chunk || CRC-32), followed by a
+footer line holding the offset where the trailer starts, so a reader finds
+it from the end of the stream without scanning the frames. Block decoders
+skip '#' lines, so an indexed stream still decodes sequentially.
+*/
+
+// first byte of trailer lines
+const indexMarker = '#'
+
+// serialization format version of a BlockIndex
+const indexVersion = 1
+
+var (
+	// block index is malformed or does not match the stream
+	ErrInvalidIndex = errors.New("base58: invalid block index")
+	// block stream does not end with an index trailer
+	ErrNoIndex = errors.New("base58: block stream has no index")
+)
+
+var errNegativeOffset = errors.New("base58: negative offset")
+
+// frame offsets of a block stream, see NewBlockEncoderIndexed
+type BlockIndex struct {
+	offsets []int64 // encoded offset of each frame, then the end of the frames
+	starts  []int64 // payload offset of each block, then the payload size
+}
+
+func newBlockIndex() *BlockIndex {
+	return &BlockIndex{offsets: []int64{0}, starts: []int64{0}}
+}
+
+// record a frame of frameLen encoded bytes holding a block of blockLen bytes
+func (ix *BlockIndex) add(frameLen, blockLen int) {
+	ix.offsets = append(ix.offsets, ix.offsets[len(ix.offsets)-1]+int64(frameLen))
+	ix.starts = append(ix.starts, ix.starts[len(ix.starts)-1]+int64(blockLen))
+}
+
+// number of indexed frames
+func (ix *BlockIndex) Frames() int {
+	return len(ix.offsets) - 1
+}
+
+// length of the decoded payload
+func (ix *BlockIndex) Size() int64 {
+	return ix.starts[len(ix.starts)-1]
+}
+
+// length of the encoded frames, excluding any trailer
+func (ix *BlockIndex) EncodedSize() int64 {
+	return ix.offsets[len(ix.offsets)-1]
+}
+
+// return the encoded offset of frame i and the payload offset of its block,
+// Frame(Frames()) returns EncodedSize() and Size()
+func (ix *BlockIndex) Frame(i int) (offset, start int64) {
+	return ix.offsets[i], ix.starts[i]
+}
+
+// return the frame whose block holds payload offset off, or Frames() if
+// off is at or past the end of the payload
+func (ix *BlockIndex) Find(off int64) int {
+	return sort.Search(ix.Frames(), func(i int) bool { return ix.starts[i+1] > off })
+}
+
+// serialize ix as a version byte, the frame count and the frame and block
+// length of every frame as uvarints, followed by a CRC-32 of all of it,
+// implementing encoding.BinaryMarshaler
+func (ix *BlockIndex) MarshalBinary() ([]byte, error) {
+	b := []byte{indexVersion}
+	b = binary.AppendUvarint(b, uint64(ix.Frames()))
+	for i := 0; i < ix.Frames(); i++ {
+		b = binary.AppendUvarint(b, uint64(ix.offsets[i+1]-ix.offsets[i]))
+		b = binary.AppendUvarint(b, uint64(ix.starts[i+1]-ix.starts[i]))
+	}
+	return binary.BigEndian.AppendUint32(b, crc32.ChecksumIEEE(b)), nil
+}
+
+// parse an index serialized by MarshalBinary, implementing
+// encoding.BinaryUnmarshaler
+func (ix *BlockIndex) UnmarshalBinary(data []byte) error {
+	if len(data) < 5 {
+		return fmt.Errorf("%w: too short", ErrInvalidIndex)
+	}
+	body := data[:len(data)-4]
+	if crc32.ChecksumIEEE(body) != binary.BigEndian.Uint32(data[len(body):]) {
+		return fmt.Errorf("%w: %w", ErrInvalidIndex, ErrChecksumMismatch)
+	}
+	if body[0] != indexVersion {
+		return fmt.Errorf("%w: unknown version %d", ErrInvalidIndex, body[0])
+	}
+	body = body[1:]
+	frames, n := binary.Uvarint(body)
+	// every frame takes at least two bytes
+	if n <= 0 || frames > uint64(len(body)-n)/2 {
+		return fmt.Errorf("%w: bad frame count", ErrInvalidIndex)
+	}
+	body = body[n:]
+	next := newBlockIndex()
+	for i := uint64(0); i < frames; i++ {
+		frameLen, n := binary.Uvarint(body)
+		if n <= 0 || frameLen == 0 || frameLen > uint64(maxFrameLen(2)+2) {
+			return fmt.Errorf("%w: bad length of frame %d", ErrInvalidIndex, i)
+		}
+		body = body[n:]
+		blockLen, n := binary.Uvarint(body)
+		if n <= 0 || blockLen == 0 || blockLen > MaxBlockSize {
+			return fmt.Errorf("%w: bad block length of frame %d", ErrInvalidIndex, i)
+		}
+		body = body[n:]
+		next.add(int(frameLen), int(blockLen))
+	}
+	if len(body) != 0 {
+		return fmt.Errorf("%w: %d trailing bytes", ErrInvalidIndex, len(body))
+	}
+	*ix = *next
+	return nil
+}
+
+// block stream encoder with blocks of size bytes that records the offset
+// of every frame in a BlockIndex. With trailer set, Close appends the index
+// to the stream; otherwise store Index() as a sidecar. Panics if trailer is
+// set and '#' is in the alphabet of enc.
+func NewBlockEncoderIndexed(enc *Encoding, w io.Writer, size int, trailer bool) *BlockEncoder {
+	if trailer && enc.reverse[indexMarker] != -1 {
+		panic("base58: index trailer requires an alphabet without '#'")
+	}
+	e := NewBlockEncoderSize(enc, w, size)
+	e.index, e.trailer = newBlockIndex(), trailer
+	return e
+}
+
+// return a copy of the index of the frames written so far, or nil if the
+// encoder was not created by NewBlockEncoderIndexed
+func (e *BlockEncoder) Index() *BlockIndex {
+	if e.index == nil {
+		return nil
+	}
+	return &BlockIndex{offsets: slices.Clone(e.index.offsets), starts: slices.Clone(e.index.starts)}
+}
+
+// write the index as '#' lines of DefaultBlockSize chunks, then the footer
+func (e *BlockEncoder) writeTrailer() error {
+	b, _ := e.index.MarshalBinary()
+	var trailer []byte
+	for len(b) > 0 {
+		k := min(DefaultBlockSize, len(b))
+		trailer = appendIndexLine(trailer, e.ce, b[:k])
+		b = b[k:]
+	}
+	trailer = appendIndexLine(trailer, e.ce, binary.BigEndian.AppendUint64(nil, uint64(e.index.EncodedSize())))
+	if _, err := e.w.Write(trailer); err != nil {
+		e.err = err
+	}
+	return e.err
+}
+
+func appendIndexLine(b []byte, ce *CheckEncoding, chunk []byte) []byte {
+	b = append(b, indexMarker)
+	b = append(b, ce.EncodeToString(chunk)...)
+	return append(b, '\n')
+}
+
+// random access decoder over an indexed block stream, see NewBlockReaderAt
+type BlockReaderAt struct {
+	ce *CheckEncoding
+	r  io.ReaderAt
+	ix *BlockIndex
+
+	// most recently decoded block, for sequential reads
+	mu     sync.Mutex
+	cached int
+	block  []byte
+}
+
+// random access decoder over the indexed block stream of size encoded
+// bytes in r, reading the index from the trailer written by
+// NewBlockEncoderIndexed. Returns ErrNoIndex if the stream has no trailer.
+func NewBlockReaderAt(enc *Encoding, r io.ReaderAt, size int64) (*BlockReaderAt, error) {
+	ce := blockCheckEncoding(enc)
+	ix, err := readIndexTrailer(ce, r, size)
+	if err != nil {
+		return nil, err
+	}
+	return NewBlockReaderAtIndex(enc, r, ix), nil
+}
+
+// random access decoder over the block stream in r using a sidecar index
+func NewBlockReaderAtIndex(enc *Encoding, r io.ReaderAt, ix *BlockIndex) *BlockReaderAt {
+	return &BlockReaderAt{ce: blockCheckEncoding(enc), r: r, ix: ix, cached: -1}
+}
+
+// length of the decoded payload
+func (b *BlockReaderAt) Size() int64 {
+	return b.ix.Size()
+}
+
+// the index in use
+func (b *BlockReaderAt) Index() *BlockIndex {
+	return b.ix
+}
+
+// read len(p) payload bytes starting at payload offset off, decoding only
+// the frames that overlap them, implementing io.ReaderAt. A corrupt frame
+// is reported as a *BlockError.
+func (b *BlockReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errNegativeOffset
+	}
+	n := 0
+	for n < len(p) && off < b.ix.Size() {
+		i := b.ix.Find(off)
+		block, err := b.frame(i)
+		if err != nil {
+			return n, err
+		}
+		k := copy(p[n:], block[off-b.ix.starts[i]:])
+		n += k
+		off += int64(k)
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// return the verified block of frame i
+func (b *BlockReaderAt) frame(i int) ([]byte, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.cached == i {
+		return b.block, nil
+	}
+	start, end := b.ix.offsets[i], b.ix.offsets[i+1]
+	line := make([]byte, end-start)
+	if _, err := b.r.ReadAt(line, start); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	block, err := b.ce.DecodeString(string(bytes.TrimRight(line, "\r\n")))
+	if errors.Is(err, ErrChecksumMismatch) {
+		err = ErrBlockChecksum
+	}
+	if err == nil && int64(len(block)) != b.ix.starts[i+1]-b.ix.starts[i] {
+		err = fmt.Errorf("%w: frame holds %d bytes, index says %d", ErrInvalidIndex, len(block), b.ix.starts[i+1]-b.ix.starts[i])
+	}
+	if err != nil {
+		return nil, &BlockError{Frame: i, Offset: start, Err: err}
+	}
+	b.cached, b.block = i, block
+	return block, nil
+}
+
+// read the index trailer at the end of the size byte stream in r
+func readIndexTrailer(ce *CheckEncoding, r io.ReaderAt, size int64) (*BlockIndex, error) {
+	// the footer encodes 8 bytes and a CRC-32, at most 96 digits in base 2
+	tail := make([]byte, min(size, 128))
+	tailStart := size - int64(len(tail))
+	if _, err := r.ReadAt(tail, tailStart); err != nil && err != io.EOF {
+		return nil, err
+	}
+	tail = bytes.TrimRight(tail, "\r\n")
+	f := bytes.LastIndexByte(tail, '\n') + 1
+	footer := tail[f:]
+	if len(footer) == 0 || footer[0] != indexMarker || (f == 0 && tailStart > 0) {
+		return nil, ErrNoIndex
+	}
+	footerStart := tailStart + int64(f)
+	decoded, err := ce.DecodeString(string(footer[1:]))
+	if err != nil || len(decoded) != 8 {
+		return nil, fmt.Errorf("%w: bad trailer footer", ErrInvalidIndex)
+	}
+	trailerStart := binary.BigEndian.Uint64(decoded)
+	if trailerStart > uint64(footerStart) {
+		return nil, fmt.Errorf("%w: trailer offset %d past its footer", ErrInvalidIndex, trailerStart)
+	}
+	trailer := make([]byte, uint64(footerStart)-trailerStart)
+	if _, err := r.ReadAt(trailer, int64(trailerStart)); err != nil && err != io.EOF {
+		return nil, err
+	}
+	var data []byte
+	for _, line := range bytes.Split(trailer, []byte{'\n'}) {
+		line = bytes.TrimRight(line, "\r")
+		if len(line) == 0 {
+			continue
+		}
+		if line[0] != indexMarker {
+			return nil, fmt.Errorf("%w: frame inside the trailer", ErrInvalidIndex)
+		}
+		chunk, err := ce.DecodeString(string(line[1:]))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidIndex, err)
+		}
+		data = append(data, chunk...)
+	}
+	ix := new(BlockIndex)
+	if err := ix.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+	if ix.EncodedSize() != int64(trailerStart) {
+		return nil, fmt.Errorf("%w: frames end at %d, trailer starts at %d", ErrInvalidIndex, ix.EncodedSize(), trailerStart)
+	}
+	return ix, nil
+}
@@ -0,0 +1,197 @@
+package base58_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+// io.ReaderAt counting the bytes read through it
+type countingReaderAt struct {
+	r    io.ReaderAt
+	read int
+}
+
+func (c *countingReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	n, err := c.r.ReadAt(p, off)
+	c.read += n
+	return n, err
+}
+
+// encode data with an indexed encoder, flushing a partial block halfway
+func encodeIndexed(t *testing.T, data []byte, size int, trailer bool) ([]byte, *base58.BlockIndex) {
+	t.Helper()
+	var encoded bytes.Buffer
+	w := base58.NewBlockEncoderIndexed(base58.StdEncoding, &encoded, size, trailer)
+	half := len(data) / 2
+	w.Write(data[:half])
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+	w.Write(data[half:])
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	return encoded.Bytes(), w.Index()
+}
+
+func TestBlockReaderAt(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 100, 1000, 5000} {
+		data := make([]byte, n)
+		rng.Read(data)
+		encoded, ix := encodeIndexed(t, data, 256, true)
+
+		// sequential decoders skip the trailer
+		decoded, err := io.ReadAll(base58.NewBlockDecoder(base58.StdEncoding, bytes.NewReader(encoded)))
+		if err != nil || !bytes.Equal(decoded, data) {
+			t.Fatalf("block Decode(indexed %d bytes): got %d bytes, %v", n, len(decoded), err)
+		}
+
+		r, err := base58.NewBlockReaderAt(base58.StdEncoding, bytes.NewReader(encoded), int64(len(encoded)))
+		if err != nil {
+			t.Fatalf("NewBlockReaderAt(%d bytes): %v", n, err)
+		}
+		testEqual(t, "Size() = %v, want %v", int64(n), r.Size())
+		testEqual(t, "Frames() = %v, want %v", ix.Frames(), r.Index().Frames())
+		for i := 0; i < 50; i++ {
+			off := rng.Intn(n + 1)
+			end := off + rng.Intn(n-off+1)
+			got := make([]byte, end-off)
+			if k, err := r.ReadAt(got, int64(off)); err != nil || k != len(got) || !bytes.Equal(got, data[off:end]) {
+				t.Fatalf("ReadAt(%d:%d of %d): got %d bytes, %v", off, end, n, k, err)
+			}
+		}
+		section, err := io.ReadAll(io.NewSectionReader(r, 0, r.Size()))
+		if err != nil || !bytes.Equal(section, data) {
+			t.Errorf("SectionReader(%d bytes): got %d bytes, %v", n, len(section), err)
+		}
+	}
+}
+
+func TestBlockReaderAtPartial(t *testing.T) {
+	data := make([]byte, 10000)
+	rand.New(rand.NewSource(2)).Read(data)
+	encoded, ix := encodeIndexed(t, data, 512, true)
+	c := &countingReaderAt{r: bytes.NewReader(encoded)}
+	r, err := base58.NewBlockReaderAt(base58.StdEncoding, c, int64(len(encoded)))
+	if err != nil {
+		t.Fatalf("NewBlockReaderAt: %v", err)
+	}
+
+	// a range inside one block reads only its frame
+	c.read = 0
+	p := make([]byte, 100)
+	if _, err := r.ReadAt(p, 6100); err != nil || !bytes.Equal(p, data[6100:6200]) {
+		t.Fatalf("ReadAt(6100): %v", err)
+	}
+	frame := ix.Find(6100)
+	start, _ := ix.Frame(frame)
+	next, _ := ix.Frame(frame + 1)
+	testEqual(t, "bytes read = %v, want %v", int(next-start), c.read)
+
+	// reads past the end are short with io.EOF
+	p = make([]byte, 20)
+	k, err := r.ReadAt(p, int64(len(data)-5))
+	if k != 5 || err != io.EOF || !bytes.Equal(p[:5], data[len(data)-5:]) {
+		t.Errorf("ReadAt(end-5): got %d, %v, want 5, EOF", k, err)
+	}
+	if k, err := r.ReadAt(p, int64(len(data))); k != 0 || err != io.EOF {
+		t.Errorf("ReadAt(end): got %d, %v, want 0, EOF", k, err)
+	}
+	if _, err := r.ReadAt(p, -1); err == nil {
+		t.Error("ReadAt(-1): got nil error")
+	}
+}
+
+func TestBlockIndexSidecar(t *testing.T) {
+	data := []byte(strings.Repeat(bigtest.decoded, 20))
+	encoded, ix := encodeIndexed(t, data, 64, false)
+	if _, err := base58.NewBlockReaderAt(base58.StdEncoding, bytes.NewReader(encoded), int64(len(encoded))); !errors.Is(err, base58.ErrNoIndex) {
+		t.Errorf("NewBlockReaderAt(no trailer): got error %v, want %v", err, base58.ErrNoIndex)
+	}
+	testEqual(t, "EncodedSize() = %v, want %v", int64(len(encoded)), ix.EncodedSize())
+
+	sidecar, err := ix.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+	loaded := new(base58.BlockIndex)
+	if err := loaded.UnmarshalBinary(sidecar); err != nil {
+		t.Fatalf("UnmarshalBinary: %v", err)
+	}
+	r := base58.NewBlockReaderAtIndex(base58.StdEncoding, bytes.NewReader(encoded), loaded)
+	got := make([]byte, 100)
+	if _, err := r.ReadAt(got, 500); err != nil || !bytes.Equal(got, data[500:600]) {
+		t.Errorf("sidecar ReadAt(500): %v", err)
+	}
+
+	// damaged or truncated sidecars are rejected
+	for _, bad := range [][]byte{nil, sidecar[:len(sidecar)-1], append([]byte{2}, sidecar[1:]...)} {
+		if err := new(base58.BlockIndex).UnmarshalBinary(bad); !errors.Is(err, base58.ErrInvalidIndex) {
+			t.Errorf("UnmarshalBinary(%x): got error %v, want %v", bad, err, base58.ErrInvalidIndex)
+		}
+	}
+	if base58.NewBlockEncoder(base58.StdEncoding, io.Discard).Index() != nil {
+		t.Error("Index() of an unindexed encoder: got non-nil")
+	}
+}
+
+func TestBlockReaderAtCorrupt(t *testing.T) {
+	data := make([]byte, 2000)
+	rand.New(rand.NewSource(3)).Read(data)
+	encoded, ix := encodeIndexed(t, data, 256, true)
+
+	// damage a digit of frame 2
+	start, _ := ix.Frame(2)
+	corrupt := bytes.Clone(encoded)
+	if corrupt[start+5] == '2' {
+		corrupt[start+5] = '3'
+	} else {
+		corrupt[start+5] = '2'
+	}
+	r, err := base58.NewBlockReaderAt(base58.StdEncoding, bytes.NewReader(corrupt), int64(len(corrupt)))
+	if err != nil {
+		t.Fatalf("NewBlockReaderAt: %v", err)
+	}
+	p := make([]byte, 10)
+	if _, err := r.ReadAt(p, 0); err != nil {
+		t.Errorf("ReadAt(frame 0) of a stream with a corrupt frame 2: %v", err)
+	}
+	_, blockStart := ix.Frame(2)
+	var be *base58.BlockError
+	if _, err := r.ReadAt(p, blockStart); !errors.As(err, &be) || !errors.Is(err, base58.ErrBlockChecksum) {
+		t.Fatalf("ReadAt(frame 2): got error %v, want %v", err, base58.ErrBlockChecksum)
+	}
+	testEqual(t, "BlockError.Frame = %v, want %v", 2, be.Frame)
+	testEqual(t, "BlockError.Offset = %v, want %v", start, be.Offset)
+
+	// a damaged footer or trailer is reported
+	footer := bytes.Clone(encoded)
+	footer[len(footer)-3]++
+	if _, err := base58.NewBlockReaderAt(base58.StdEncoding, bytes.NewReader(footer), int64(len(footer))); !errors.Is(err, base58.ErrInvalidIndex) {
+		t.Errorf("NewBlockReaderAt(damaged footer): got error %v, want %v", err, base58.ErrInvalidIndex)
+	}
+	trailer := bytes.Clone(encoded)
+	trailer[ix.EncodedSize()+3]++
+	if _, err := base58.NewBlockReaderAt(base58.StdEncoding, bytes.NewReader(trailer), int64(len(trailer))); !errors.Is(err, base58.ErrInvalidIndex) {
+		t.Errorf("NewBlockReaderAt(damaged trailer): got error %v, want %v", err, base58.ErrInvalidIndex)
+	}
+}
+
+func TestNewBlockEncoderIndexedAlphabet(t *testing.T) {
+	enc := base58.NewEncoding("#23456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz")
+	// a sidecar index works with any alphabet
+	base58.NewBlockEncoderIndexed(enc, io.Discard, 64, false)
+	defer func() {
+		if recover() == nil {
+			t.Error("NewBlockEncoderIndexed(trailer, '#' in alphabet): did not panic")
+		}
+	}()
+	base58.NewBlockEncoderIndexed(enc, io.Discard, 64, true)
+}
@@ -0,0 +1,124 @@
+package btcaddr
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+/*
+Bech32 SegWit Address Encoding
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Minimal encoder for native SegWit addresses: a human-readable part ("bc",
+"tb", ...), the separator '1', the witness version and the 5-bit regrouped
+witness program, followed by a 6-character BCH checksum. Version 0 programs
+use the bech32 checksum of BIP173, versions 1 to 16 the bech32m checksum of
+BIP350. Decoding is left to full Bitcoin libraries.
+*/
+
+// witness program or human-readable part cannot form a SegWit address
+var ErrInvalidWitness = errors.New("btcaddr: invalid witness program")
+
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// checksum constants XORed into the polymod
+const (
+	bech32Const  = 1
+	bech32mConst = 0x2bc830a3
+)
+
+// BCH checksum generator over GF(32)
+func bech32Polymod(values []byte) uint32 {
+	gen := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if top>>i&1 == 1 {
+				chk ^= gen[i]
+			}
+		}
+	}
+	return chk
+}
+
+// encode a bech32 or bech32m string from hrp and 5-bit data values
+func bech32Encode(hrp string, data []byte, constant uint32) string {
+	values := make([]byte, 0, 2*len(hrp)+1+len(data)+6)
+	for i := 0; i < len(hrp); i++ {
+		values = append(values, hrp[i]>>5)
+	}
+	values = append(values, 0)
+	for i := 0; i < len(hrp); i++ {
+		values = append(values, hrp[i]&31)
+	}
+	values = append(values, data...)
+	values = append(values, 0, 0, 0, 0, 0, 0)
+	chk := bech32Polymod(values) ^ constant
+
+	var b strings.Builder
+	b.Grow(len(hrp) + 1 + len(data) + 6)
+	b.WriteString(hrp)
+	b.WriteByte('1')
+	for _, v := range data {
+		b.WriteByte(bech32Charset[v])
+	}
+	for i := 0; i < 6; i++ {
+		b.WriteByte(bech32Charset[chk>>(5*(5-i))&31])
+	}
+	return b.String()
+}
+
+// regroup 8-bit bytes into 5-bit values, zero-padding the last group
+func toBase32(src []byte) []byte {
+	out := make([]byte, 0, (len(src)*8+4)/5)
+	var acc uint32
+	bits := 0
+	for _, c := range src {
+		acc = acc<<8 | uint32(c)
+		bits += 8
+		for bits >= 5 {
+			bits -= 5
+			out = append(out, byte(acc>>bits)&31)
+		}
+	}
+	if bits > 0 {
+		out = append(out, byte(acc<<(5-bits))&31)
+	}
+	return out
+}
+
+// encode a native SegWit address for witness version 0 to 16 and its
+// program, e.g. EncodeSegWit("bc", 0, hash160) for P2WPKH
+func EncodeSegWit(hrp string, version int, program []byte) (string, error) {
+	if hrp == "" || len(hrp) > 83 {
+		return "", fmt.Errorf("%w: human-readable part length %d", ErrInvalidWitness, len(hrp))
+	}
+	for i := 0; i < len(hrp); i++ {
+		if c := hrp[i]; c < 33 || c > 126 || ('A' <= c && c <= 'Z') {
+			return "", fmt.Errorf("%w: human-readable part %q", ErrInvalidWitness, hrp)
+		}
+	}
+	if version < 0 || version > 16 {
+		return "", fmt.Errorf("%w: version %d", ErrInvalidWitness, version)
+	}
+	if len(program) < 2 || len(program) > 40 {
+		return "", fmt.Errorf("%w: program length %d", ErrInvalidWitness, len(program))
+	}
+	constant := uint32(bech32mConst)
+	if version == 0 {
+		if len(program) != Hash160Len && len(program) != ScriptHashLen {
+			return "", fmt.Errorf("%w: version 0 program length %d, want %d or %d", ErrInvalidWitness, len(program), Hash160Len, ScriptHashLen)
+		}
+		constant = bech32Const
+	}
+	data := append([]byte{byte(version)}, toBase32(program)...)
+	if len(hrp)+1+len(data)+6 > 90 {
+		return "", fmt.Errorf("%w: address longer than 90 characters", ErrInvalidWitness)
+	}
+	return bech32Encode(hrp, data, constant), nil
+}
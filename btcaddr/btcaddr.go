@@ -0,0 +1,118 @@
+package btcaddr
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cyclone-github/base58"
+)
+
+/*
+Bitcoin Legacy Address Encoding (P2PKH/P2SH)
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Legacy Bitcoin addresses are the Base58Check encoding of a 1-byte network
+version followed by a 20-byte hash160 of a public key (P2PKH) or redeem
+script (P2SH).
+*/
+
+// length of a hash160 digest in bytes
+const Hash160Len = 20
+
+var (
+	// address is not a well-formed legacy address
+	ErrInvalidAddress = errors.New("btcaddr: invalid address")
+	// address version does not belong to a registered network
+	ErrUnknownVersion = base58.ErrUnknownNetwork
+)
+
+// address script type
+type Type int
+
+const (
+	P2PKH Type = iota // pay to public key hash
+	P2SH              // pay to script hash
+)
+
+// return the script type name
+func (t Type) String() string {
+	switch t {
+	case P2PKH:
+		return "p2pkh"
+	case P2SH:
+		return "p2sh"
+	}
+	return fmt.Sprintf("Type(%d)", int(t))
+}
+
+// bitcoin networks, other networks are taken from the base58 registry
+var (
+	MainNet = base58.BitcoinMainNet
+	TestNet = base58.BitcoinTestNet
+)
+
+// decoded legacy address
+type Address struct {
+	Type    Type
+	Network *base58.Network
+	Hash160 [Hash160Len]byte
+}
+
+// return the Base58Check string for a
+func (a *Address) String() string {
+	version := a.Network.PubKeyHashAddrID
+	if a.Type == P2SH {
+		version = a.Network.ScriptHashAddrID
+	}
+	return base58.CheckEncodeVersion(version, a.Hash160[:])
+}
+
+// encode a pay-to-pubkey-hash address from a 20-byte hash160
+func EncodeP2PKH(hash160 []byte, net *base58.Network) (string, error) {
+	return encode(hash160, net, P2PKH)
+}
+
+// encode a pay-to-script-hash address from a 20-byte hash160
+func EncodeP2SH(hash160 []byte, net *base58.Network) (string, error) {
+	return encode(hash160, net, P2SH)
+}
+
+func encode(hash160 []byte, net *base58.Network, t Type) (string, error) {
+	if len(hash160) != Hash160Len {
+		return "", fmt.Errorf("%w: hash160 length %d, want %d", ErrInvalidAddress, len(hash160), Hash160Len)
+	}
+	a := &Address{Type: t, Network: net}
+	copy(a.Hash160[:], hash160)
+	return a.String(), nil
+}
+
+// decode and checksum-verify a legacy address and identify its type and
+// registered network
+func ParseAddress(s string) (*Address, error) {
+	net, kind, payload, err := base58.CheckDecodeNetwork(s)
+	if err != nil {
+		return nil, err
+	}
+	a := &Address{Network: net}
+	switch kind {
+	case base58.PubKeyHash:
+		a.Type = P2PKH
+	case base58.ScriptHash:
+		a.Type = P2SH
+	default:
+		return nil, fmt.Errorf("%w: %s version", ErrInvalidAddress, kind)
+	}
+	if len(payload) != Hash160Len {
+		return nil, fmt.Errorf("%w: payload length %d, want %d", ErrInvalidAddress, len(payload), Hash160Len)
+	}
+	copy(a.Hash160[:], payload)
+	return a, nil
+}
+
+// report nil if s is a valid legacy address on a registered network
+func Validate(s string) error {
+	_, err := ParseAddress(s)
+	return err
+}
@@ -0,0 +1,82 @@
+package btcaddr_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+	"github.com/cyclone-github/base58/btcaddr"
+)
+
+func TestAddress(t *testing.T) {
+	tests := []struct {
+		hash    string
+		typ     btcaddr.Type
+		net     *base58.Network
+		encoded string
+	}{
+		{"010966776006953d5567439e5e39f86a0d273bee", btcaddr.P2PKH, btcaddr.MainNet, "16UwLL9Risc3QfPqBUvKofHmBQ7wMtjvM"},
+		{"010966776006953d5567439e5e39f86a0d273bee", btcaddr.P2PKH, btcaddr.TestNet, "mfcSEPR8EkJrpX91YkTJ9iscdAzppJrG9j"},
+		{"f815b036d9bbbce5e9f2a00abd1bf3dc91e95510", btcaddr.P2SH, btcaddr.MainNet, "3QJmV3qfvL9SuYo34YihAf3sRCW3qSinyC"},
+		{"f815b036d9bbbce5e9f2a00abd1bf3dc91e95510", btcaddr.P2SH, btcaddr.TestNet, "2NFryYnmhXneo7LRajgLZnc38dYiDePvf3G"},
+	}
+	for _, tt := range tests {
+		hash, _ := hex.DecodeString(tt.hash)
+		var got string
+		var err error
+		if tt.typ == btcaddr.P2PKH {
+			got, err = btcaddr.EncodeP2PKH(hash, tt.net)
+		} else {
+			got, err = btcaddr.EncodeP2SH(hash, tt.net)
+		}
+		if err != nil {
+			t.Fatalf("encode %s failed: %v", tt.typ, err)
+		}
+		if got != tt.encoded {
+			t.Errorf("encode %s/%s: got %q, want %q", tt.typ, tt.net.Name, got, tt.encoded)
+		}
+
+		a, err := btcaddr.ParseAddress(tt.encoded)
+		if err != nil {
+			t.Fatalf("ParseAddress(%q) failed: %v", tt.encoded, err)
+		}
+		if a.Type != tt.typ || a.Network != tt.net || hex.EncodeToString(a.Hash160[:]) != tt.hash {
+			t.Errorf("ParseAddress(%q): got %s/%s/%x, want %s/%s/%s", tt.encoded, a.Type, a.Network.Name, a.Hash160, tt.typ, tt.net.Name, tt.hash)
+		}
+		if err := btcaddr.Validate(tt.encoded); err != nil {
+			t.Errorf("Validate(%q): %v", tt.encoded, err)
+		}
+	}
+}
+
+func TestParseAddressRegistry(t *testing.T) {
+	ltc := base58.CheckEncodeVersion(base58.LitecoinMainNet.ScriptHashAddrID, make([]byte, 20))
+	a, err := btcaddr.ParseAddress(ltc)
+	if err != nil {
+		t.Fatalf("ParseAddress(%q) failed: %v", ltc, err)
+	}
+	if a.Type != btcaddr.P2SH || a.Network != base58.LitecoinMainNet {
+		t.Errorf("ParseAddress(%q): got %s/%s, want p2sh/ltc", ltc, a.Type, a.Network.Name)
+	}
+}
+
+func TestAddressErrors(t *testing.T) {
+	if _, err := btcaddr.EncodeP2PKH(make([]byte, 19), btcaddr.MainNet); !errors.Is(err, btcaddr.ErrInvalidAddress) {
+		t.Errorf("EncodeP2PKH(19 bytes): got error %v, want %v", err, btcaddr.ErrInvalidAddress)
+	}
+	tests := []struct {
+		in   string
+		want error
+	}{
+		{"16UwLL9Risc3QfPqBUvKofHmBQ7wMtjvN", base58.ErrChecksumMismatch},
+		{base58.CheckEncodeVersion([]byte{0x00}, make([]byte, 21)), btcaddr.ErrInvalidAddress},
+		{base58.CheckEncodeVersion([]byte{0xfe}, make([]byte, 20)), btcaddr.ErrUnknownVersion},
+		{"KwdMAjGmerYanjeui5SHS7JkmpZvVipYvB2LJGU1ZxJwYvP98617", btcaddr.ErrInvalidAddress},
+	}
+	for _, tt := range tests {
+		if err := btcaddr.Validate(tt.in); !errors.Is(err, tt.want) {
+			t.Errorf("Validate(%q): got error %v, want %v", tt.in, err, tt.want)
+		}
+	}
+}
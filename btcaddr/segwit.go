@@ -0,0 +1,126 @@
+package btcaddr
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/cyclone-github/base58"
+	"golang.org/x/crypto/ripemd160"
+)
+
+/*
+Legacy to SegWit Conversion
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+A P2PKH address and its native SegWit P2WPKH counterpart commit to the same
+hash160 of the public key, so ToSegWit converts one into the other directly:
+
+	addr, err := btcaddr.ToSegWit("1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH")
+	// bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4
+
+P2SH is different. Its hash160 commits to a redeem script, while P2WSH
+commits to the SHA256 of the witness script, and one cannot be computed
+from the other. ConvertP2SH therefore takes the redeem script, checks it
+against the address and returns the P2WSH address for it, or the native
+P2WPKH/P2WSH address when the script is a nested SegWit program
+(P2SH-P2WPKH, P2SH-P2WSH).
+*/
+
+// length of a P2WSH witness script hash in bytes
+const ScriptHashLen = sha256.Size
+
+var (
+	// network has no SegWit human-readable part
+	ErrNoSegWit = errors.New("btcaddr: network has no segwit addresses")
+	// P2SH address cannot be converted without its redeem script
+	ErrScriptRequired = errors.New("btcaddr: p2sh conversion requires the redeem script")
+	// redeem script does not hash to the address
+	ErrScriptMismatch = errors.New("btcaddr: redeem script does not match address")
+)
+
+// bech32 human-readable parts of the registered networks with SegWit
+var segwitHRPs = map[*base58.Network]string{
+	base58.BitcoinMainNet:  "bc",
+	base58.BitcoinTestNet:  "tb",
+	base58.LitecoinMainNet: "ltc",
+}
+
+// return the bech32 human-readable part of net, ErrNoSegWit if it has none
+func SegWitHRP(net *base58.Network) (string, error) {
+	if hrp, ok := segwitHRPs[net]; ok {
+		return hrp, nil
+	}
+	name := "<nil>"
+	if net != nil {
+		name = net.Name
+	}
+	return "", fmt.Errorf("%w: %s", ErrNoSegWit, name)
+}
+
+// encode a native pay-to-witness-pubkey-hash address from a 20-byte hash160
+func EncodeP2WPKH(hash160 []byte, net *base58.Network) (string, error) {
+	if len(hash160) != Hash160Len {
+		return "", fmt.Errorf("%w: hash160 length %d, want %d", ErrInvalidAddress, len(hash160), Hash160Len)
+	}
+	return encodeWitness(hash160, net)
+}
+
+// encode a native pay-to-witness-script-hash address for a witness script
+func EncodeP2WSH(script []byte, net *base58.Network) (string, error) {
+	h := sha256.Sum256(script)
+	return encodeWitness(h[:], net)
+}
+
+func encodeWitness(program []byte, net *base58.Network) (string, error) {
+	hrp, err := SegWitHRP(net)
+	if err != nil {
+		return "", err
+	}
+	return EncodeSegWit(hrp, 0, program)
+}
+
+// convert a legacy P2PKH address to the P2WPKH address with the same
+// hash160 on the same network, P2SH addresses return ErrScriptRequired
+func ToSegWit(s string) (string, error) {
+	a, err := ParseAddress(s)
+	if err != nil {
+		return "", err
+	}
+	return a.SegWit()
+}
+
+// return the P2WPKH address with the same hash160 as P2PKH address a
+func (a *Address) SegWit() (string, error) {
+	if a.Type != P2PKH {
+		return "", fmt.Errorf("%w: %s address", ErrScriptRequired, a.Type)
+	}
+	return EncodeP2WPKH(a.Hash160[:], a.Network)
+}
+
+// convert a legacy P2SH address with redeem script to its native SegWit
+// equivalent: the inner program for nested P2SH-P2WPKH and P2SH-P2WSH
+// scripts, otherwise the P2WSH address of the script
+func ConvertP2SH(s string, redeemScript []byte) (string, error) {
+	a, err := ParseAddress(s)
+	if err != nil {
+		return "", err
+	}
+	if a.Type != P2SH {
+		return "", fmt.Errorf("%w: %s address is not p2sh", ErrInvalidAddress, a.Type)
+	}
+	h := ripemd160.New()
+	sum := sha256.Sum256(redeemScript)
+	h.Write(sum[:])
+	if !bytes.Equal(h.Sum(nil), a.Hash160[:]) {
+		return "", ErrScriptMismatch
+	}
+	// OP_0 followed by a push of a 20- or 32-byte witness program
+	if n := len(redeemScript) - 2; n > 0 && redeemScript[0] == 0x00 && int(redeemScript[1]) == n && (n == Hash160Len || n == ScriptHashLen) {
+		return encodeWitness(redeemScript[2:], a.Network)
+	}
+	return EncodeP2WSH(redeemScript, a.Network)
+}
@@ -0,0 +1,154 @@
+package btcaddr_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+	"github.com/cyclone-github/base58/btcaddr"
+	"golang.org/x/crypto/ripemd160"
+)
+
+func hash160(b []byte) []byte {
+	sum := sha256.Sum256(b)
+	h := ripemd160.New()
+	h.Write(sum[:])
+	return h.Sum(nil)
+}
+
+func mustHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func TestEncodeSegWit(t *testing.T) {
+	// BIP173 and BIP350 vectors
+	tests := []struct {
+		hrp     string
+		version int
+		program string
+		want    string
+	}{
+		{"bc", 0, "751e76e8199196d454941c45d1b3a323f1433bd6", "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"},
+		{"tb", 0, "1863143c14c5166804bd19203356da136c985678cd4d27a1b8c6329604903262", "tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q0sl5k7"},
+		{"bc", 1, "751e76e8199196d454941c45d1b3a323f1433bd6751e76e8199196d454941c45d1b3a323f1433bd6", "bc1pw508d6qejxtdg4y5r3zarvary0c5xw7kw508d6qejxtdg4y5r3zarvary0c5xw7kt5nd6y"},
+		{"bc", 16, "751e", "bc1sw50qgdz25j"},
+		{"tb", 0, "000000c4a5cad46221b2a187905e5266362b99d5e91c6ce24d165dab93e86433", "tb1qqqqqp399et2xygdj5xreqhjjvcmzhxw4aywxecjdzew6hylgvsesrxh6hy"},
+	}
+	for _, tt := range tests {
+		got, err := btcaddr.EncodeSegWit(tt.hrp, tt.version, mustHex(tt.program))
+		if err != nil {
+			t.Fatalf("EncodeSegWit(%q, %d, %s) failed: %v", tt.hrp, tt.version, tt.program, err)
+		}
+		if got != tt.want {
+			t.Errorf("EncodeSegWit(%q, %d, %s): got %q, want %q", tt.hrp, tt.version, tt.program, got, tt.want)
+		}
+	}
+
+	errTests := []struct {
+		hrp     string
+		version int
+		program []byte
+	}{
+		{"", 0, make([]byte, 20)},
+		{"BC", 0, make([]byte, 20)},
+		{"bc", 17, make([]byte, 20)},
+		{"bc", 0, make([]byte, 21)},
+		{"bc", 1, make([]byte, 1)},
+		{"bc", 1, make([]byte, 41)},
+	}
+	for _, tt := range errTests {
+		if _, err := btcaddr.EncodeSegWit(tt.hrp, tt.version, tt.program); !errors.Is(err, btcaddr.ErrInvalidWitness) {
+			t.Errorf("EncodeSegWit(%q, %d, %d bytes): got error %v, want %v", tt.hrp, tt.version, len(tt.program), err, btcaddr.ErrInvalidWitness)
+		}
+	}
+}
+
+func TestToSegWit(t *testing.T) {
+	tests := []struct {
+		legacy string
+		want   string
+	}{
+		{"1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"},
+		{"mrCDrCybB6J1vRfbwM5hemdJz73FwDBC8r", "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx"},
+	}
+	for _, tt := range tests {
+		got, err := btcaddr.ToSegWit(tt.legacy)
+		if err != nil {
+			t.Fatalf("ToSegWit(%q) failed: %v", tt.legacy, err)
+		}
+		if got != tt.want {
+			t.Errorf("ToSegWit(%q): got %q, want %q", tt.legacy, got, tt.want)
+		}
+	}
+
+	errTests := []struct {
+		in   string
+		want error
+	}{
+		{"3QJmV3qfvL9SuYo34YihAf3sRCW3qSinyC", btcaddr.ErrScriptRequired},
+		{base58.CheckEncodeVersion(base58.DogecoinMainNet.PubKeyHashAddrID, make([]byte, 20)), btcaddr.ErrNoSegWit},
+		{"1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMJ", base58.ErrChecksumMismatch},
+	}
+	for _, tt := range errTests {
+		if _, err := btcaddr.ToSegWit(tt.in); !errors.Is(err, tt.want) {
+			t.Errorf("ToSegWit(%q): got error %v, want %v", tt.in, err, tt.want)
+		}
+	}
+}
+
+func TestConvertP2SH(t *testing.T) {
+	// pubkey OP_CHECKSIG, the BIP173 P2WSH example script
+	script := mustHex("210279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798ac")
+	nestedWPKH := mustHex("0014751e76e8199196d454941c45d1b3a323f1433bd6")
+	wsh := sha256.Sum256(script)
+	nestedWSH := append([]byte{0x00, 0x20}, wsh[:]...)
+	tests := []struct {
+		script []byte
+		net    *base58.Network
+		want   string
+	}{
+		{script, btcaddr.MainNet, "bc1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qccfmv3"},
+		{script, btcaddr.TestNet, "tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q0sl5k7"},
+		{nestedWPKH, btcaddr.MainNet, "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"},
+		{nestedWSH, btcaddr.MainNet, "bc1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qccfmv3"},
+	}
+	for _, tt := range tests {
+		p2sh, err := btcaddr.EncodeP2SH(hash160(tt.script), tt.net)
+		if err != nil {
+			t.Fatalf("EncodeP2SH failed: %v", err)
+		}
+		got, err := btcaddr.ConvertP2SH(p2sh, tt.script)
+		if err != nil {
+			t.Fatalf("ConvertP2SH(%q) failed: %v", p2sh, err)
+		}
+		if got != tt.want {
+			t.Errorf("ConvertP2SH(%q, %x): got %q, want %q", p2sh, tt.script, got, tt.want)
+		}
+	}
+
+	p2sh, _ := btcaddr.EncodeP2SH(hash160(script), btcaddr.MainNet)
+	if _, err := btcaddr.ConvertP2SH(p2sh, nestedWPKH); !errors.Is(err, btcaddr.ErrScriptMismatch) {
+		t.Errorf("ConvertP2SH with wrong script: got error %v, want %v", err, btcaddr.ErrScriptMismatch)
+	}
+	if _, err := btcaddr.ConvertP2SH(p2sh, nil); !errors.Is(err, btcaddr.ErrScriptMismatch) {
+		t.Errorf("ConvertP2SH with empty script: got error %v, want %v", err, btcaddr.ErrScriptMismatch)
+	}
+	if _, err := btcaddr.ConvertP2SH("1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", script); !errors.Is(err, btcaddr.ErrInvalidAddress) {
+		t.Errorf("ConvertP2SH of p2pkh: got error %v, want %v", err, btcaddr.ErrInvalidAddress)
+	}
+}
+
+func TestSegWitHRP(t *testing.T) {
+	if hrp, err := btcaddr.SegWitHRP(base58.LitecoinMainNet); err != nil || hrp != "ltc" {
+		t.Errorf("SegWitHRP(ltc): got %q, %v, want \"ltc\"", hrp, err)
+	}
+	if _, err := btcaddr.SegWitHRP(nil); !errors.Is(err, btcaddr.ErrNoSegWit) {
+		t.Errorf("SegWitHRP(nil): got error %v, want %v", err, btcaddr.ErrNoSegWit)
+	}
+}
@@ -0,0 +1,71 @@
+package base58
+
+/*
+Text Marshaling
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Bytes is a []byte that implements encoding.TextMarshaler and
+encoding.TextUnmarshaler with StdEncoding, so struct fields serialize as
+base58 in any text-based encoder instead of each encoder's byte default:
+
+	type Wallet struct {
+		PubKey base58.Bytes `json:"pubkey"`
+	}
+
+BytesWith selects another encoding through a zero-size type parameter,
+e.g. BytesWith[Ripple], or a caller type with an Encoding method for custom
+alphabets.
+*/
+
+// selects the encoding of a BytesWith value, implemented by zero-size
+// marker types so the choice is part of the field's type
+type EncodingSelector interface {
+	Encoding() *Encoding
+}
+
+// selectors for the predefined encodings
+type (
+	Bitcoin struct{}
+	Ripple  struct{}
+	Flickr  struct{}
+	GMP     struct{}
+)
+
+func (Bitcoin) Encoding() *Encoding { return StdEncoding }
+func (Ripple) Encoding() *Encoding  { return RippleEncoding }
+func (Flickr) Encoding() *Encoding  { return FlickrEncoding }
+func (GMP) Encoding() *Encoding     { return GMPEncoding }
+
+// byte slice marshaled as text with the encoding selected by S
+type BytesWith[S EncodingSelector] []byte
+
+// byte slice marshaled as StdEncoding text
+type Bytes = BytesWith[Bitcoin]
+
+// return the encoding selected by S
+func (b BytesWith[S]) encoding() *Encoding {
+	var s S
+	return s.Encoding()
+}
+
+// encode b, implementing encoding.TextMarshaler
+func (b BytesWith[S]) MarshalText() ([]byte, error) {
+	return b.encoding().EncodeToBytes(b), nil
+}
+
+// decode text into b, implementing encoding.TextUnmarshaler
+func (b *BytesWith[S]) UnmarshalText(text []byte) error {
+	decoded, err := b.encoding().DecodeToBytes(text)
+	if err != nil {
+		return err
+	}
+	*b = decoded
+	return nil
+}
+
+// return the encoded form of b
+func (b BytesWith[S]) String() string {
+	return b.encoding().EncodeToString(b)
+}
@@ -0,0 +1,71 @@
+package base58_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+// custom alphabet selector for BytesWith
+type base36 struct{}
+
+var base36Encoding = base58.NewRadixEncoding("0123456789abcdefghijklmnopqrstuvwxyz")
+
+func (base36) Encoding() *base58.Encoding { return base36Encoding }
+
+func TestBytesText(t *testing.T) {
+	b := base58.Bytes("sure.")
+	text, err := b.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	testEqual(t, "Bytes.MarshalText: got %q, want %q", "E2XFRyo", string(text))
+	testEqual(t, "Bytes.String: got %q, want %q", "E2XFRyo", b.String())
+	testEqual(t, "Bytes %%v: got %q, want %q", "E2XFRyo", fmt.Sprintf("%v", b))
+
+	var got base58.Bytes
+	if err := got.UnmarshalText([]byte("E2XFRyo")); err != nil || string(got) != "sure." {
+		t.Errorf("Bytes.UnmarshalText: got %q, %v", got, err)
+	}
+	var bad base58.Bytes
+	if err := bad.UnmarshalText([]byte("E2X0Ryo")); err == nil {
+		t.Error("Bytes.UnmarshalText invalid: got nil error")
+	}
+
+	r := base58.BytesWith[base58.Ripple]("sure.")
+	testEqual(t, "BytesWith[Ripple]: got %q, want %q", "NpXERyo", r.String())
+	c := base58.BytesWith[base36]("sure.")
+	testEqual(t, "BytesWith[base36]: got %q, want %q", base36Encoding.EncodeToString([]byte("sure.")), c.String())
+}
+
+func TestBytesEncoders(t *testing.T) {
+	type record struct {
+		XMLName xml.Name                        `json:"-" xml:"record"`
+		Key     base58.Bytes                    `json:"key" xml:"key"`
+		Ledger  base58.BytesWith[base58.Ripple] `json:"ledger" xml:"ledger,attr"`
+	}
+	in := record{Key: base58.Bytes("sure."), Ledger: base58.BytesWith[base58.Ripple]("sure.")}
+
+	j, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testEqual(t, "json.Marshal: got %s, want %s", `{"key":"E2XFRyo","ledger":"NpXERyo"}`, string(j))
+	var out record
+	if err := json.Unmarshal(j, &out); err != nil || string(out.Key) != "sure." || string(out.Ledger) != "sure." {
+		t.Errorf("json.Unmarshal: got %+v, %v", out, err)
+	}
+
+	x, err := xml.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testEqual(t, "xml.Marshal: got %s, want %s", `<record ledger="NpXERyo"><key>E2XFRyo</key></record>`, string(x))
+	out = record{}
+	if err := xml.Unmarshal(x, &out); err != nil || string(out.Key) != "sure." || string(out.Ledger) != "sure." {
+		t.Errorf("xml.Unmarshal: got %+v, %v", out, err)
+	}
+}
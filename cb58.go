@@ -0,0 +1,59 @@
+package base58
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+)
+
+/*
+Avalanche CB58 Encoding
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+CB58 appends the last 4 bytes of a single SHA256(payload) to the payload
+before base58 encoding with the bitcoin alphabet. It is used for IDs,
+addresses and keys throughout the Avalanche ecosystem.
+*/
+
+// decoded CB58 checksum does not match the payload
+var ErrCB58ChecksumMismatch = fmt.Errorf("%w: cb58", ErrChecksumMismatch)
+
+// avalanche CB58 checked encoding
+var CB58Encoding = NewCheckEncoding(StdEncoding, newCB58Hash, 4)
+
+type cb58Hash struct {
+	hash.Hash
+}
+
+// single SHA-256 truncated to its last 4 bytes
+func newCB58Hash() hash.Hash {
+	return cb58Hash{sha256.New()}
+}
+
+// append the last 4 bytes of the digest to b
+func (h cb58Hash) Sum(b []byte) []byte {
+	sum := h.Hash.Sum(nil)
+	return append(b, sum[len(sum)-4:]...)
+}
+
+// return the digest size in bytes
+func (h cb58Hash) Size() int {
+	return 4
+}
+
+// CB58 encode payload
+func EncodeCB58(payload []byte) string {
+	return CB58Encoding.EncodeToString(payload)
+}
+
+// decode CB58 string s and verify its checksum
+func DecodeCB58(s string) ([]byte, error) {
+	payload, err := CB58Encoding.DecodeString(s)
+	if errors.Is(err, ErrChecksumMismatch) {
+		return nil, ErrCB58ChecksumMismatch
+	}
+	return payload, err
+}
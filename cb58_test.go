@@ -0,0 +1,36 @@
+package base58_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+var cb58Pairs = []testpair{
+	{"", "45PJLL"},
+	{"\x00", "1c7hwa"},
+	{"\x00\x00\x00\x00\x00\x00\x00\x00\x00\xff", "111111111VnH8Ynb"},
+	{"\x01\x02\x03\x04\x05\x06\x07\x08\x09\x0a\x0b\x0c\x0d\x0e\x0f\x10\x11\x12\x13\x14\x15\x16\x17\x18\x19\x1a\x1b\x1c\x1d\x1e\x1f\x20", "SkB92YpWm4Q2ijQHH34cqbKkCZWszsiQgHVjtNeFF2HdvDQU"},
+}
+
+func TestCB58(t *testing.T) {
+	for _, p := range cb58Pairs {
+		got := base58.EncodeCB58([]byte(p.decoded))
+		msg := fmt.Sprintf("EncodeCB58(%q): got %%q, want %%q", p.decoded)
+		testEqual(t, msg, p.encoded, got)
+
+		res, err := base58.DecodeCB58(p.encoded)
+		if err != nil {
+			t.Errorf("DecodeCB58(%q) failed: %v", p.encoded, err)
+			continue
+		}
+		msg = fmt.Sprintf("DecodeCB58(%q): got %%q, want %%q", p.encoded)
+		testEqual(t, msg, p.decoded, string(res))
+	}
+	_, err := base58.DecodeCB58("45PJLM")
+	if !errors.Is(err, base58.ErrCB58ChecksumMismatch) || !errors.Is(err, base58.ErrChecksumMismatch) {
+		t.Errorf("DecodeCB58(corrupted): got error %v, want %v", err, base58.ErrCB58ChecksumMismatch)
+	}
+}
@@ -0,0 +1,281 @@
+package base58
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"hash"
+	"hash/crc32"
+	"io"
+)
+
+/*
+Base58Check Encoding
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Base58Check appends the first 4 bytes of SHA256(SHA256(payload)) to the
+payload before base58 encoding, allowing typos and truncation to be detected
+on decode. This is the scheme used by Bitcoin addresses, WIF keys, BIP32
+extended keys and many other coins.
+
+CheckEncoding generalizes the scheme to any alphabet, hash function and
+checksum length, e.g. blake2b checksums or truncated digests.
+*/
+
+var (
+	// decoded checksum does not match the payload
+	ErrChecksumMismatch = errors.New("base58: checksum mismatch")
+	// decoded data is too short to contain a checksum and version
+	ErrInvalidFormat = errors.New("base58: invalid format: version and/or checksum bytes missing")
+)
+
+// checked base58 encoding scheme: payload followed by a truncated digest
+type CheckEncoding struct {
+	enc         *Encoding
+	raw         *Encoding // enc without validators, which see the payload only
+	hash        func() hash.Hash
+	checksumLen int
+}
+
+// checked encoding over enc using the first checksumLen bytes of h's digest
+func NewCheckEncoding(enc *Encoding, h func() hash.Hash, checksumLen int) *CheckEncoding {
+	if enc == nil || h == nil {
+		panic("base58: check encoding requires an encoding and a hash")
+	}
+	if checksumLen <= 0 || checksumLen > h().Size() {
+		panic("base58: checksum length must be between 1 and the hash size")
+	}
+	raw := enc
+	if enc.HasValidators() {
+		raw = enc.WithoutValidators()
+	}
+	return &CheckEncoding{enc: enc, raw: raw, hash: h, checksumLen: checksumLen}
+}
+
+// std bitcoin base58check encoding: double-SHA256, 4-byte checksum
+var StdCheckEncoding = NewCheckEncoding(StdEncoding, NewDoubleSHA256, 4)
+
+// ripple base58check encoding used by XRP addresses and seeds
+var RippleCheckEncoding = NewCheckEncoding(RippleEncoding, NewDoubleSHA256, 4)
+
+// non-cryptographic checked encoding: StdEncoding with a 4-byte big-endian
+// CRC-32 (IEEE), for typo detection on log IDs, cache keys and tokens
+var CRC32CheckEncoding = NewCheckEncoding(StdEncoding, newCRC32, 4)
+
+func newCRC32() hash.Hash {
+	return crc32.NewIEEE()
+}
+
+// return the underlying base58 encoding
+func (ce *CheckEncoding) Encoding() *Encoding {
+	return ce.enc
+}
+
+// return the checksum length in bytes
+func (ce *CheckEncoding) ChecksumLen() int {
+	return ce.checksumLen
+}
+
+// compute checksum of input
+func (ce *CheckEncoding) checksum(input []byte) []byte {
+	h := ce.hash()
+	h.Write(input)
+	return h.Sum(nil)[:ce.checksumLen]
+}
+
+// encode payload followed by its checksum
+func (ce *CheckEncoding) EncodeToString(payload []byte) string {
+	return ce.EncodeVersion(nil, payload)
+}
+
+// encode version prefix and payload followed by a checksum covering both
+func (ce *CheckEncoding) EncodeVersion(version, payload []byte) string {
+	b := make([]byte, 0, len(version)+len(payload)+ce.checksumLen)
+	b = append(b, version...)
+	b = append(b, payload...)
+	b = append(b, ce.checksum(b)...)
+	return ce.enc.EncodeToString(b)
+}
+
+// decode s, verify its checksum and run the validators of the encoding on
+// the payload
+func (ce *CheckEncoding) DecodeString(s string) ([]byte, error) {
+	decoded, err := ce.raw.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(decoded) < ce.checksumLen {
+		return nil, ce.enc.observeFailure(ErrInvalidFormat)
+	}
+	payload := decoded[:len(decoded)-ce.checksumLen]
+	if string(ce.checksum(payload)) != string(decoded[len(payload):]) {
+		return nil, ce.enc.observeFailure(ErrChecksumMismatch)
+	}
+	if err := ce.enc.runValidators(payload); err != nil {
+		return nil, ce.enc.observeFailure(err)
+	}
+	return payload, nil
+}
+
+// decode s, verify its checksum and split off a versionLen-byte version
+// prefix from the payload
+func (ce *CheckEncoding) DecodeVersion(s string, versionLen int) (version, payload []byte, err error) {
+	if versionLen < 0 {
+		return nil, nil, errors.New("base58: negative version length")
+	}
+	decoded, err := ce.DecodeString(s)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(decoded) < versionLen {
+		return nil, nil, ErrInvalidFormat
+	}
+	return decoded[:versionLen], decoded[versionLen:], nil
+}
+
+// base58check encode payload with StdCheckEncoding
+func CheckEncode(payload []byte) string {
+	return StdCheckEncoding.EncodeToString(payload)
+}
+
+// base58check encode version prefix and payload with StdCheckEncoding,
+// the checksum covers both version and payload
+func CheckEncodeVersion(version, payload []byte) string {
+	return StdCheckEncoding.EncodeVersion(version, payload)
+}
+
+// decode base58check string s and verify its checksum
+func CheckDecode(s string) ([]byte, error) {
+	return StdCheckEncoding.DecodeString(s)
+}
+
+// decode base58check string s, verify its checksum and split off
+// a versionLen-byte version prefix from the payload
+func CheckDecodeVersion(s string, versionLen int) (version, payload []byte, err error) {
+	return StdCheckEncoding.DecodeVersion(s, versionLen)
+}
+
+type checkEncoder struct {
+	ce     *CheckEncoding
+	w      io.Writer
+	h      hash.Hash
+	buf    bytes.Buffer
+	closed bool
+	err    error
+}
+
+// hash and buffer data
+func (e *checkEncoder) Write(p []byte) (int, error) {
+	if e.closed {
+		return 0, ErrClosed
+	}
+	e.h.Write(p)
+	return e.buf.Write(p)
+}
+
+// hash and buffer s, implementing io.StringWriter
+func (e *checkEncoder) WriteString(s string) (int, error) {
+	if e.closed {
+		return 0, ErrClosed
+	}
+	io.WriteString(e.h, s)
+	return e.buf.WriteString(s)
+}
+
+// hash and buffer c, implementing io.ByteWriter
+func (e *checkEncoder) WriteByte(c byte) error {
+	_, err := e.Write([]byte{c})
+	return err
+}
+
+// append checksum, encode and write buffered data, once
+func (e *checkEncoder) Close() error {
+	if e.closed {
+		return e.err
+	}
+	e.closed = true
+	e.buf.Write(e.h.Sum(nil)[:e.ce.checksumLen])
+	encoded := e.ce.enc.EncodeToBytes(e.buf.Bytes())
+	e.buf.Reset()
+	_, e.err = e.w.Write(encoded)
+	return e.err
+}
+
+// checked stream encoder, the checksum is computed as data is written
+// and appended at Close
+func NewCheckEncoder(ce *CheckEncoding, w io.Writer) io.WriteCloser {
+	return &checkEncoder{ce: ce, w: w, h: ce.hash()}
+}
+
+type checkDecoder struct {
+	ce      *CheckEncoding
+	r       io.Reader
+	buf     bytes.Buffer
+	decoded bool
+	err     error
+}
+
+// read decoded payload once the checksum has been verified at EOF. The
+// first error is returned by every later Read.
+func (d *checkDecoder) Read(p []byte) (int, error) {
+	if d.err != nil {
+		return 0, d.err
+	}
+	if !d.decoded {
+		payload, err := d.verify()
+		if err != nil {
+			d.err = err
+			return 0, err
+		}
+		d.decoded = true
+		d.buf.Write(payload)
+	}
+	return d.buf.Read(p)
+}
+
+// read the whole input and return its payload if the checksum matches
+func (d *checkDecoder) verify() ([]byte, error) {
+	dec := NewDecoder(d.ce.raw, d.r)
+	decoded, err := io.ReadAll(dec)
+	dec.Close()
+	if err != nil {
+		return nil, err
+	}
+	if len(decoded) < d.ce.checksumLen {
+		return nil, d.ce.enc.observeFailure(ErrInvalidFormat)
+	}
+	payload := decoded[:len(decoded)-d.ce.checksumLen]
+	h := d.ce.hash()
+	h.Write(payload)
+	if !bytes.Equal(h.Sum(nil)[:d.ce.checksumLen], decoded[len(payload):]) {
+		return nil, d.ce.enc.observeFailure(ErrChecksumMismatch)
+	}
+	if err := d.ce.enc.runValidators(payload); err != nil {
+		return nil, d.ce.enc.observeFailure(err)
+	}
+	return payload, nil
+}
+
+// checked stream decoder, the checksum is verified at EOF before any
+// payload is returned
+func NewCheckDecoder(ce *CheckEncoding, r io.Reader) io.Reader {
+	return &checkDecoder{ce: ce, r: r}
+}
+
+type doubleSHA256 struct {
+	hash.Hash
+}
+
+// return a hash.Hash computing SHA256(SHA256(data))
+func NewDoubleSHA256() hash.Hash {
+	return doubleSHA256{sha256.New()}
+}
+
+// append SHA256 of the inner digest to b
+func (d doubleSHA256) Sum(b []byte) []byte {
+	inner := d.Hash.Sum(nil)
+	outer := sha256.Sum256(inner)
+	return append(b, outer[:]...)
+}
@@ -0,0 +1,181 @@
+package base58_test
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+var checkPairs = []testpair{
+	{"", "3QJmnh"},
+	{"hello", "2L5B5yqsVG8Vt"},
+	{"\x00\x01\x09\x66\x77\x60\x06\x95\x3d\x55\x67\x43\x9e\x5e\x39\xf8\x6a\x0d\x27\x3b\xee", "16UwLL9Risc3QfPqBUvKofHmBQ7wMtjvM"},
+}
+
+func TestCheckEncode(t *testing.T) {
+	for _, p := range checkPairs {
+		got := base58.CheckEncode([]byte(p.decoded))
+		msg := fmt.Sprintf("CheckEncode(%q): got %%q, want %%q", p.decoded)
+		testEqual(t, msg, p.encoded, got)
+	}
+}
+
+func TestCheckDecode(t *testing.T) {
+	for _, p := range checkPairs {
+		res, err := base58.CheckDecode(p.encoded)
+		if err != nil {
+			t.Errorf("CheckDecode(%q) failed: %v", p.encoded, err)
+			continue
+		}
+		msg := fmt.Sprintf("CheckDecode(%q): got %%q, want %%q", p.encoded)
+		testEqual(t, msg, p.decoded, string(res))
+	}
+}
+
+func TestCheckDecodeErrors(t *testing.T) {
+	tests := []struct {
+		in   string
+		want error
+	}{
+		{"16UwLL9Risc3QfPqBUvKofHmBQ7wMtjvN", base58.ErrChecksumMismatch},
+		{"3QJmni", base58.ErrChecksumMismatch},
+		{"", base58.ErrInvalidFormat},
+		{"2ZP", base58.ErrInvalidFormat},
+	}
+	for _, tt := range tests {
+		_, err := base58.CheckDecode(tt.in)
+		if !errors.Is(err, tt.want) {
+			t.Errorf("CheckDecode(%q): got error %v, want %v", tt.in, err, tt.want)
+		}
+	}
+	if _, err := base58.CheckDecode("0OIl"); err == nil {
+		t.Errorf("CheckDecode(%q): expected invalid character error", "0OIl")
+	}
+}
+
+func TestCheckVersion(t *testing.T) {
+	tests := []struct {
+		version, payload []byte
+		encoded          string
+	}{
+		{[]byte{0x00}, []byte("\x01\x09\x66\x77\x60\x06\x95\x3d\x55\x67\x43\x9e\x5e\x39\xf8\x6a\x0d\x27\x3b\xee"), "16UwLL9Risc3QfPqBUvKofHmBQ7wMtjvM"},
+		{[]byte{0x06, 0xa1, 0x9f}, make([]byte, 20), "tz1Ke2h7sDdakHJQh8WX4Z372du1KChsksyU"},
+		{[]byte{0x04, 0x88, 0xb2, 0x1e}, []byte("payload"), "8MiFZsJs4AxTzNmA4m9B"},
+	}
+	for _, tt := range tests {
+		got := base58.CheckEncodeVersion(tt.version, tt.payload)
+		msg := fmt.Sprintf("CheckEncodeVersion(%x, %x): got %%q, want %%q", tt.version, tt.payload)
+		testEqual(t, msg, tt.encoded, got)
+
+		version, payload, err := base58.CheckDecodeVersion(tt.encoded, len(tt.version))
+		if err != nil {
+			t.Errorf("CheckDecodeVersion(%q) failed: %v", tt.encoded, err)
+			continue
+		}
+		msg = fmt.Sprintf("CheckDecodeVersion(%q) version: got %%x, want %%x", tt.encoded)
+		testEqual(t, msg, string(tt.version), string(version))
+		msg = fmt.Sprintf("CheckDecodeVersion(%q) payload: got %%x, want %%x", tt.encoded)
+		testEqual(t, msg, string(tt.payload), string(payload))
+	}
+	if _, _, err := base58.CheckDecodeVersion("3QJmnh", 1); !errors.Is(err, base58.ErrInvalidFormat) {
+		t.Errorf("CheckDecodeVersion: got error %v, want %v", err, base58.ErrInvalidFormat)
+	}
+}
+
+func TestCheckEncoding(t *testing.T) {
+	// 2-byte truncated single SHA-256 over the ripple alphabet
+	alphabet := "rpshnaf39wBUDNEGHJKLM4PQRST7VWXYZ2bcdeCg65jkm8oFqi1tuvAxyz"
+	ce := base58.NewCheckEncoding(base58.NewEncoding(alphabet), sha256.New, 2)
+	testEqual(t, "ChecksumLen: got %d, want %d", 2, ce.ChecksumLen())
+	for _, p := range pairs {
+		encoded := ce.EncodeToString([]byte(p.decoded))
+		decoded, err := ce.DecodeString(encoded)
+		if err != nil {
+			t.Errorf("DecodeString(%q) failed: %v", encoded, err)
+			continue
+		}
+		msg := fmt.Sprintf("round trip of %q: got %%q, want %%q", p.decoded)
+		testEqual(t, msg, p.decoded, string(decoded))
+	}
+	sum := sha256.Sum256([]byte("abc"))
+	want := base58.NewEncoding(alphabet).EncodeToString(append([]byte("abc"), sum[:2]...))
+	testEqual(t, "EncodeToString: got %q, want %q", want, ce.EncodeToString([]byte("abc")))
+
+	for _, p := range checkPairs {
+		testEqual(t, "StdCheckEncoding: got %q, want %q", p.encoded, base58.StdCheckEncoding.EncodeToString([]byte(p.decoded)))
+	}
+}
+
+func TestNewCheckEncodingPanics(t *testing.T) {
+	for _, n := range []int{0, 33} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewCheckEncoding(checksumLen=%d) did not panic", n)
+				}
+			}()
+			base58.NewCheckEncoding(base58.StdEncoding, sha256.New, n)
+		}()
+	}
+}
+
+func TestCRC32CheckEncoding(t *testing.T) {
+	payload := []byte("cache-key-0042")
+	encoded := base58.CRC32CheckEncoding.EncodeToString(payload)
+	crc := crc32.ChecksumIEEE(payload)
+	want := base58.StdEncoding.EncodeToString(append(append([]byte{}, payload...), byte(crc>>24), byte(crc>>16), byte(crc>>8), byte(crc)))
+	testEqual(t, "CRC32CheckEncoding: got %q, want %q", want, encoded)
+
+	decoded, err := base58.CRC32CheckEncoding.DecodeString(encoded)
+	if err != nil {
+		t.Fatalf("DecodeString(%q) failed: %v", encoded, err)
+	}
+	testEqual(t, "DecodeString: got %q, want %q", string(payload), string(decoded))
+
+	typo := []byte(encoded)
+	if typo[3] == 'a' {
+		typo[3] = 'b'
+	} else {
+		typo[3] = 'a'
+	}
+	if _, err := base58.CRC32CheckEncoding.DecodeString(string(typo)); !errors.Is(err, base58.ErrChecksumMismatch) {
+		t.Errorf("DecodeString(%q): got error %v, want %v", typo, err, base58.ErrChecksumMismatch)
+	}
+}
+
+func TestCheckStream(t *testing.T) {
+	for _, p := range checkPairs {
+		bb := &strings.Builder{}
+		encoder := base58.NewCheckEncoder(base58.StdCheckEncoding, bb)
+		for i := 0; i < len(p.decoded); i++ {
+			encoder.Write([]byte{p.decoded[i]})
+		}
+		if err := encoder.Close(); err != nil {
+			t.Fatalf("Close failed: %v", err)
+		}
+		msg := fmt.Sprintf("Stream CheckEncode(%q): got %%q, want %%q", p.decoded)
+		testEqual(t, msg, p.encoded, bb.String())
+
+		decoded, err := io.ReadAll(base58.NewCheckDecoder(base58.StdCheckEncoding, strings.NewReader(p.encoded)))
+		if err != nil {
+			t.Fatalf("Stream CheckDecode(%q) failed: %v", p.encoded, err)
+		}
+		msg = fmt.Sprintf("Stream CheckDecode(%q): got %%q, want %%q", p.encoded)
+		testEqual(t, msg, p.decoded, string(decoded))
+	}
+	r := base58.NewCheckDecoder(base58.StdCheckEncoding, strings.NewReader("3QJmni"))
+	_, err := io.ReadAll(r)
+	if !errors.Is(err, base58.ErrChecksumMismatch) {
+		t.Errorf("Stream CheckDecode(corrupted): got error %v, want %v", err, base58.ErrChecksumMismatch)
+	}
+	// the error sticks instead of decoding the drained reader
+	if n, err := r.Read(make([]byte, 8)); n != 0 || !errors.Is(err, base58.ErrChecksumMismatch) {
+		t.Errorf("Stream CheckDecode(corrupted) second Read = %d, %v, want %v", n, err, base58.ErrChecksumMismatch)
+	}
+}
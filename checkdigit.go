@@ -0,0 +1,115 @@
+package base58
+
+import (
+	"context"
+	"errors"
+)
+
+/*
+Base58 Check Digit
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+A single trailing check character computed with the Luhn mod N algorithm
+(N = 58, or the radix of the encoding) over the alphabet positions of an
+encoded string. It detects every single-character substitution and most
+adjacent transpositions, for license keys and coupon codes where a 4-byte
+checksum would be too long.
+https://en.wikipedia.org/wiki/Luhn_mod_N_algorithm
+*/
+
+// empty input has no check digit
+var errMissingCheckDigit = errors.New("base58: check digit missing")
+
+// return the check character for encoded string s. Separators, line breaks
+// and other ignored characters are skipped and a leading zero digit counts
+// as the first alphabet character, so s may be formatted by enc.
+func (enc *Encoding) CheckDigit(s string) (byte, error) {
+	vals, err := enc.checkValues(s)
+	if err != nil {
+		return 0, err
+	}
+	return enc.checkChar(vals), nil
+}
+
+// return the check character for digit values vals
+func (enc *Encoding) checkChar(vals []byte) byte {
+	sum := enc.luhnSum(vals, 2)
+	return enc.encode[(enc.base-sum%enc.base)%enc.base]
+}
+
+// return the digit values of encoded string s without the formatting of enc
+func (enc *Encoding) checkValues(s string) ([]byte, error) {
+	vals := make([]byte, 0, len(s))
+	leading := true
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if leading && enc.zero != 0 && c == enc.zero {
+			vals = append(vals, 0)
+			continue
+		}
+		if enc.ignored(c) {
+			continue
+		}
+		val := enc.reverse[c]
+		if val == -1 {
+			return nil, errInvalidCharacter
+		}
+		leading = leading && val == 0
+		vals = append(vals, byte(val))
+	}
+	return vals, nil
+}
+
+// luhn mod N sum of digit values vals, doubling every other digit starting
+// with factor on the rightmost one. Leading zero digits add nothing, so the
+// sum does not depend on padding.
+func (enc *Encoding) luhnSum(vals []byte, factor int) int {
+	sum := 0
+	for i := len(vals) - 1; i >= 0; i-- {
+		addend := factor * int(vals[i])
+		addend = addend/enc.base + addend%enc.base
+		sum += addend
+		factor = 3 - factor
+	}
+	return sum
+}
+
+// return the base58 encoding of src followed by a check character. The check
+// character is computed over the digit values, before separators, line
+// breaks and the zero digit are applied.
+func (enc *Encoding) EncodeCheckDigit(src []byte) string {
+	s := enc.EncodeToBytes(src)
+	num := enc.bigEndian(src)
+	vals, _ := convertRadixContext(context.Background(), enc.allocator, num, 256, enc.base)
+	c := enc.checkChar(vals)
+	enc.release(vals)
+	if enc.littleEndian {
+		enc.release(num)
+	}
+	return string(append(s, c))
+}
+
+// verify the trailing check character of s and decode the rest
+func (enc *Encoding) DecodeCheckDigit(s string) ([]byte, error) {
+	// the check character is the last one that is not ignored
+	end := len(s) - 1
+	for end >= 0 && enc.ignored(s[end]) {
+		end--
+	}
+	if end < 0 {
+		return nil, errMissingCheckDigit
+	}
+	if enc.reverse[s[end]] == -1 {
+		return nil, errInvalidCharacter
+	}
+	vals, err := enc.checkValues(s)
+	if err != nil {
+		return nil, err
+	}
+	if enc.luhnSum(vals, 1)%enc.base != 0 {
+		return nil, ErrChecksumMismatch
+	}
+	return enc.DecodeString(s[:end])
+}
@@ -0,0 +1,72 @@
+package base58_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestCheckDigit(t *testing.T) {
+	for _, p := range pairs {
+		encoded := base58.StdEncoding.EncodeCheckDigit([]byte(p.decoded))
+		msg := fmt.Sprintf("EncodeCheckDigit(%q) prefix: got %%q, want %%q", p.decoded)
+		testEqual(t, msg, p.encoded, encoded[:len(encoded)-1])
+		decoded, err := base58.StdEncoding.DecodeCheckDigit(encoded)
+		if err != nil {
+			t.Errorf("DecodeCheckDigit(%q) failed: %v", encoded, err)
+			continue
+		}
+		msg = fmt.Sprintf("DecodeCheckDigit(%q): got %%q, want %%q", encoded)
+		testEqual(t, msg, p.decoded, string(decoded))
+	}
+}
+
+func TestCheckDigitDetectsTypos(t *testing.T) {
+	encoded := base58.StdEncoding.EncodeCheckDigit([]byte(bigtest.decoded))
+	alphabet := base58.StdEncoding.Alphabet()
+	// every single-character substitution is detected
+	for i := 0; i < len(encoded); i++ {
+		for j := 0; j < len(alphabet); j++ {
+			if alphabet[j] == encoded[i] {
+				continue
+			}
+			typo := []byte(encoded)
+			typo[i] = alphabet[j]
+			if _, err := base58.StdEncoding.DecodeCheckDigit(string(typo)); !errors.Is(err, base58.ErrChecksumMismatch) {
+				t.Fatalf("DecodeCheckDigit(%q): got error %v, want %v", typo, err, base58.ErrChecksumMismatch)
+			}
+		}
+	}
+	if _, err := base58.StdEncoding.DecodeCheckDigit(""); err == nil {
+		t.Errorf("DecodeCheckDigit(\"\"): expected error")
+	}
+}
+
+func TestCheckDigitFormatted(t *testing.T) {
+	encodings := []*base58.Encoding{
+		base58.StdEncoding.WithSeparator('-', 4),
+		base58.StdEncoding.WithZeroDigit('0'),
+		base58.StdEncoding.WithPadWidth(12).WithZeroDigit('0').WithSeparator('-', 4),
+		base58.StdEncoding.WithWrap(5),
+	}
+	inputs := []string{"", "\x00\x00", "secret key", bigtest.decoded}
+	for _, enc := range encodings {
+		for _, in := range inputs {
+			encoded := enc.EncodeCheckDigit([]byte(in))
+			// the check digit is that of the plain encoding
+			plain := base58.StdEncoding.EncodeCheckDigit([]byte(in))
+			if encoded[len(encoded)-1] != plain[len(plain)-1] {
+				t.Errorf("%v EncodeCheckDigit(%q) = %q, want check digit %q", enc, in, encoded, plain[len(plain)-1])
+			}
+			// padded encodings decode to their fixed size
+			want, _ := enc.DecodeString(enc.EncodeToString([]byte(in)))
+			decoded, err := enc.DecodeCheckDigit(encoded)
+			if err != nil || !bytes.Equal(decoded, want) {
+				t.Errorf("%v DecodeCheckDigit(%q) = %q, %v, want %q", enc, encoded, decoded, err, want)
+			}
+		}
+	}
+}
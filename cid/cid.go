@@ -0,0 +1,131 @@
+package cid
+
+import (
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cyclone-github/base58"
+)
+
+/*
+IPFS CIDv0 / Multihash Helpers
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+A CIDv0 is the bare base58btc encoding of a sha2-256 multihash: the varint
+hash code 0x12, the varint digest length 0x20 and the 32-byte digest, which
+always encodes to a 46-character string starting with "Qm". CIDv1 strings
+carry a multibase prefix and start with the varint version 1.
+https://github.com/multiformats/cid
+https://github.com/multiformats/multihash
+*/
+
+const (
+	// multihash code of sha2-256
+	SHA2_256 = 0x12
+	// length of a sha2-256 digest in bytes
+	DigestLen = 32
+	// length of a CIDv0 string
+	V0Len = 46
+)
+
+var (
+	// input is not a well-formed multihash
+	ErrInvalidMultihash = errors.New("cid: invalid multihash")
+	// input is not a CIDv0
+	ErrNotV0 = errors.New("cid: not a CIDv0")
+	// input is neither a CIDv0 nor a CIDv1 in a supported multibase
+	ErrUnknownVersion = errors.New("cid: unknown CID version")
+)
+
+// multihash header code and digest
+type Multihash struct {
+	Code   uint64
+	Digest []byte
+}
+
+// parse a binary multihash, the digest aliases b
+func DecodeMultihash(b []byte) (*Multihash, error) {
+	code, n := binary.Uvarint(b)
+	if n <= 0 {
+		return nil, fmt.Errorf("%w: bad hash code varint", ErrInvalidMultihash)
+	}
+	b = b[n:]
+	length, n := binary.Uvarint(b)
+	if n <= 0 {
+		return nil, fmt.Errorf("%w: bad digest length varint", ErrInvalidMultihash)
+	}
+	b = b[n:]
+	if uint64(len(b)) != length {
+		return nil, fmt.Errorf("%w: digest is %d bytes, header says %d", ErrInvalidMultihash, len(b), length)
+	}
+	return &Multihash{Code: code, Digest: b}, nil
+}
+
+// encode a 32-byte sha2-256 digest as a CIDv0 string
+func EncodeV0(digest []byte) (string, error) {
+	if len(digest) != DigestLen {
+		return "", fmt.Errorf("%w: digest is %d bytes, want %d", ErrInvalidMultihash, len(digest), DigestLen)
+	}
+	b := make([]byte, 0, 2+DigestLen)
+	b = append(b, SHA2_256, DigestLen)
+	b = append(b, digest...)
+	return base58.StdEncoding.EncodeToString(b), nil
+}
+
+// parse a CIDv0 string, validate its multihash header and return the digest
+func ParseV0(s string) ([]byte, error) {
+	if len(s) != V0Len || !strings.HasPrefix(s, "Qm") {
+		return nil, ErrNotV0
+	}
+	b, err := base58.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	mh, err := DecodeMultihash(b)
+	if err != nil {
+		return nil, err
+	}
+	if mh.Code != SHA2_256 || len(mh.Digest) != DigestLen {
+		return nil, fmt.Errorf("%w: hash code %#x, want sha2-256", ErrNotV0, mh.Code)
+	}
+	return mh.Digest, nil
+}
+
+// lowercase RFC 4648 base32 without padding, multibase 'b'
+var base32Lower = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// report the CID version of s: 0 for CIDv0, 1 for a CIDv1 in base58btc
+// ('z') or base32 ('b', 'B') multibase
+func Version(s string) (int, error) {
+	if _, err := ParseV0(s); err == nil {
+		return 0, nil
+	}
+	if s == "" {
+		return 0, ErrUnknownVersion
+	}
+	var b []byte
+	var err error
+	switch s[0] {
+	case 'z':
+		b, err = base58.DecodeMultibase(s)
+	case 'b':
+		b, err = base32Lower.DecodeString(s[1:])
+	case 'B':
+		b, err = base32Lower.DecodeString(strings.ToLower(s[1:]))
+	default:
+		return 0, ErrUnknownVersion
+	}
+	if err != nil {
+		return 0, err
+	}
+	version, n := binary.Uvarint(b)
+	if n <= 0 || version != 1 {
+		return 0, ErrUnknownVersion
+	}
+	return 1, nil
+}
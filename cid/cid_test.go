@@ -0,0 +1,70 @@
+package cid_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/cyclone-github/base58/cid"
+)
+
+// the go-ipfs readme directory
+const (
+	readmeV0     = "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG"
+	readmeDigest = "9d6c2be50f706953479ab9df2ce3edca90b68053c00b3004b7f0accbe1e8eedf"
+)
+
+func TestV0(t *testing.T) {
+	digest, err := cid.ParseV0(readmeV0)
+	if err != nil {
+		t.Fatalf("ParseV0(%q) failed: %v", readmeV0, err)
+	}
+	if got := hex.EncodeToString(digest); got != readmeDigest {
+		t.Errorf("ParseV0(%q): got %s, want %s", readmeV0, got, readmeDigest)
+	}
+	s, err := cid.EncodeV0(digest)
+	if err != nil {
+		t.Fatalf("EncodeV0 failed: %v", err)
+	}
+	if s != readmeV0 {
+		t.Errorf("EncodeV0: got %q, want %q", s, readmeV0)
+	}
+	if _, err := cid.EncodeV0(make([]byte, 31)); !errors.Is(err, cid.ErrInvalidMultihash) {
+		t.Errorf("EncodeV0(31 bytes): got error %v, want %v", err, cid.ErrInvalidMultihash)
+	}
+}
+
+func TestVersion(t *testing.T) {
+	tests := []struct {
+		in      string
+		version int
+		err     error
+	}{
+		{readmeV0, 0, nil},
+		{"zdj7Wg2Qkk4mYgAkVU1kppfQ2sMGz5zPwERVpeWmxCQLDxVoC", 1, nil},
+		{"bafybeie5nqv6kd3qnfjupgvz34woh3oksc3iau6abmyajn7qvtf6d2ho34", 1, nil},
+		{"BAFYBEIE5NQV6KD3QNFJUPGVZ34WOH3OKSC3IAU6ABMYAJN7QVTF6D2HO34", 1, nil},
+		{"QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbd", 0, cid.ErrUnknownVersion},
+		{"", 0, cid.ErrUnknownVersion},
+		{"mAXASIA", 0, cid.ErrUnknownVersion},
+	}
+	for _, tt := range tests {
+		version, err := cid.Version(tt.in)
+		if !errors.Is(err, tt.err) || version != tt.version {
+			t.Errorf("Version(%q): got %d, %v, want %d, %v", tt.in, version, err, tt.version, tt.err)
+		}
+	}
+	if _, err := cid.ParseV0("zdj7Wg2Qkk4mYgAkVU1kppfQ2sMGz5zPwERVpeWmxCQLDxVoC"); !errors.Is(err, cid.ErrNotV0) {
+		t.Errorf("ParseV0(CIDv1): got error %v, want %v", err, cid.ErrNotV0)
+	}
+}
+
+func TestDecodeMultihash(t *testing.T) {
+	if _, err := cid.DecodeMultihash([]byte{0x12, 0x20, 0x01}); !errors.Is(err, cid.ErrInvalidMultihash) {
+		t.Errorf("DecodeMultihash(truncated): got error %v, want %v", err, cid.ErrInvalidMultihash)
+	}
+	mh, err := cid.DecodeMultihash([]byte{0x13, 0x02, 0xaa, 0xbb})
+	if err != nil || mh.Code != 0x13 || len(mh.Digest) != 2 {
+		t.Errorf("DecodeMultihash: got %+v, %v", mh, err)
+	}
+}
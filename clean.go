@@ -0,0 +1,59 @@
+package base58
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+/*
+Input Sanitizing
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Addresses copied from chat apps, PDFs and web pages often carry invisible
+Unicode (zero-width spaces, byte order marks, bidi marks), non-breaking
+spaces, smart quotes or fullwidth forms. CleanString removes or folds these
+so the result can be passed straight to DecodeString.
+*/
+
+// input contains a character CleanString cannot fold to ASCII
+var ErrUnexpectedCharacter = errors.New("base58: unexpected character")
+
+// strip invisible Unicode, whitespace and quotes from s and fold fullwidth
+// forms to ASCII, returning a decode-ready ASCII string
+func CleanString(s string) (string, error) {
+	var sb strings.Builder
+	sb.Grow(len(s))
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			return "", fmt.Errorf("%w: invalid UTF-8 at byte offset %d", ErrUnexpectedCharacter, i)
+		}
+		switch {
+		case r < utf8.RuneSelf && !unicode.IsSpace(r) && !isQuote(r) && unicode.IsPrint(r):
+			sb.WriteByte(byte(r))
+		case unicode.IsSpace(r) || isQuote(r) || unicode.Is(unicode.Cf, r):
+			// whitespace, NBSP, quotes, zero-width and bidi format characters
+		case r >= 0xff01 && r <= 0xff5e:
+			// fullwidth ASCII variants
+			sb.WriteByte(byte(r - 0xfee0))
+		default:
+			return "", fmt.Errorf("%w %q (%U) at byte offset %d", ErrUnexpectedCharacter, r, r, i)
+		}
+		i += size
+	}
+	return sb.String(), nil
+}
+
+// report whether r is an ASCII or typographic quote mark
+func isQuote(r rune) bool {
+	switch r {
+	case '"', '\'', '`', '‘', '’', '‚', '‛', '“', '”', '„', '‟', '‹', '›', '«', '»':
+		return true
+	}
+	return false
+}
@@ -0,0 +1,41 @@
+package base58_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestCleanString(t *testing.T) {
+	const addr = "1BoatSLRHtKNngkdXEeobR76b53LETtpyT"
+	tests := []string{
+		addr,
+		" " + addr + "\n",
+		"\u200b" + addr + "\ufeff",
+		"1Boat\u00a0SLRH\u200dtKNngkdXEeobR76b53LETtpyT",
+		"\u201c" + addr + "\u201d",
+		"'" + addr + "'",
+		"\u202a" + addr + "\u202c",
+		"１ＢｏａｔSLRHtKNngkdXEeobR76b53LETtpyT",
+	}
+	for _, in := range tests {
+		got, err := base58.CleanString(in)
+		if err != nil {
+			t.Errorf("CleanString(%q) failed: %v", in, err)
+			continue
+		}
+		msg := fmt.Sprintf("CleanString(%q): got %%q, want %%q", in)
+		testEqual(t, msg, addr, got)
+		if _, err := base58.CheckDecode(got); err != nil {
+			t.Errorf("CheckDecode(CleanString(%q)) failed: %v", in, err)
+		}
+	}
+
+	for _, in := range []string{"1Boaté", "1Boat\x00", "1Boat\xff", "1BoatБ"} {
+		if _, err := base58.CleanString(in); !errors.Is(err, base58.ErrUnexpectedCharacter) {
+			t.Errorf("CleanString(%q): got %v, want %v", in, err, base58.ErrUnexpectedCharacter)
+		}
+	}
+}
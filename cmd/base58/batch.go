@@ -0,0 +1,182 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+)
+
+// lines handed to a worker at a time
+const batchChunk = 1024
+
+// longest input line accepted in batch mode
+const batchMaxLine = 1 << 20
+
+// chunk of consecutive input lines, converted by one worker
+type batchJob struct {
+	name   string   // input file name, "-" for stdin
+	first  int      // line number of lines[0], counting from 1
+	lines  [][]byte // input lines without line endings
+	out    bytes.Buffer
+	errs   bytes.Buffer
+	failed int
+	done   chan struct{}
+}
+
+// base58 batch encode|decode: one value per line, converted in parallel,
+// output is written in input order and failed lines are reported to stderr
+func runBatch(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+	op := args[0]
+	fs := newFlagSet("batch "+op, stderr)
+	encoding := alphabetFlag(fs)
+	workers := fs.Int("workers", runtime.GOMAXPROCS(0), "number of parallel workers")
+	var format *string
+	switch op {
+	case "encode":
+		format = formatFlag(fs, "in", "input line format")
+	case "decode":
+		format = fs.String("out", formatHex, "output line format: raw, hex, base64 or hashcat")
+	default:
+		return errUsage
+	}
+	if err := parseFlags(fs, args[1:]); err != nil {
+		return err
+	}
+	if *workers < 1 {
+		return errUsage
+	}
+	enc, err := encoding()
+	if err != nil {
+		return err
+	}
+	if err := validFormat(*format); err != nil {
+		return err
+	}
+
+	var convert func(w *bytes.Buffer, line []byte) error
+	if op == "encode" {
+		convert = func(w *bytes.Buffer, line []byte) error {
+			data, err := parseFormat(*format, line)
+			if err != nil {
+				return err
+			}
+			w.WriteString(enc.EncodeToString(data))
+			w.WriteByte('\n')
+			return nil
+		}
+	} else {
+		convert = func(w *bytes.Buffer, line []byte) error {
+			decoded, err := enc.DecodeToBytes(trimSpace(line))
+			if err != nil {
+				return err
+			}
+			if *format == formatRaw {
+				w.Write(decoded)
+				w.WriteByte('\n')
+				return nil
+			}
+			return writeFormat(w, *format, decoded)
+		}
+	}
+	return batch(fs.Args(), stdin, stdout, stderr, *workers, convert)
+}
+
+// run convert over every line of the named files, or stdin if none are
+// named, on a pool of workers
+func batch(files []string, stdin io.Reader, stdout, stderr io.Writer, workers int, convert func(*bytes.Buffer, []byte) error) error {
+	jobs := make(chan *batchJob)
+	queue := make(chan *batchJob, 2*workers)
+	for range workers {
+		go func() {
+			for j := range jobs {
+				for i, line := range j.lines {
+					if err := convert(&j.out, line); err != nil {
+						fmt.Fprintf(&j.errs, "base58 batch: %s:%d: %v\n", j.name, j.first+i, err)
+						j.failed++
+					}
+				}
+				close(j.done)
+			}
+		}()
+	}
+
+	// the producer queues jobs in input order before handing them out, so
+	// the writer below can emit results in order while workers run ahead
+	var readErr error
+	go func() {
+		defer close(queue)
+		defer close(jobs)
+		if len(files) == 0 {
+			readErr = batchLines("-", stdin, jobs, queue)
+			return
+		}
+		for _, name := range files {
+			f, err := os.Open(name)
+			if err != nil {
+				readErr = err
+				return
+			}
+			err = batchLines(name, f, jobs, queue)
+			f.Close()
+			if err != nil {
+				readErr = err
+				return
+			}
+		}
+	}()
+
+	w := bufio.NewWriter(stdout)
+	var writeErr error
+	failed := 0
+	for j := range queue {
+		<-j.done
+		failed += j.failed
+		if writeErr == nil {
+			_, writeErr = w.Write(j.out.Bytes())
+		}
+		stderr.Write(j.errs.Bytes())
+	}
+	if writeErr == nil {
+		writeErr = w.Flush()
+	}
+	switch {
+	case readErr != nil:
+		return readErr
+	case writeErr != nil:
+		return writeErr
+	case failed > 0:
+		return fmt.Errorf("%d lines failed", failed)
+	}
+	return nil
+}
+
+// split r into chunks of lines and queue them
+func batchLines(name string, r io.Reader, jobs, queue chan<- *batchJob) error {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64*1024), batchMaxLine)
+	n := 0
+	j := &batchJob{name: name, first: 1}
+	send := func() {
+		j.done = make(chan struct{})
+		queue <- j
+		jobs <- j
+	}
+	for sc.Scan() {
+		n++
+		j.lines = append(j.lines, bytes.TrimSuffix(bytes.Clone(sc.Bytes()), []byte("\r")))
+		if len(j.lines) == batchChunk {
+			send()
+			j = &batchJob{name: name, first: n + 1}
+		}
+	}
+	if len(j.lines) > 0 {
+		send()
+	}
+	return sc.Err()
+}
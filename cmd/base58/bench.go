@@ -0,0 +1,160 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"math/rand/v2"
+	"runtime"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/cyclone-github/base58"
+)
+
+// codec path measured by bench
+type benchPath struct {
+	name   string
+	encode func(data []byte) string
+	decode func(s string) error
+}
+
+// the package codec, the block stream format and a math/big baseline, so a
+// report shows which path is slow on the machine at hand. math/big only
+// has digits up to radix 62, larger alphabets are measured without it.
+func benchPaths(enc *base58.Encoding) []benchPath {
+	paths := []benchPath{
+		{
+			name:   "base58",
+			encode: enc.EncodeToString,
+			decode: func(s string) error {
+				_, err := enc.DecodeString(s)
+				return err
+			},
+		},
+		{
+			name: "block",
+			encode: func(data []byte) string {
+				var sb strings.Builder
+				e := base58.NewBlockEncoder(enc, &sb)
+				e.Write(data)
+				e.Close()
+				return sb.String()
+			},
+			decode: func(s string) error {
+				_, err := io.Copy(io.Discard, base58.NewBlockDecoder(enc, strings.NewReader(s)))
+				return err
+			},
+		},
+	}
+	if enc.Radix() <= len(bigDigits) {
+		paths = append(paths, benchPath{
+			name: "math/big",
+			encode: func(data []byte) string {
+				return bigEncode(enc, data)
+			},
+			decode: func(s string) error {
+				_, err := bigDecode(enc, s)
+				return err
+			},
+		})
+	}
+	return paths
+}
+
+// digits of big.Int.Text and SetString, in value order
+const bigDigits = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// encode data without leading zeros using math/big's radix conversion,
+// mapping its digits to the alphabet of enc, whose radix must be at most 62
+func bigEncode(enc *base58.Encoding, data []byte) string {
+	digits := []byte(new(big.Int).SetBytes(data).Text(enc.Radix()))
+	alphabet := enc.Alphabet()
+	for i, c := range digits {
+		digits[i] = alphabet[strings.IndexByte(bigDigits, c)]
+	}
+	return string(digits)
+}
+
+// decode s, the inverse of bigEncode
+func bigDecode(enc *base58.Encoding, s string) ([]byte, error) {
+	digits := []byte(s)
+	alphabet := enc.Alphabet()
+	for i, c := range digits {
+		v := strings.IndexByte(alphabet, c)
+		if v < 0 {
+			return nil, base58.CorruptInputError(i)
+		}
+		digits[i] = bigDigits[v]
+	}
+	x, _ := new(big.Int).SetString(string(digits), enc.Radix())
+	return x.Bytes(), nil
+}
+
+// base58 bench: measure encode and decode throughput per payload size
+func runBench(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := newFlagSet("bench", stderr)
+	encoding := alphabetFlag(fs)
+	sizesFlag := fs.String("sizes", "32,256,1024,8192", "comma-separated payload sizes in bytes")
+	benchTime := fs.Duration("time", 200*time.Millisecond, "minimum run time per measurement")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 || *benchTime <= 0 {
+		return errUsage
+	}
+	var sizes []int
+	for _, f := range strings.Split(*sizesFlag, ",") {
+		n, err := strconv.Atoi(strings.TrimSpace(f))
+		if err != nil || n < 1 {
+			return fmt.Errorf("invalid size %q", f)
+		}
+		sizes = append(sizes, n)
+	}
+	enc, err := encoding()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "go: %s %s/%s, %d CPUs\n", runtime.Version(), runtime.GOOS, runtime.GOARCH, runtime.NumCPU())
+	fmt.Fprintf(stdout, "implementation: pure Go, alphabet %s\n\n", enc.Name())
+	tw := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "path\tsize\tencode MB/s\tencode ns/op\tdecode MB/s\tdecode ns/op\t")
+	rng := rand.New(rand.NewPCG(1, 2))
+	for _, size := range sizes {
+		data := make([]byte, size)
+		for i := range data {
+			data[i] = byte(rng.Uint32())
+		}
+		data[0] |= 1 // no leading zeros, math/big drops them
+		for _, p := range benchPaths(enc) {
+			var s string
+			encNs := measure(*benchTime, func() { s = p.encode(data) })
+			if err := p.decode(s); err != nil {
+				return fmt.Errorf("%s: %v", p.name, err)
+			}
+			decNs := measure(*benchTime, func() { p.decode(s) })
+			fmt.Fprintf(tw, "%s\t%d\t%.2f\t%.0f\t%.2f\t%.0f\t\n",
+				p.name, size, float64(size)*1e3/encNs, encNs, float64(size)*1e3/decNs, decNs)
+		}
+	}
+	return tw.Flush()
+}
+
+// run f repeatedly for at least d and return the mean time per call in ns
+func measure(d time.Duration, f func()) float64 {
+	n := 1
+	for {
+		start := time.Now()
+		for range n {
+			f()
+		}
+		elapsed := time.Since(start)
+		if elapsed >= d {
+			return float64(elapsed.Nanoseconds()) / float64(n)
+		}
+		n *= 2
+	}
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	"github.com/cyclone-github/base58"
+)
+
+// base58 check encode|decode
+func runCheck(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+	switch args[0] {
+	case "encode":
+		return runCheckEncode(args[1:], stdin, stdout, stderr)
+	case "decode":
+		return runCheckDecode(args[1:], stdin, stdout, stderr)
+	}
+	return errUsage
+}
+
+func runCheckEncode(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := newFlagSet("check encode", stderr)
+	encoding := alphabetFlag(fs)
+	versionHex := fs.String("version", "", "version prefix in hex, e.g. 00 or 0488b21e")
+	in := formatFlag(fs, "in", "payload input format")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := validFormat(*in); err != nil {
+		return err
+	}
+	enc, err := encoding()
+	if err != nil {
+		return err
+	}
+	version, err := hex.DecodeString(*versionHex)
+	if err != nil {
+		return fmt.Errorf("invalid -version: %w", err)
+	}
+	payload, err := readInput(fs.Args(), stdin)
+	if err != nil {
+		return err
+	}
+	if payload, err = parseFormat(*in, payload); err != nil {
+		return err
+	}
+	ce := base58.NewCheckEncoding(enc, base58.NewDoubleSHA256, 4)
+	_, err = fmt.Fprintln(stdout, ce.EncodeVersion(version, payload))
+	return err
+}
+
+func runCheckDecode(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := newFlagSet("check decode", stderr)
+	encoding := alphabetFlag(fs)
+	versionLen := fs.Int("version-len", 1, "version prefix length in bytes")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	enc, err := encoding()
+	if err != nil {
+		return err
+	}
+	data, err := readInput(fs.Args(), stdin)
+	if err != nil {
+		return err
+	}
+	ce := base58.NewCheckEncoding(enc, base58.NewDoubleSHA256, 4)
+	version, payload, err := ce.DecodeVersion(string(trimSpace(data)), *versionLen)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(stdout, "version: %x\npayload: %x\n", version, payload)
+	return err
+}
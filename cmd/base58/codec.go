@@ -0,0 +1,107 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/cyclone-github/base58"
+)
+
+// register the -alphabet flag on fs, returning a func resolving it
+func alphabetFlag(fs *flag.FlagSet) func() (*base58.Encoding, error) {
+	name := fs.String("alphabet", "bitcoin", "alphabet: bitcoin, ripple, flickr, gmp or the alphabet characters")
+	return func() (*base58.Encoding, error) {
+		return lookupEncoding(*name)
+	}
+}
+
+// resolve a registered encoding name or a literal alphabet
+func lookupEncoding(name string) (enc *base58.Encoding, err error) {
+	for _, e := range base58.Encodings() {
+		if e.Name() == name {
+			return e, nil
+		}
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			enc, err = nil, fmt.Errorf("invalid alphabet %q: %v", name, r)
+		}
+	}()
+	return base58.NewRadixEncoding(name), nil
+}
+
+// register -strict and -lenient on fs, returning a func applying them
+func modeFlags(fs *flag.FlagSet) func(*base58.Encoding) (*base58.Encoding, bool, error) {
+	strict := fs.Bool("strict", false, "reject any byte outside the alphabet, including surrounding whitespace")
+	lenient := fs.Bool("lenient", false, "skip whitespace anywhere in the input")
+	return func(enc *base58.Encoding) (*base58.Encoding, bool, error) {
+		switch {
+		case *strict && *lenient:
+			return nil, false, errUsage
+		case *strict:
+			return enc.Strict(), true, nil
+		case *lenient:
+			return enc.Lenient(), false, nil
+		}
+		return enc, false, nil
+	}
+}
+
+func runEncode(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := newFlagSet("encode", stderr)
+	encoding := alphabetFlag(fs)
+	in := formatFlag(fs, "in", "input format")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	enc, err := encoding()
+	if err != nil {
+		return err
+	}
+	if err := validFormat(*in); err != nil {
+		return err
+	}
+	data, err := readInput(fs.Args(), stdin)
+	if err != nil {
+		return err
+	}
+	if data, err = parseFormat(*in, data); err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(stdout, enc.EncodeToString(data))
+	return err
+}
+
+func runDecode(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := newFlagSet("decode", stderr)
+	encoding := alphabetFlag(fs)
+	mode := modeFlags(fs)
+	out := formatFlag(fs, "out", "output format")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	enc, err := encoding()
+	if err != nil {
+		return err
+	}
+	if err := validFormat(*out); err != nil {
+		return err
+	}
+	enc, strict, err := mode(enc)
+	if err != nil {
+		return err
+	}
+	data, err := readInput(fs.Args(), stdin)
+	if err != nil {
+		return err
+	}
+	if !strict {
+		data = trimSpace(data)
+	}
+	decoded, err := enc.DecodeToBytes(data)
+	if err != nil {
+		return err
+	}
+	return writeFormat(stdout, *out, decoded)
+}
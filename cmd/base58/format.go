@@ -0,0 +1,68 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/cyclone-github/base58"
+)
+
+// binary data representations for -in and -out
+const (
+	formatRaw    = "raw"
+	formatHex    = "hex"
+	formatBase64 = "base64"
+	// plain text, $HEX[...] for non-printable bytes
+	formatHashcat = "hashcat"
+)
+
+// register a data format flag on fs
+func formatFlag(fs *flag.FlagSet, name, usage string) *string {
+	return fs.String(name, formatRaw, usage+": raw, hex, base64 or hashcat")
+}
+
+// convert input in the given format to bytes, surrounding whitespace is
+// ignored for hex and base64, a trailing line ending for hashcat
+func parseFormat(format string, data []byte) ([]byte, error) {
+	switch format {
+	case formatRaw:
+		return data, nil
+	case formatHex:
+		return hex.DecodeString(string(trimSpace(data)))
+	case formatBase64:
+		return base64.StdEncoding.DecodeString(string(trimSpace(data)))
+	case formatHashcat:
+		return base58.ParseHashcat(string(trimNewline(data)))
+	}
+	return nil, fmt.Errorf("unknown format %q", format)
+}
+
+// write data to w in the given format, text formats end with a newline
+func writeFormat(w io.Writer, format string, data []byte) error {
+	var err error
+	switch format {
+	case formatRaw:
+		_, err = w.Write(data)
+	case formatHex:
+		_, err = fmt.Fprintln(w, hex.EncodeToString(data))
+	case formatBase64:
+		_, err = fmt.Fprintln(w, base64.StdEncoding.EncodeToString(data))
+	case formatHashcat:
+		_, err = fmt.Fprintln(w, base58.FormatHashcat(data))
+	default:
+		err = fmt.Errorf("unknown format %q", format)
+	}
+	return err
+}
+
+// check that format is known before any input is read
+func validFormat(format string) error {
+	switch format {
+	case formatRaw, formatHex, formatBase64, formatHashcat:
+		return nil
+	}
+	return fmt.Errorf("unknown format %q", format)
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	"github.com/cyclone-github/base58"
+)
+
+// base58 generate: print random base58 tokens from crypto/rand, one per
+// line, optionally Base58Check wrapped
+func runGenerate(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := newFlagSet("generate", stderr)
+	encoding := alphabetFlag(fs)
+	size := fs.Int("bytes", 32, "random bytes per token")
+	count := fs.Int("count", 1, "number of tokens")
+	check := fs.Bool("check", false, "wrap each token in Base58Check")
+	versionHex := fs.String("version", "", "Base58Check version prefix in hex, implies -check")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 || *size < 1 || *count < 0 {
+		return errUsage
+	}
+	enc, err := encoding()
+	if err != nil {
+		return err
+	}
+	version, err := hex.DecodeString(*versionHex)
+	if err != nil {
+		return fmt.Errorf("invalid -version: %w", err)
+	}
+	var ce *base58.CheckEncoding
+	if *check || len(version) > 0 {
+		ce = base58.NewCheckEncoding(enc, base58.NewDoubleSHA256, 4)
+	}
+
+	w := bufio.NewWriter(stdout)
+	buf := make([]byte, *size)
+	for range *count {
+		if _, err := rand.Read(buf); err != nil {
+			return err
+		}
+		var token string
+		if ce != nil {
+			token = ce.EncodeVersion(version, buf)
+		} else {
+			token = enc.EncodeToString(buf)
+		}
+		if _, err := fmt.Fprintln(w, token); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
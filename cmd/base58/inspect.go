@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cyclone-github/base58"
+)
+
+// base58 inspect: print a triage report for each argument, or for stdin
+// if there are none
+func runInspect(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := newFlagSet("inspect", stderr)
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	inputs := fs.Args()
+	if len(inputs) == 0 {
+		data, err := io.ReadAll(stdin)
+		if err != nil {
+			return err
+		}
+		inputs = strings.Fields(string(data))
+		if len(inputs) == 0 {
+			return errUsage
+		}
+	}
+	unknown := 0
+	for i, s := range inputs {
+		if i > 0 {
+			fmt.Fprintln(stdout)
+		}
+		r := base58.Inspect(s)
+		if r.Encoding == nil {
+			unknown++
+		}
+		if _, err := io.WriteString(stdout, r.String()); err != nil {
+			return err
+		}
+	}
+	if unknown > 0 {
+		return fmt.Errorf("%d of %d inputs match no alphabet", unknown, len(inputs))
+	}
+	return nil
+}
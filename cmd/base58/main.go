@@ -0,0 +1,146 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+/*
+base58 command line tool
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Shell access to the base58 package: reads stdin or the named files and
+writes the result to stdout.
+
+	base58 encode [-alphabet name] [-in raw|hex|base64|hashcat] [file ...]
+	base58 decode [-alphabet name] [-strict | -lenient] [-out raw|hex|base64|hashcat] [file ...]
+	base58 check encode [-alphabet name] [-version hex] [-in raw|hex|base64|hashcat] [file ...]
+	base58 check decode [-alphabet name] [-version-len n] [file ...]
+	base58 batch encode [-alphabet name] [-workers n] [-in raw|hex|base64|hashcat] [file ...]
+	base58 batch decode [-alphabet name] [-workers n] [-out raw|hex|base64|hashcat] [file ...]
+	base58 inspect [string ...]
+	base58 scan [-alphabet name] [-min n] [-max n] [-check] [-network name] [file|dir ...]
+	base58 verify [-network name[,name]] [-invalid file] [file ...]
+	base58 bench [-alphabet name] [-sizes n,n,...] [-time d]
+	base58 generate [-alphabet name] [-bytes n] [-count n] [-check] [-version hex]
+*/
+
+// subcommand of the tool
+type command struct {
+	usage string
+	run   func(args []string, stdin io.Reader, stdout, stderr io.Writer) error
+}
+
+var commands map[string]command
+
+func init() {
+	commands = map[string]command{
+		"encode":   {"encode [-alphabet name] [-in raw|hex|base64|hashcat] [file ...]", runEncode},
+		"generate": {"generate [-alphabet name] [-bytes n] [-count n] [-check] [-version hex]", runGenerate},
+		"decode":   {"decode [-alphabet name] [-strict | -lenient] [-out raw|hex|base64|hashcat] [file ...]", runDecode},
+		"batch":    {"batch encode [-alphabet name] [-workers n] [-in raw|hex|base64|hashcat] [file ...] | batch decode [-alphabet name] [-workers n] [-out raw|hex|base64|hashcat] [file ...]", runBatch},
+		"bench":    {"bench [-alphabet name] [-sizes n,n,...] [-time d]", runBench},
+		"inspect":  {"inspect [string ...]", runInspect},
+		"check":    {"check encode [-alphabet name] [-version hex] [-in raw|hex|base64|hashcat] [file ...] | check decode [-alphabet name] [-version-len n] [file ...]", runCheck},
+		"scan":     {"scan [-alphabet name] [-min n] [-max n] [-check] [-network name] [file|dir ...]", runScan},
+		"verify":   {"verify [-network name[,name]] [-invalid file] [file ...]", runVerify},
+	}
+}
+
+// usage error, printed with the command usage
+var errUsage = errors.New("usage")
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run the tool and return its exit status
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+		return 2
+	}
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "base58: unknown command %q\n", args[0])
+		usage(stderr)
+		return 2
+	}
+	if err := cmd.run(args[1:], stdin, stdout, stderr); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		if errors.Is(err, errUsage) {
+			fmt.Fprintf(stderr, "usage: base58 %s\n", cmd.usage)
+			return 2
+		}
+		fmt.Fprintf(stderr, "base58 %s: %v\n", args[0], err)
+		return 1
+	}
+	return 0
+}
+
+func usage(w io.Writer) {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fmt.Fprintln(w, "usage:")
+	for _, name := range names {
+		fmt.Fprintf(w, "\tbase58 %s\n", commands[name].usage)
+	}
+}
+
+// new flag set for a subcommand, reporting flag errors as errUsage
+func newFlagSet(name string, stderr io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet("base58 "+name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	return fs
+}
+
+// parse args with fs, mapping flag errors to errUsage
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		return errUsage
+	}
+	return nil
+}
+
+// read all named files in order, or stdin if none are named
+func readInput(files []string, stdin io.Reader) ([]byte, error) {
+	if len(files) == 0 {
+		return io.ReadAll(stdin)
+	}
+	var data []byte
+	for _, name := range files {
+		b, err := os.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, b...)
+	}
+	return data, nil
+}
+
+// trim surrounding ASCII whitespace, e.g. the newline from echo
+func trimSpace(b []byte) []byte {
+	return []byte(strings.TrimSpace(string(b)))
+}
+
+// trim one trailing LF or CRLF, e.g. the newline from echo
+func trimNewline(b []byte) []byte {
+	b = bytes.TrimSuffix(b, []byte("\n"))
+	return bytes.TrimSuffix(b, []byte("\r"))
+}
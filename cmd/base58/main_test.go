@@ -0,0 +1,330 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+// run the tool with args and stdin, returning stdout, stderr and the exit
+// status
+func runTool(t *testing.T, stdin string, args ...string) (string, string, int) {
+	t.Helper()
+	var stdout, stderr strings.Builder
+	code := run(args, strings.NewReader(stdin), &stdout, &stderr)
+	return stdout.String(), stderr.String(), code
+}
+
+func TestEncodeDecode(t *testing.T) {
+	out, _, code := runTool(t, "sure.", "encode")
+	if code != 0 || out != "E2XFRyo\n" {
+		t.Errorf("encode: got %q, exit %d", out, code)
+	}
+	out, _, code = runTool(t, "E2XFRyo\n", "decode")
+	if code != 0 || out != "sure." {
+		t.Errorf("decode: got %q, exit %d", out, code)
+	}
+	out, _, code = runTool(t, "sure.", "encode", "-alphabet", "ripple")
+	if code != 0 || out != "NpXERyo\n" {
+		t.Errorf("encode -alphabet ripple: got %q, exit %d", out, code)
+	}
+	out, _, code = runTool(t, "\x01", "encode", "-alphabet", "01")
+	if code != 0 || out != "1\n" {
+		t.Errorf("encode -alphabet 01: got %q, exit %d", out, code)
+	}
+}
+
+func TestDecodeModes(t *testing.T) {
+	if _, stderr, code := runTool(t, "E2XFRyo\n", "decode", "-strict"); code != 1 || stderr == "" {
+		t.Errorf("decode -strict with newline: exit %d, stderr %q", code, stderr)
+	}
+	if out, _, code := runTool(t, "E2X FRyo\n", "decode", "-lenient"); code != 0 || out != "sure." {
+		t.Errorf("decode -lenient: got %q, exit %d", out, code)
+	}
+	if _, _, code := runTool(t, "", "decode", "-strict", "-lenient"); code != 2 {
+		t.Errorf("decode -strict -lenient: exit %d, want 2", code)
+	}
+}
+
+func TestFilesAndErrors(t *testing.T) {
+	dir := t.TempDir()
+	a, b := filepath.Join(dir, "a"), filepath.Join(dir, "b")
+	os.WriteFile(a, []byte("su"), 0o600)
+	os.WriteFile(b, []byte("re."), 0o600)
+	if out, _, code := runTool(t, "", "encode", a, b); code != 0 || out != "E2XFRyo\n" {
+		t.Errorf("encode files: got %q, exit %d", out, code)
+	}
+	if _, _, code := runTool(t, "", "encode", filepath.Join(dir, "missing")); code != 1 {
+		t.Errorf("encode missing file: exit %d, want 1", code)
+	}
+	if _, _, code := runTool(t, "", "frobnicate"); code != 2 {
+		t.Errorf("unknown command: exit %d, want 2", code)
+	}
+	if _, _, code := runTool(t, ""); code != 2 {
+		t.Errorf("no command: exit %d, want 2", code)
+	}
+	if _, stderr, code := runTool(t, "", "encode", "-alphabet", "aa"); code != 1 || !strings.Contains(stderr, "invalid alphabet") {
+		t.Errorf("bad alphabet: exit %d, stderr %q", code, stderr)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	payload, _ := hex.DecodeString("7680adec8eabcabac676be9e83854ade0bd22cdb")
+	out, _, code := runTool(t, string(payload), "check", "encode", "-version", "00")
+	if code != 0 || out != "1BoatSLRHtKNngkdXEeobR76b53LETtpyT\n" {
+		t.Errorf("check encode: got %q, exit %d", out, code)
+	}
+	out, _, code = runTool(t, "1BoatSLRHtKNngkdXEeobR76b53LETtpyT\n", "check", "decode")
+	if code != 0 || out != "version: 00\npayload: 7680adec8eabcabac676be9e83854ade0bd22cdb\n" {
+		t.Errorf("check decode: got %q, exit %d", out, code)
+	}
+	out, _, code = runTool(t, "1BoatSLRHtKNngkdXEeobR76b53LETtpyT", "check", "decode", "-version-len", "0")
+	if code != 0 || out != "version: \npayload: 007680adec8eabcabac676be9e83854ade0bd22cdb\n" {
+		t.Errorf("check decode -version-len 0: got %q, exit %d", out, code)
+	}
+	if _, stderr, code := runTool(t, "1BoatSLRHtKNngkdXEeobR76b53LETtpyU", "check", "decode"); code != 1 || !strings.Contains(stderr, "checksum mismatch") {
+		t.Errorf("check decode bad checksum: exit %d, stderr %q", code, stderr)
+	}
+	if _, _, code := runTool(t, "", "check", "encode", "-version", "xyz"); code != 1 {
+		t.Errorf("check encode bad version: exit %d, want 1", code)
+	}
+	if _, _, code := runTool(t, "", "check"); code != 2 {
+		t.Errorf("check without subcommand: exit %d, want 2", code)
+	}
+}
+
+func TestFormats(t *testing.T) {
+	tests := []struct {
+		stdin string
+		args  []string
+		want  string
+	}{
+		{"73757265 2e\n", []string{"encode", "-in=hex"}, ""},
+		{"737572652e\n", []string{"encode", "-in=hex"}, "E2XFRyo\n"},
+		{"c3VyZS4=\n", []string{"encode", "-in", "base64"}, "E2XFRyo\n"},
+		{"E2XFRyo", []string{"decode", "-out=hex"}, "737572652e\n"},
+		{"E2XFRyo", []string{"decode", "-out=base64"}, "c3VyZS4=\n"},
+		{"E2XFRyo", []string{"decode", "-out=raw"}, "sure."},
+		{"E2XFRyo", []string{"decode", "-out=hashcat"}, "sure.\n"},
+		{"112", []string{"decode", "-out=hashcat"}, "$HEX[000001]\n"},
+		{"$HEX[737572652e]\n", []string{"encode", "-in=hashcat"}, "E2XFRyo\n"},
+		{"sure.\r\n", []string{"encode", "-in=hashcat"}, "E2XFRyo\n"},
+		{"$HEX[7375\n", []string{"encode", "-in=hashcat"}, ""},
+		{"7680adec8eabcabac676be9e83854ade0bd22cdb", []string{"check", "encode", "-version", "00", "-in", "hex"}, "1BoatSLRHtKNngkdXEeobR76b53LETtpyT\n"},
+	}
+	for _, tt := range tests {
+		out, stderr, code := runTool(t, tt.stdin, tt.args...)
+		if tt.want == "" {
+			if code != 1 {
+				t.Errorf("%v: exit %d, want 1", tt.args, code)
+			}
+			continue
+		}
+		if code != 0 || out != tt.want {
+			t.Errorf("%v: got %q, exit %d, stderr %q, want %q", tt.args, out, code, stderr, tt.want)
+		}
+	}
+	if _, _, code := runTool(t, "", "decode", "-out=octal"); code != 1 {
+		t.Errorf("decode -out=octal: exit %d, want 1", code)
+	}
+}
+
+func TestBatch(t *testing.T) {
+	var in, want strings.Builder
+	for i := range 5000 {
+		s := fmt.Sprintf("line %d", i)
+		in.WriteString(s + "\n")
+		want.WriteString(base58.StdEncoding.EncodeToString([]byte(s)) + "\n")
+	}
+	out, stderr, code := runTool(t, in.String(), "batch", "encode", "-workers", "4")
+	if code != 0 || out != want.String() {
+		t.Fatalf("batch encode: exit %d, stderr %q, output in order %v", code, stderr, out == want.String())
+	}
+	back, stderr, code := runTool(t, out, "batch", "decode", "-out", "raw", "-workers", "3")
+	if code != 0 || back != in.String() {
+		t.Fatalf("batch decode: exit %d, stderr %q, round trip %v", code, stderr, back == in.String())
+	}
+
+	out, stderr, code = runTool(t, "E2XFRyo\r\nE2X0Ryo\n\n2ukV\n", "batch", "decode")
+	if code != 1 || out != "737572652e\n\n05af52\n" {
+		t.Errorf("batch decode: got %q, exit %d", out, code)
+	}
+	if !strings.Contains(stderr, "-:2: ") || strings.Contains(stderr, "-:1: ") {
+		t.Errorf("batch decode: stderr %q, want an error for line 2 only", stderr)
+	}
+	if _, _, code := runTool(t, "", "batch", "decode", "-workers", "0"); code != 2 {
+		t.Errorf("batch -workers 0: exit %d, want 2", code)
+	}
+}
+
+func TestInspect(t *testing.T) {
+	out, _, code := runTool(t, "", "inspect", "1BoatSLRHtKNngkdXEeobR76b53LETtpyT")
+	for _, want := range []string{"alphabet: bitcoin", "decoded length: 25", "leading zeros: 1", "base58check: true", "version: 00", "network: btc"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("inspect: missing %q in %q", want, out)
+		}
+	}
+	if code != 0 {
+		t.Errorf("inspect: exit %d", code)
+	}
+	out, _, code = runTool(t, "E2XFRyo\n", "inspect")
+	if code != 0 || !strings.Contains(out, "hex: 737572652e\n") || !strings.Contains(out, "base58check: false") {
+		t.Errorf("inspect stdin: got %q, exit %d", out, code)
+	}
+	out, _, code = runTool(t, "", "inspect", "not base58!")
+	if code != 1 || !strings.Contains(out, "alphabet: none") {
+		t.Errorf("inspect invalid: got %q, exit %d", out, code)
+	}
+}
+
+func TestScan(t *testing.T) {
+	const addr = "1BoatSLRHtKNngkdXEeobR76b53LETtpyT"
+	dir := t.TempDir()
+	// text file with a plain candidate, an address and an overlong run
+	text := "note: " + strings.Repeat("z", 30) + " sent to " + addr + " " + strings.Repeat("a", 200) + "\n"
+	if err := os.WriteFile(filepath.Join(dir, "a.txt"), []byte(text), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	// binary file with an address straddling the read chunk boundary and
+	// one glued to other alphabet bytes
+	bin := make([]byte, scanChunk-10)
+	bin = append(bin, addr...)
+	bin = append(bin, 0xff)
+	bin = append(bin, "xyz"+addr...)
+	if err := os.MkdirAll(filepath.Join(dir, "sub"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "sub", "b.bin"), bin, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	a, b := filepath.Join(dir, "a.txt"), filepath.Join(dir, "sub", "b.bin")
+
+	out, stderr, code := runTool(t, "", "scan", dir)
+	want := fmt.Sprintf("%s:6: %s\n%s:45: %s btc pubkeyhash\n%s:%d: %s btc pubkeyhash\n%s:%d: xyz%s\n",
+		a, strings.Repeat("z", 30), a, addr, b, scanChunk-10, addr, b, scanChunk+len(addr)-9, addr)
+	if code != 0 || out != want {
+		t.Errorf("scan: got %q, exit %d, stderr %q, want %q", out, code, stderr, want)
+	}
+
+	out, _, code = runTool(t, "", "scan", "-network", "btc", b)
+	want = fmt.Sprintf("%s:%d: %s btc pubkeyhash\n%s:%d: %s btc pubkeyhash\n",
+		b, scanChunk-10, addr, b, scanChunk+len(addr)-6, addr)
+	if code != 0 || out != want {
+		t.Errorf("scan -network btc: got %q, exit %d, want %q", out, code, want)
+	}
+
+	out, _, code = runTool(t, "id "+addr, "scan", "-min", "30", "-max", "40")
+	if code != 0 || out != "-:3: "+addr+" btc pubkeyhash\n" {
+		t.Errorf("scan stdin: got %q, exit %d", out, code)
+	}
+	if _, _, code := runTool(t, "", "scan", "-network", "nope"); code != 1 {
+		t.Errorf("scan -network nope: exit %d, want 1", code)
+	}
+	if _, _, code := runTool(t, "", "scan", filepath.Join(dir, "missing")); code != 1 {
+		t.Errorf("scan missing file: exit %d, want 1", code)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	const addr = "1BoatSLRHtKNngkdXEeobR76b53LETtpyT"
+	in := addr + "\n\n1BoatSLRHtKNngkdXEeobR76b53LETtpyU\r\n" + addr + "\nnot an address\n"
+	invalid := filepath.Join(t.TempDir(), "invalid.txt")
+	out, stderr, code := runTool(t, in, "verify", "-invalid", invalid)
+	if code != 1 || out != "valid: 2\ninvalid: 2\n" || !strings.Contains(stderr, "2 of 4") {
+		t.Errorf("verify: got %q, exit %d, stderr %q", out, code, stderr)
+	}
+	b, err := os.ReadFile(invalid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "1BoatSLRHtKNngkdXEeobR76b53LETtpyU\nnot an address\n" {
+		t.Errorf("verify -invalid: got %q", b)
+	}
+
+	var many strings.Builder
+	for range 10000 {
+		many.WriteString(addr + "\n")
+	}
+	out, _, code = runTool(t, many.String(), "verify", "-network", "btc")
+	if code != 0 || out != "valid: 10000\ninvalid: 0\n" {
+		t.Errorf("verify -network btc: got %q, exit %d", out, code)
+	}
+	out, _, code = runTool(t, addr, "verify", "-network", "ltc,doge")
+	if code != 1 || out != "valid: 0\ninvalid: 1\n" {
+		t.Errorf("verify -network ltc,doge: got %q, exit %d", out, code)
+	}
+	if _, _, code := runTool(t, addr, "verify", "-network", "nope"); code != 1 {
+		t.Errorf("verify -network nope: exit %d, want 1", code)
+	}
+}
+
+func TestBench(t *testing.T) {
+	out, stderr, code := runTool(t, "", "bench", "-sizes", "16,40", "-time", "1ms", "-alphabet", "ripple")
+	if code != 0 {
+		t.Fatalf("bench: exit %d, stderr %q", code, stderr)
+	}
+	for _, want := range []string{"implementation: pure Go, alphabet ripple", "encode MB/s", "base58    16", "block    40", "math/big    40"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("bench: missing %q in %q", want, out)
+		}
+	}
+	// math/big runs in the alphabet's radix, and is left out above 62
+	out, stderr, code = runTool(t, "", "bench", "-sizes", "32", "-time", "1ms", "-alphabet", "0123456789")
+	if code != 0 || !strings.Contains(out, "math/big    32") {
+		t.Errorf("bench -alphabet decimal: exit %d, stderr %q, output %q", code, stderr, out)
+	}
+	wide := bigDigits + "-_"
+	out, stderr, code = runTool(t, "", "bench", "-sizes", "32", "-time", "1ms", "-alphabet", wide)
+	if code != 0 || strings.Contains(out, "math/big") {
+		t.Errorf("bench -alphabet radix 64: exit %d, stderr %q, output %q", code, stderr, out)
+	}
+	if _, _, code := runTool(t, "", "bench", "-sizes", "0"); code != 1 {
+		t.Errorf("bench -sizes 0: exit %d, want 1", code)
+	}
+}
+
+func TestBigBaseline(t *testing.T) {
+	data := []byte("\x01 math/big baseline")
+	s := bigEncode(base58.StdEncoding, data)
+	if want := base58.StdEncoding.EncodeToString(data); s != want {
+		t.Errorf("bigEncode: got %q, want %q", s, want)
+	}
+	if decoded, err := bigDecode(base58.StdEncoding, s); err != nil || string(decoded) != string(data) {
+		t.Errorf("bigDecode: got %q, %v", decoded, err)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	out, _, code := runTool(t, "", "generate", "-bytes", "16", "-count", "50")
+	lines := strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+	if code != 0 || len(lines) != 50 {
+		t.Fatalf("generate: got %d lines, exit %d", len(lines), code)
+	}
+	seen := make(map[string]bool)
+	for _, line := range lines {
+		decoded, err := base58.StdEncoding.DecodeString(line)
+		if err != nil || len(decoded) != 16 || seen[line] {
+			t.Errorf("generate: bad or repeated token %q: %v", line, err)
+		}
+		seen[line] = true
+	}
+
+	out, _, code = runTool(t, "", "generate", "-bytes", "20", "-version", "00")
+	net, kind, payload, err := base58.CheckDecodeNetwork(strings.TrimSpace(out))
+	if code != 0 || err != nil || net != base58.BitcoinMainNet || kind != base58.PubKeyHash || len(payload) != 20 {
+		t.Errorf("generate -version 00: got %q, exit %d, %v", out, code, err)
+	}
+	out, _, code = runTool(t, "", "generate", "-check", "-alphabet", "ripple")
+	ce := base58.NewCheckEncoding(base58.RippleEncoding, base58.NewDoubleSHA256, 4)
+	if payload, err := ce.DecodeString(strings.TrimSpace(out)); code != 0 || err != nil || len(payload) != 32 {
+		t.Errorf("generate -check -alphabet ripple: got %q, exit %d, %v", out, code, err)
+	}
+	if _, _, code := runTool(t, "", "generate", "-bytes", "0"); code != 2 {
+		t.Errorf("generate -bytes 0: exit %d, want 2", code)
+	}
+}
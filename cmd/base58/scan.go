@@ -0,0 +1,161 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/cyclone-github/base58"
+)
+
+// bytes read from a file at a time when extracting candidates
+const scanChunk = 1 << 20
+
+// base58 scan: report base58 candidates, or only checksummed addresses,
+// found in the named files and directories, or stdin if none are named
+func runScan(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	flags := newFlagSet("scan", stderr)
+	encoding := alphabetFlag(flags)
+	minLen := flags.Int("min", base58.DefaultExtractMinLen, "minimum candidate length")
+	maxLen := flags.Int("max", 128, "maximum candidate length, longer runs are skipped")
+	check := flags.Bool("check", false, "report only Base58Check addresses and keys of a registered network, including ones glued to other alphabet bytes")
+	network := flags.String("network", "", "report only addresses and keys of this network, implies -check")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if *minLen < 1 || *maxLen < *minLen {
+		return errUsage
+	}
+	enc, err := encoding()
+	if err != nil {
+		return err
+	}
+	var net *base58.Network
+	if *network != "" {
+		var ok bool
+		if net, ok = base58.Lookup(*network); !ok {
+			return fmt.Errorf("unknown network %q", *network)
+		}
+		*check = true
+	}
+
+	scan := func(name string, r io.Reader) error {
+		if *check {
+			return scanAddresses(name, r, net, stdout)
+		}
+		return scanCandidates(name, r, enc, *minLen, *maxLen, stdout)
+	}
+	if flags.NArg() == 0 {
+		return scan("-", stdin)
+	}
+	failed := 0
+	for _, root := range flags.Args() {
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err == nil && !d.Type().IsRegular() {
+				return nil
+			}
+			if err == nil {
+				err = scanFile(path, scan)
+			}
+			if err != nil {
+				fmt.Fprintf(stderr, "base58 scan: %v\n", err)
+				failed++
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d files could not be scanned", failed)
+	}
+	return nil
+}
+
+func scanFile(path string, scan func(string, io.Reader) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return scan(path, f)
+}
+
+// print every run of enc alphabet bytes of minLen to maxLen characters,
+// annotated with its network if it is a registered Base58Check string
+func scanCandidates(name string, r io.Reader, enc *base58.Encoding, minLen, maxLen int, w io.Writer) error {
+	var inAlphabet [256]bool
+	for _, c := range []byte(enc.Alphabet()) {
+		inAlphabet[c] = true
+	}
+	opts := &base58.ExtractOptions{Encoding: enc, MinLen: minLen, MaxLen: maxLen}
+	buf := make([]byte, 0, scanChunk+maxLen)
+	var base int64 // stream offset of buf[0]
+	skipping := false
+	for {
+		start := len(buf)
+		n, err := io.ReadFull(r, buf[start:start+scanChunk])
+		buf = buf[:start+n]
+		eof := err == io.EOF || err == io.ErrUnexpectedEOF
+		if err != nil && !eof {
+			return err
+		}
+		if skipping {
+			// drop the rest of an overlong run carried from the last chunk
+			i := 0
+			for i < len(buf) && inAlphabet[buf[i]] {
+				i++
+			}
+			skipping = i == len(buf) && !eof
+			buf, base = buf[i:], base+int64(i)
+		}
+		// a run touching the end of the chunk may continue in the next one
+		cut := len(buf)
+		if !eof {
+			for cut > 0 && inAlphabet[buf[cut-1]] {
+				cut--
+			}
+		}
+		for _, m := range base58.ExtractBase58(buf[:cut], opts) {
+			if err := printCandidate(w, name, base+int64(m.Offset), m.Text); err != nil {
+				return err
+			}
+		}
+		if eof {
+			return nil
+		}
+		if len(buf)-cut > maxLen {
+			skipping, cut = true, len(buf)
+		}
+		base += int64(cut)
+		buf = append(buf[:0], buf[cut:]...)
+	}
+}
+
+func printCandidate(w io.Writer, name string, off int64, text string) error {
+	if net, kind, _, err := base58.CheckDecodeNetwork(text); err == nil {
+		_, err = fmt.Fprintf(w, "%s:%d: %s %s %s\n", name, off, text, net.Name, kind)
+		return err
+	}
+	_, err := fmt.Fprintf(w, "%s:%d: %s\n", name, off, text)
+	return err
+}
+
+// print every Base58Check address or key found by an AddressScanner,
+// keeping only those of net if it is not nil
+func scanAddresses(name string, r io.Reader, net *base58.Network, w io.Writer) error {
+	s := base58.NewAddressScanner(r)
+	for s.Scan() {
+		m := s.Match()
+		if net != nil && m.Network != net {
+			continue
+		}
+		if _, err := fmt.Fprintf(w, "%s:%d: %s %s %s\n", name, m.Offset, m.Text, m.Network.Name, m.Kind); err != nil {
+			return err
+		}
+	}
+	return s.Err()
+}
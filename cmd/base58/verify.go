@@ -0,0 +1,114 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/cyclone-github/base58"
+)
+
+// addresses validated per ValidateAddresses call
+const verifyChunk = 4096
+
+// base58 verify: checksum-validate every non-blank line of the named files,
+// or stdin if none are named, and print valid and invalid counts
+func runVerify(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := newFlagSet("verify", stderr)
+	network := fs.String("network", "", "comma-separated networks to accept, any registered network if empty")
+	invalidPath := fs.String("invalid", "", "write invalid lines to this file")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	var nets []*base58.Network
+	if *network != "" {
+		for _, name := range strings.Split(*network, ",") {
+			net, ok := base58.Lookup(strings.TrimSpace(name))
+			if !ok {
+				return fmt.Errorf("unknown network %q", name)
+			}
+			nets = append(nets, net)
+		}
+	}
+	var invalidOut *bufio.Writer
+	if *invalidPath != "" {
+		f, err := os.Create(*invalidPath)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		invalidOut = bufio.NewWriter(f)
+	}
+
+	var valid, invalid int
+	lines := make([]string, 0, verifyChunk)
+	flush := func() error {
+		for _, r := range base58.ValidateAddresses(lines, nets...) {
+			if r.Valid {
+				valid++
+				continue
+			}
+			invalid++
+			if invalidOut != nil {
+				if _, err := invalidOut.WriteString(r.Input + "\n"); err != nil {
+					return err
+				}
+			}
+		}
+		lines = lines[:0]
+		return nil
+	}
+	verify := func(r io.Reader) error {
+		sc := bufio.NewScanner(r)
+		sc.Buffer(make([]byte, 64*1024), batchMaxLine)
+		for sc.Scan() {
+			line := strings.TrimSpace(sc.Text())
+			if line == "" {
+				continue
+			}
+			if lines = append(lines, line); len(lines) == verifyChunk {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		}
+		return sc.Err()
+	}
+
+	if fs.NArg() == 0 {
+		if err := verify(stdin); err != nil {
+			return err
+		}
+	}
+	for _, name := range fs.Args() {
+		if err := verifyFile(name, verify); err != nil {
+			return err
+		}
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+	if invalidOut != nil {
+		if err := invalidOut.Flush(); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintf(stdout, "valid: %d\ninvalid: %d\n", valid, invalid); err != nil {
+		return err
+	}
+	if invalid > 0 {
+		return fmt.Errorf("%d of %d addresses invalid", invalid, valid+invalid)
+	}
+	return nil
+}
+
+func verifyFile(name string, verify func(io.Reader) error) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return verify(f)
+}
@@ -0,0 +1,192 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+/*
+base58gen alphabet code generator
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Reads a config of named alphabets and writes a Go file declaring one
+package-level *base58.Encoding per alphabet, built from precomputed encode
+and decode tables with base58.NewEncodingTables. Run it from go:generate:
+
+	//go:generate go run github.com/cyclone-github/base58/cmd/base58gen -in alphabets.txt
+
+	base58gen -in file [-out file] [-package name]
+
+The config has one alphabet per line, a Go identifier followed by the
+alphabet characters; blank lines and lines starting with '#' are ignored:
+
+	# name     alphabet
+	Invoice    123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz
+	Hex        0123456789abcdef
+
+Each alphabet becomes <name>Encoding, with <name> as its display name. The
+output defaults to the config name with a _gen.go suffix, the package to
+$GOPACKAGE as set by go generate.
+*/
+
+// parsed config line
+type alphabet struct {
+	name  string
+	chars string
+	line  int
+}
+
+func main() {
+	if err := run(os.Args[1:], os.Stderr); err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintln(os.Stderr, "base58gen:", err)
+		}
+		os.Exit(2)
+	}
+}
+
+func run(args []string, stderr io.Writer) error {
+	fs := flag.NewFlagSet("base58gen", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	in := fs.String("in", "", "alphabet config file")
+	out := fs.String("out", "", "output Go file (default: config name with _gen.go suffix)")
+	pkg := fs.String("package", os.Getenv("GOPACKAGE"), "package name of the output file")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *in == "" || fs.NArg() > 0 {
+		fs.Usage()
+		return errors.New("usage: base58gen -in file [-out file] [-package name]")
+	}
+	if *pkg == "" {
+		return errors.New("no -package given and $GOPACKAGE is not set")
+	}
+	if *out == "" {
+		*out = strings.TrimSuffix(*in, filepath.Ext(*in)) + "_gen.go"
+	}
+	f, err := os.Open(*in)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	alphabets, err := parseConfig(f)
+	if err != nil {
+		return fmt.Errorf("%s:%w", *in, err)
+	}
+	src, err := generate(*pkg, filepath.Base(*in), alphabets)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(*out, src, 0o644)
+}
+
+// read the alphabets of a config, errors are prefixed with the line number
+func parseConfig(r io.Reader) ([]alphabet, error) {
+	var alphabets []alphabet
+	seen := make(map[string]int)
+	s := bufio.NewScanner(r)
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("%d: want a name and an alphabet", n)
+		}
+		a := alphabet{name: fields[0], chars: fields[1], line: n}
+		if !token.IsIdentifier(a.name) || !isASCIILetter(a.name[0]) {
+			return nil, fmt.Errorf("%d: %q is not a Go identifier starting with an ASCII letter", n, a.name)
+		}
+		// names differing only in the case of the first letter share
+		// a decode table name
+		if prev, ok := seen[decodeTableName(a.name)]; ok {
+			return nil, fmt.Errorf("%d: %s conflicts with the name on line %d", n, a.name, prev)
+		}
+		if err := checkAlphabet(a.chars); err != nil {
+			return nil, fmt.Errorf("%d: %s: %w", n, a.name, err)
+		}
+		seen[decodeTableName(a.name)] = n
+		alphabets = append(alphabets, a)
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf(" %w", err)
+	}
+	return alphabets, nil
+}
+
+func isASCIILetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+// apply the rules of base58.NewRadixEncoding, so generated code never
+// panics at init
+func checkAlphabet(chars string) error {
+	if len(chars) < 2 || len(chars) > 94 {
+		return fmt.Errorf("alphabet has %d characters, want 2 to 94", len(chars))
+	}
+	for i := 0; i < len(chars); i++ {
+		if chars[i] <= ' ' || chars[i] > '~' {
+			return fmt.Errorf("alphabet character %q is not printable non-space ASCII", chars[i])
+		}
+		if strings.IndexByte(chars[:i], chars[i]) >= 0 {
+			return fmt.Errorf("alphabet character %q is repeated", chars[i])
+		}
+	}
+	return nil
+}
+
+// return the gofmt'ed Go source declaring the encodings
+func generate(pkg, config string, alphabets []alphabet) ([]byte, error) {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by base58gen from %s; DO NOT EDIT.\n\n", config)
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	fmt.Fprintf(&b, "import \"github.com/cyclone-github/base58\"\n\n")
+	if len(alphabets) > 0 {
+		b.WriteString("var (\n")
+		for _, a := range alphabets {
+			fmt.Fprintf(&b, "\t// base%d encoding with alphabet %s\n", len(a.chars), a.chars)
+			fmt.Fprintf(&b, "\t%sEncoding = base58.NewEncodingTables(%q, %q, &%s)\n", a.name, a.name, a.chars, decodeTableName(a.name))
+		}
+		b.WriteString(")\n")
+	}
+	for _, a := range alphabets {
+		var table [256]int
+		for i := range table {
+			table[i] = -1
+		}
+		for i := 0; i < len(a.chars); i++ {
+			table[a.chars[i]] = i
+		}
+		fmt.Fprintf(&b, "\n// digit values of the %s alphabet, -1 for invalid characters\n", a.name)
+		fmt.Fprintf(&b, "var %s = [256]int8{\n", decodeTableName(a.name))
+		for row := 0; row < 256; row += 16 {
+			b.WriteString("\t")
+			for i, v := range table[row : row+16] {
+				if i > 0 {
+					b.WriteString(" ")
+				}
+				fmt.Fprintf(&b, "%d,", v)
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString("}\n")
+	}
+	return format.Source(b.Bytes())
+}
+
+// unexported name of the decode table of the named alphabet
+func decodeTableName(name string) string {
+	return strings.ToLower(name[:1]) + name[1:] + "DecodeTable"
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testConfig = `# test alphabets
+Invoice    123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz
+
+hex        0123456789abcdef
+`
+
+func TestParseConfig(t *testing.T) {
+	alphabets, err := parseConfig(strings.NewReader(testConfig))
+	if err != nil {
+		t.Fatalf("parseConfig: %v", err)
+	}
+	if len(alphabets) != 2 || alphabets[0].name != "Invoice" || alphabets[1].chars != "0123456789abcdef" || alphabets[1].line != 4 {
+		t.Errorf("parseConfig: got %+v", alphabets)
+	}
+
+	tests := []struct {
+		config string
+		want   string
+	}{
+		{"Foo abc def", "1: want a name and an alphabet"},
+		{"9lives 0123", "1: \"9lives\" is not a Go identifier"},
+		{"_x 0123", "1: \"_x\" is not a Go identifier"},
+		{"Foo 0123\n\nfoo 4567", "3: foo conflicts with the name on line 1"},
+		{"Foo a", "1: Foo: alphabet has 1 characters"},
+		{"Foo abca", "1: Foo: alphabet character 'a' is repeated"},
+		{"Foo ab\x7f", "1: Foo: alphabet character '\\x7f' is not printable"},
+	}
+	for _, tt := range tests {
+		_, err := parseConfig(strings.NewReader(tt.config))
+		if err == nil || !strings.HasPrefix(err.Error(), tt.want) {
+			t.Errorf("parseConfig(%q): got error %v, want %q", tt.config, err, tt.want)
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	alphabets, _ := parseConfig(strings.NewReader(testConfig))
+	src, err := generate("alphabets", "alphabets.txt", alphabets)
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	for _, want := range []string{
+		"// Code generated by base58gen from alphabets.txt; DO NOT EDIT.\n",
+		"package alphabets\n",
+		"InvoiceEncoding = base58.NewEncodingTables(\"Invoice\", \"123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz\", &invoiceDecodeTable)",
+		"hexEncoding = base58.NewEncodingTables(\"hex\", \"0123456789abcdef\", &hexDecodeTable)",
+		"var hexDecodeTable = [256]int8{\n",
+		"\t0, 1, 2, 3, 4, 5, 6, 7, 8, 9, -1, -1, -1, -1, -1, -1,\n",
+	} {
+		if !bytes.Contains(src, []byte(want)) {
+			t.Errorf("generated source lacks %q:\n%s", want, src)
+		}
+	}
+}
+
+// generate a package inside this module and run a program using it
+func TestGeneratedCode(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds a program")
+	}
+	gobin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+	dir, err := os.MkdirTemp(".", "testgen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	config := filepath.Join(dir, "alphabets.txt")
+	if err := os.WriteFile(config, []byte(testConfig), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := run([]string{"-in", config, "-package", "main"}, os.Stderr); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "alphabets_gen.go")); err != nil {
+		t.Fatalf("default output file: %v", err)
+	}
+	prog := `package main
+
+import (
+	"fmt"
+
+	"github.com/cyclone-github/base58"
+)
+
+func main() {
+	fmt.Println(InvoiceEncoding.Equal(base58.StdEncoding), InvoiceEncoding.Name())
+	fmt.Println(InvoiceEncoding.EncodeToString([]byte("sure.")), hexEncoding.EncodeToString([]byte{0x01, 0xab}))
+	b, err := hexEncoding.DecodeString("1ab")
+	fmt.Println(b, err)
+}
+`
+	if err := os.WriteFile(filepath.Join(dir, "main.go"), []byte(prog), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	out, err := exec.Command(gobin, "run", "./"+dir).CombinedOutput()
+	if err != nil {
+		t.Fatalf("go run: %v\n%s", err, out)
+	}
+	want := "true Invoice\nE2XFRyo 1ab\n[1 171] <nil>\n"
+	if string(out) != want {
+		t.Errorf("generated encodings: got %q, want %q", out, want)
+	}
+}
+
+func TestRunUsage(t *testing.T) {
+	var stderr bytes.Buffer
+	if err := run(nil, &stderr); err == nil {
+		t.Error("run without -in: got no error")
+	}
+	t.Setenv("GOPACKAGE", "")
+	if err := run([]string{"-in", "x.txt"}, &stderr); err == nil || !strings.Contains(err.Error(), "GOPACKAGE") {
+		t.Errorf("run without package: got %v", err)
+	}
+}
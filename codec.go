@@ -0,0 +1,116 @@
+package base58
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"math"
+)
+
+/*
+Codec Interface
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Codec is the common surface of this package's encodings and the standard
+library's, so frameworks can accept any text codec and users can switch
+encodings by name in configuration:
+
+	codec, ok := base58.LookupCodec(cfg.IDEncoding) // "bitcoin", "hex", ...
+	id := codec.EncodeToString(raw)
+
+*base64.Encoding and *base32.Encoding satisfy Codec as they are, Hex
+adapts the encoding/hex functions. Base58 output length depends on the
+value, so EncodedLen and DecodedLen of this package's encodings are upper
+bounds rather than exact lengths.
+*/
+
+// text encoding of byte slices
+type Codec interface {
+	EncodeToString(src []byte) string
+	DecodeString(s string) ([]byte, error)
+	EncodedLen(n int) int // maximum encoded length of n bytes
+	DecodedLen(n int) int // maximum decoded length of n characters
+}
+
+var (
+	_ Codec = (*Encoding)(nil)
+	_ Codec = (*CheckEncoding)(nil)
+	_ Codec = (*MoneroEncoding)(nil)
+	_ Codec = (*RuneEncoding)(nil)
+	_ Codec = (*base64.Encoding)(nil)
+	_ Codec = (*base32.Encoding)(nil)
+)
+
+// return the maximum length of the encoding of n bytes, including
+// padding, separators and line breaks
+func (enc *Encoding) EncodedLen(n int) int {
+	l := 0
+	if n > 0 {
+		// digits of the largest n-byte number, leading zero bytes take one
+		// digit each which is never more
+		l = int(float64(n)*8/math.Log2(float64(enc.base))) + 1
+	}
+	l = max(l, enc.padWidth)
+	if enc.groupSize > 0 {
+		l += (l - 1) / enc.groupSize
+	}
+	if enc.lineLen > 0 {
+		l += (l - 1) / enc.lineLen
+	}
+	return l
+}
+
+// return the maximum decoded length of n encoded characters, each
+// character decodes to at most one byte since every leading zero digit is
+// a zero byte
+func (enc *Encoding) DecodedLen(n int) int {
+	return max(n, 0)
+}
+
+// return the maximum length of the encoding of an n-byte payload
+func (ce *CheckEncoding) EncodedLen(n int) int {
+	return ce.enc.EncodedLen(n + ce.checksumLen)
+}
+
+// return the maximum payload length decoded from n characters
+func (ce *CheckEncoding) DecodedLen(n int) int {
+	return max(ce.enc.DecodedLen(n)-ce.checksumLen, 0)
+}
+
+// lowercase hexadecimal Codec backed by encoding/hex
+var Hex Codec = hexCodec{}
+
+type hexCodec struct{}
+
+func (hexCodec) EncodeToString(src []byte) string      { return hex.EncodeToString(src) }
+func (hexCodec) DecodeString(s string) ([]byte, error) { return hex.DecodeString(s) }
+func (hexCodec) EncodedLen(n int) int                  { return hex.EncodedLen(n) }
+func (hexCodec) DecodedLen(n int) int                  { return hex.DecodedLen(n) }
+
+// return the Codec for name: a registered base58 encoding name such as
+// "bitcoin" or "flickr", "hex", "base64", "base64url", "base64raw",
+// "base64rawurl" or "base32"
+func LookupCodec(name string) (Codec, bool) {
+	switch name {
+	case "hex":
+		return Hex, true
+	case "base64":
+		return base64.StdEncoding, true
+	case "base64url":
+		return base64.URLEncoding, true
+	case "base64raw":
+		return base64.RawStdEncoding, true
+	case "base64rawurl":
+		return base64.RawURLEncoding, true
+	case "base32":
+		return base32.StdEncoding, true
+	}
+	for _, enc := range Encodings() {
+		if enc.name != "" && enc.name == name {
+			return enc, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,67 @@
+package base58_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestCodecLen(t *testing.T) {
+	codecs := []base58.Codec{
+		base58.StdEncoding,
+		base58.GMPEncoding.WithPadWidth(30),
+		base58.StdEncoding.WithSeparator('-', 4).WithWrap(10),
+		base58.StdEncoding.WithPadWidth(12).WithSeparator('-', 4),
+		base58.StdEncoding.WithPadWidth(12).WithWrap(5),
+		base58.StdEncoding.WithPadWidth(25).WithSeparator('-', 4).WithWrap(10),
+		base58.NewRadixEncoding("01"),
+		base36Encoding,
+		base58.StdCheckEncoding,
+		base58.StdMoneroEncoding,
+		base58.Hex,
+		base64.StdEncoding,
+	}
+	for _, c := range codecs {
+		for n := 0; n <= 70; n++ {
+			for _, fill := range []byte{0x00, 0x01, 0xff} {
+				src := bytes.Repeat([]byte{fill}, n)
+				s := c.EncodeToString(src)
+				if len(s) > c.EncodedLen(n) {
+					t.Errorf("%T %v: len(EncodeToString(%d x %#x)) = %d > EncodedLen = %d", c, c, n, fill, len(s), c.EncodedLen(n))
+				}
+				// padded encodings reject values wider than the pad width
+				decoded, err := c.DecodeString(s)
+				if err == nil && len(decoded) > c.DecodedLen(len(s)) {
+					t.Errorf("%T %v: decoded %d bytes from %d characters > DecodedLen = %d", c, c, len(decoded), len(s), c.DecodedLen(len(s)))
+				}
+			}
+		}
+	}
+}
+
+func TestLookupCodec(t *testing.T) {
+	for name, want := range map[string]string{
+		"bitcoin":   "E2XFRyo",
+		"ripple":    "NpXERyo",
+		"hex":       "737572652e",
+		"base64":    "c3VyZS4=",
+		"base64raw": "c3VyZS4",
+		"base32":    "ON2XEZJO",
+	} {
+		c, ok := base58.LookupCodec(name)
+		if !ok {
+			t.Errorf("LookupCodec(%q): not found", name)
+			continue
+		}
+		testEqual(t, "LookupCodec: got %q, want %q", want, c.EncodeToString([]byte("sure.")))
+		decoded, err := c.DecodeString(want)
+		if err != nil || string(decoded) != "sure." {
+			t.Errorf("LookupCodec(%q).DecodeString: got %q, %v", name, decoded, err)
+		}
+	}
+	if _, ok := base58.LookupCodec("custom"); ok {
+		t.Error(`LookupCodec("custom"): found`)
+	}
+}
@@ -0,0 +1,69 @@
+package compat
+
+import (
+	"crypto/sha256"
+	"errors"
+
+	"github.com/cyclone-github/base58"
+)
+
+/*
+btcutil Compatibility Shim
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Drop-in replacement for github.com/btcsuite/btcd/btcutil/base58: the same
+function signatures, error values and edge-case behavior, backed by this
+module, so existing code migrates with an import swap:
+
+	import base58 "github.com/cyclone-github/base58/compat"
+
+As in btcutil, Decode returns an empty slice instead of an error for
+invalid input and the error messages carry no package prefix.
+*/
+
+var (
+	// checksum does not match, as btcutil's base58.ErrChecksum
+	ErrChecksum = errors.New("checksum error")
+	// input too short for a version byte and checksum, or not base58, as
+	// btcutil's base58.ErrInvalidFormat
+	ErrInvalidFormat = errors.New("invalid format: version and/or checksum bytes missing")
+)
+
+// encode b with the bitcoin alphabet
+func Encode(b []byte) string {
+	return base58.StdEncoding.EncodeToString(b)
+}
+
+// decode b with the bitcoin alphabet, returning an empty slice if b is
+// not valid base58
+func Decode(b string) []byte {
+	decoded, err := base58.StdEncoding.DecodeString(b)
+	if err != nil || decoded == nil {
+		return []byte("")
+	}
+	return decoded
+}
+
+// prepend version to input and append the 4-byte double-SHA256 checksum
+// before encoding
+func CheckEncode(input []byte, version byte) string {
+	return base58.StdCheckEncoding.EncodeVersion([]byte{version}, input)
+}
+
+// decode input, verify its checksum and split off the version byte
+func CheckDecode(input string) (result []byte, version byte, err error) {
+	decoded := Decode(input)
+	if len(decoded) < 5 {
+		return nil, 0, ErrInvalidFormat
+	}
+	version = decoded[0]
+	body, sum := decoded[:len(decoded)-4], decoded[len(decoded)-4:]
+	h := sha256.Sum256(body)
+	h = sha256.Sum256(h[:])
+	if string(h[:4]) != string(sum) {
+		return nil, 0, ErrChecksum
+	}
+	return body[1:], version, nil
+}
@@ -0,0 +1,107 @@
+package compat_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/cyclone-github/base58/compat"
+)
+
+// vectors from btcutil's base58 tests
+var stringTests = []struct {
+	in  string
+	out string
+}{
+	{"", ""},
+	{" ", "Z"},
+	{"-", "n"},
+	{"0", "q"},
+	{"1", "r"},
+	{"-1", "4SU"},
+	{"11", "4k8"},
+	{"abc", "ZiCa"},
+	{"1234598760", "3mJr7AoUXx2Wqd"},
+	{"abcdefghijklmnopqrstuvwxyz", "3yxU3u1igY8WkgtjK92fbJQCd4BZiiT1v25f"},
+}
+
+var hexTests = []struct {
+	in  string
+	out string
+}{
+	{"", ""},
+	{"61", "2g"},
+	{"626262", "a3gV"},
+	{"636363", "aPEr"},
+	{"00eb15231dfceb60925886b67d065299925915aeb172c06647", "1NS17iag9jJgTHD1VXjvLCEnZuQ3rJDE9L"},
+	{"516b6fcd0f", "ABnLTmg"},
+	{"bf4f89001e670274dd", "3SEo3LWLoPntC"},
+	{"572e4794", "3EFU7m"},
+	{"ecac89cad93923c02321", "EJDM8drfXA6uyA"},
+	{"10c8511e", "Rt5zm"},
+	{"00000000000000000000", "1111111111"},
+}
+
+func TestEncodeDecode(t *testing.T) {
+	for _, tt := range stringTests {
+		if got := compat.Encode([]byte(tt.in)); got != tt.out {
+			t.Errorf("Encode(%q): got %q, want %q", tt.in, got, tt.out)
+		}
+		if got := compat.Decode(tt.out); string(got) != tt.in {
+			t.Errorf("Decode(%q): got %q, want %q", tt.out, got, tt.in)
+		}
+	}
+	for _, tt := range hexTests {
+		b, _ := hex.DecodeString(tt.in)
+		if got := compat.Encode(b); got != tt.out {
+			t.Errorf("Encode(%s): got %q, want %q", tt.in, got, tt.out)
+		}
+		if got := hex.EncodeToString(compat.Decode(tt.out)); got != tt.in {
+			t.Errorf("Decode(%q): got %s, want %s", tt.out, got, tt.in)
+		}
+	}
+	for _, s := range []string{"0", "O", "I", "l", "3mJr0", "O3yxU", "3sNI", "4kl8", "0OIl", "!@#$%^&*()-_=+~`"} {
+		if got := compat.Decode(s); got == nil || len(got) != 0 {
+			t.Errorf("Decode(%q): got %#v, want empty slice", s, got)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		version byte
+		in      string
+		out     string
+	}{
+		{20, "", "3MNQE1X"},
+		{20, " ", "B2Kr6dBE"},
+		{20, "-", "B3jv1Aft"},
+		{20, "0", "B482yuaX"},
+		{20, "1", "B4CmeGAC"},
+		{20, "-1", "mM7eUf6kB"},
+		{20, "11", "mP7BMTDVH"},
+		{20, "abc", "4QiVtDjUdeq"},
+		{20, "1234598760", "ZmNb8uQn5zvnUohNCEPP"},
+		{20, "abcdefghijklmnopqrstuvwxyz", "K2RYDcKfupxwXdWhSAxQPCeiULntKm63UXyx5MvEH2"},
+		{20, "00000000000000000000000000000000000000000000000000000000000000", "bi1EWXwJay2udZVxLJozuTb8Meg4W9c6xnmJaRDjg6pri5MBAxb9XwrpQXbtnqEoRV5U2pixnFfwyXC8tRAVC8XxnjK"},
+	}
+	for _, tt := range tests {
+		if got := compat.CheckEncode([]byte(tt.in), tt.version); got != tt.out {
+			t.Errorf("CheckEncode(%q, %d): got %q, want %q", tt.in, tt.version, got, tt.out)
+		}
+		res, version, err := compat.CheckDecode(tt.out)
+		if err != nil || version != tt.version || string(res) != tt.in {
+			t.Errorf("CheckDecode(%q): got %q, %d, %v", tt.out, res, version, err)
+		}
+	}
+
+	// 4 bytes decode to a checksum without a version byte
+	if _, _, err := compat.CheckDecode("3MNQE1X"[:5]); err != compat.ErrInvalidFormat {
+		t.Errorf("CheckDecode short: got %v, want ErrInvalidFormat", err)
+	}
+	if _, _, err := compat.CheckDecode("3MNQE1Y"); err != compat.ErrChecksum {
+		t.Errorf("CheckDecode bad checksum: got %v, want ErrChecksum", err)
+	}
+	if _, _, err := compat.CheckDecode("3MNQE10"); err != compat.ErrInvalidFormat {
+		t.Errorf("CheckDecode invalid character: got %v, want ErrInvalidFormat", err)
+	}
+}
@@ -0,0 +1,205 @@
+package base58
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"math"
+)
+
+/*
+Constant-Time Encoding
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+EncodeToString and DecodeString stop dividing once the number is used up
+and index the alphabet tables by digit value, so their timing and memory
+access patterns depend on the data. That is fine for addresses and IDs but
+not for private keys and seeds on shared hardware. The ConstantTime
+variants run a fixed number of iterations for the input length, divide by
+multiplying with a precomputed reciprocal, scan the whole alphabet for
+every lookup and select results with crypto/subtle masks.
+
+What remains visible is the input and output length, which any base58
+string reveals, and, on decode, the positions of characters skipped by
+WithSeparator, WithWrap, WithIgnore or Lenient. Intermediate buffers are
+cleared before returning.
+*/
+
+// report whether enc has no options the constant-time variants cannot
+// honor
+func (enc *Encoding) constantTimeSupported() bool {
+	return enc.padWidth == 0 && enc.onInvalid == nil
+}
+
+// panic if enc has options the constant-time variants cannot honor
+func (enc *Encoding) checkConstantTime() {
+	if !enc.constantTimeSupported() {
+		panic("base58: constant-time variants do not support WithPadWidth or WithInvalidHandler")
+	}
+}
+
+// encode src like EncodeToString, in time depending only on len(src) and
+// the encoded length. Panics if enc uses WithPadWidth or
+// WithInvalidHandler.
+func (enc *Encoding) ConstantTimeEncodeToString(src []byte) string {
+	enc.checkConstantTime()
+	base := uint32(enc.base)
+	// q = x*recip>>32 equals x/base for every x < base*256 (< 2^15)
+	recip := uint64(1<<32)/uint64(base) + 1
+
+	// little-endian digits, enough for the largest len(src)-byte number
+	digits := make([]uint32, int(float64(len(src))*8/math.Log2(float64(base)))+1)
+	defer clear(digits)
+	zeros, leading := 0, 1
+	for i := range src {
+		// the byte order depends only on the encoding, not on the data
+		b := src[i]
+		if enc.littleEndian {
+			b = src[len(src)-1-i]
+		}
+		leading &= subtle.ConstantTimeByteEq(b, 0)
+		zeros += leading
+		carry := uint32(b)
+		for j, d := range digits {
+			x := d<<8 | carry
+			q := uint32(uint64(x) * recip >> 32)
+			digits[j] = x - q*base
+			carry = q
+		}
+	}
+	sig := 0
+	for j, d := range digits {
+		sig = subtle.ConstantTimeSelect(subtle.ConstantTimeEq(int32(d), 0), sig, j+1)
+	}
+
+	// zeros+sig never exceeds len(digits), digits past sig are zero
+	out := make([]byte, zeros+sig)
+	zero := int(enc.zeroDigit())
+	for i := range out {
+		c := enc.constantTimeChar(digits[len(out)-1-i])
+		out[i] = byte(subtle.ConstantTimeSelect(subtle.ConstantTimeLessOrEq(i+1, zeros), zero, int(c)))
+	}
+	if enc.groupSize > 0 {
+		out = enc.group(out)
+	}
+	if enc.lineLen > 0 {
+		out = enc.wrap(out)
+	}
+	return string(out)
+}
+
+// decode s like DecodeString, in time depending only on len(s), the
+// decoded length and the positions of skipped characters. An invalid
+// character is reported as a CorruptInputError after the whole input has
+// been processed. The input and decoded length limits of enc apply; they
+// depend only on lengths, which are not secret. Panics if enc uses
+// WithPadWidth or WithInvalidHandler.
+func (enc *Encoding) ConstantTimeDecodeString(s string) ([]byte, error) {
+	enc.checkConstantTime()
+	if err := enc.checkInputLen(len(s)); err != nil {
+		return nil, err
+	}
+	src := []byte(s)
+	defer clear(src)
+	if enc.groupSize > 0 || enc.hasIgnore || enc.lineLen > 0 || enc.lenient {
+		stripped := enc.stripIgnored(src)
+		defer clear(stripped)
+		src = stripped
+	}
+	// refuse the quadratic decode of inputs too long for the limit
+	if err := enc.checkDigitCount(len(src)); err != nil {
+		return nil, err
+	}
+	base := uint32(enc.base)
+
+	// little-endian bytes, each character adds less than one byte
+	num := make([]byte, len(src))
+	defer clear(num)
+	zeros, leading, bad := 0, 1, -1
+	for i, c := range src {
+		v, ok := enc.constantTimeValue(c)
+		if enc.zero != 0 {
+			// the custom zero digit is only valid among the leading zeros
+			isZero := subtle.ConstantTimeByteEq(c, enc.zero) & leading
+			v = subtle.ConstantTimeSelect(isZero, 0, v)
+			ok |= isZero
+		}
+		bad = subtle.ConstantTimeSelect((1^ok)&subtle.ConstantTimeEq(int32(bad), -1), i, bad)
+		leading &= subtle.ConstantTimeEq(int32(v), 0)
+		zeros += leading
+		carry := uint32(v)
+		for j, b := range num {
+			x := uint32(b)*base + carry
+			num[j] = byte(x)
+			carry = x >> 8
+		}
+	}
+	if bad >= 0 {
+		return nil, CorruptInputError(bad)
+	}
+	sig := 0
+	for j, b := range num {
+		sig = subtle.ConstantTimeSelect(subtle.ConstantTimeByteEq(b, 0), sig, j+1)
+	}
+	if enc.maxDecoded > 0 && zeros+sig > enc.maxDecoded {
+		return nil, &LimitError{Limit: int64(enc.maxDecoded)}
+	}
+	out := make([]byte, zeros+sig)
+	for i := range out {
+		out[i] = num[len(out)-1-i]
+	}
+	if enc.littleEndian {
+		reverseBytes(out)
+	}
+	return out, nil
+}
+
+// report whether a and b decode to the same bytes, for comparing encoded
+// secrets such as API tokens. Both are decoded with
+// ConstantTimeDecodeString, so formatting the encoding skips does not
+// matter, and their SHA-256 digests are compared with
+// subtle.ConstantTimeCompare, so the comparison leaks neither a shared
+// prefix nor a length difference. Invalid strings, strings over the length
+// limits of enc and encodings the constant-time variants do not support
+// are never equal.
+func (enc *Encoding) ConstantTimeEqualEncoded(a, b string) bool {
+	if !enc.constantTimeSupported() {
+		return false
+	}
+	da, errA := enc.ConstantTimeDecodeString(a)
+	db, errB := enc.ConstantTimeDecodeString(b)
+	ha, hb := sha256.Sum256(da), sha256.Sum256(db)
+	clear(da)
+	clear(db)
+	valid := subtle.ConstantTimeEq(int32(btoi(errA == nil)&btoi(errB == nil)), 1)
+	return subtle.ConstantTimeCompare(ha[:], hb[:])&valid == 1
+}
+
+// return 1 for true and 0 for false
+func btoi(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// return the alphabet character for digit d, reading every entry
+func (enc *Encoding) constantTimeChar(d uint32) byte {
+	c := 0
+	for i := 0; i < enc.base; i++ {
+		c |= subtle.ConstantTimeSelect(subtle.ConstantTimeEq(int32(i), int32(d)), int(enc.encode[i]), 0)
+	}
+	return byte(c)
+}
+
+// return the digit value of c and 1, or 0 and 0 if c is not in the
+// alphabet, reading every entry
+func (enc *Encoding) constantTimeValue(c byte) (v, ok int) {
+	for i := 0; i < enc.base; i++ {
+		eq := subtle.ConstantTimeByteEq(c, enc.encode[i])
+		v |= subtle.ConstantTimeSelect(eq, i, 0)
+		ok |= eq
+	}
+	return v, ok
+}
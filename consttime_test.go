@@ -0,0 +1,144 @@
+package base58_test
+
+import (
+	"bytes"
+	"errors"
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestConstantTime(t *testing.T) {
+	encodings := []*base58.Encoding{
+		base58.StdEncoding,
+		base58.RippleEncoding,
+		base58.GMPEncoding,
+		base58.NewRadixEncoding("01"),
+		base36Encoding,
+		base58.NewRadixEncoding("!\"#$%&'()*+,-./0123456789:;<=>?@ABCDEFGHIJKLMNOPQRSTUVWXYZ[\\]^_`abcdefghijklmnopqrstuvwxyz{|}~"),
+		base58.StdEncoding.WithZeroDigit('_'),
+		base58.StdEncoding.WithSeparator('-', 4).WithWrap(10),
+	}
+	rng := rand.New(rand.NewPCG(1, 2))
+	for _, enc := range encodings {
+		for n := 0; n <= 66; n++ {
+			src := make([]byte, n)
+			for i := range src {
+				src[i] = byte(rng.Uint32())
+			}
+			// vary the leading zeros
+			for i := 0; i < n && i < int(rng.Uint32N(4)); i++ {
+				src[i] = 0
+			}
+			want := enc.EncodeToString(src)
+			got := enc.ConstantTimeEncodeToString(src)
+			if got != want {
+				t.Errorf("%v: ConstantTimeEncodeToString(%x): got %q, want %q", enc, src, got, want)
+			}
+			decoded, err := enc.ConstantTimeDecodeString(want)
+			if err != nil || !bytes.Equal(decoded, src) {
+				t.Errorf("%v: ConstantTimeDecodeString(%q): got %x, %v, want %x", enc, want, decoded, err, src)
+			}
+		}
+	}
+
+	if got := base58.StdEncoding.ConstantTimeEncodeToString(nil); got != "" {
+		t.Errorf("ConstantTimeEncodeToString(nil): got %q", got)
+	}
+	if got := base58.StdEncoding.ConstantTimeEncodeToString([]byte{0, 0, 0}); got != "111" {
+		t.Errorf("ConstantTimeEncodeToString(000000): got %q", got)
+	}
+	for _, tt := range []struct {
+		enc *base58.Encoding
+		s   string
+		err error
+	}{
+		{base58.StdEncoding, "E2X0Ryo", base58.CorruptInputError(3)},
+		{base58.StdEncoding, "E2X0RyO", base58.CorruptInputError(3)},
+		{base58.StdEncoding.WithZeroDigit('_'), "__2_", base58.CorruptInputError(3)},
+		{base58.StdEncoding.WithZeroDigit('_'), "1_2", nil},
+	} {
+		if _, err := tt.enc.ConstantTimeDecodeString(tt.s); err != tt.err {
+			t.Errorf("%v: ConstantTimeDecodeString(%q): got %v, want %v", tt.enc, tt.s, err, tt.err)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("ConstantTimeEncodeToString with pad width: no panic")
+		}
+	}()
+	base58.StdEncoding.WithPadWidth(10).ConstantTimeEncodeToString([]byte{1})
+}
+
+func TestConstantTimeEqualEncoded(t *testing.T) {
+	enc := base58.StdEncoding.WithSeparator('-', 4)
+	token := enc.EncodeToString([]byte("secret api token"))
+	for _, tt := range []struct {
+		a, b string
+		want bool
+	}{
+		{token, token, true},
+		{token, strings.ReplaceAll(token, "-", ""), true},
+		{token, enc.EncodeToString([]byte("secret api tokeN")), false},
+		{token, enc.EncodeToString([]byte("secret api token!")), false},
+		{token, enc.EncodeToString([]byte("\x00secret api token")), false},
+		{"", "", true},
+		{"0OIl", "0OIl", false},
+		{token, token + "0", false},
+	} {
+		if got := enc.ConstantTimeEqualEncoded(tt.a, tt.b); got != tt.want {
+			t.Errorf("ConstantTimeEqualEncoded(%q, %q): got %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestConstantTimeLimits(t *testing.T) {
+	long := base58.StdEncoding.EncodeToString(bytes.Repeat([]byte{0xa5}, 64))
+	// the limits apply before the quadratic decode
+	for _, enc := range []*base58.Encoding{
+		base58.StdEncoding.WithMaxInputLen(40),
+		base58.StdEncoding.WithMaxDecodedLen(32),
+	} {
+		if _, err := enc.ConstantTimeDecodeString(long); err == nil {
+			t.Errorf("%v ConstantTimeDecodeString of %d characters: expected a limit error", enc, len(long))
+		}
+		if enc.ConstantTimeEqualEncoded(long, long) {
+			t.Errorf("%v ConstantTimeEqualEncoded over the limit: got true, want false", enc)
+		}
+	}
+	var lerr *base58.LimitError
+	if _, err := base58.StdEncoding.WithMaxDecodedLen(32).ConstantTimeDecodeString(long); !errors.As(err, &lerr) {
+		t.Errorf("ConstantTimeDecodeString over the decoded limit: got %v, want *LimitError", err)
+	}
+	// the decoded limit also holds for strings that pass the length bound
+	zeros := strings.Repeat("1", 40)
+	if _, err := base58.StdEncoding.WithMaxDecodedLen(32).ConstantTimeDecodeString(zeros); !errors.As(err, &lerr) {
+		t.Errorf("ConstantTimeDecodeString of 40 zero digits: got %v, want *LimitError", err)
+	}
+
+	// unsupported options compare unequal instead of panicking
+	padded := base58.StdEncoding.WithPadWidth(10)
+	s := padded.EncodeToString([]byte("key"))
+	if padded.ConstantTimeEqualEncoded(s, s) {
+		t.Errorf("ConstantTimeEqualEncoded with WithPadWidth: got true, want false")
+	}
+}
+
+func BenchmarkConstantTimeEncode(b *testing.B) {
+	data := bytes.Repeat([]byte{0xa5}, 32)
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		base58.StdEncoding.ConstantTimeEncodeToString(data)
+	}
+}
+
+func BenchmarkConstantTimeDecode(b *testing.B) {
+	s := base58.StdEncoding.EncodeToString(bytes.Repeat([]byte{0xa5}, 32))
+	b.SetBytes(int64(len(s)))
+	for i := 0; i < b.N; i++ {
+		base58.StdEncoding.ConstantTimeDecodeString(s)
+	}
+}
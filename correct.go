@@ -0,0 +1,114 @@
+package base58
+
+/*
+Typo Correction
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+For checked strings that fail to decode, Correct searches a bounded
+neighbourhood of the input for strings whose checksum validates:
+
+  - characters outside the alphabet are replaced with their look-alikes
+    (0/O/o, I/l/1/i), the classic confusions base58 was designed around
+  - then at most one further single-character substitution or adjacent
+    transposition is tried
+
+A 4-byte checksum makes a false positive in this neighbourhood very
+unlikely, but callers should still confirm a correction with the user.
+*/
+
+// maximum look-alike combinations tried for characters outside the alphabet
+const maxCorrectionBases = 64
+
+// characters commonly misread or mistyped as each other
+var confusables = map[byte]string{
+	'0': "oO",
+	'O': "o0",
+	'o': "O0",
+	'I': "1li",
+	'l': "1Ii",
+	'|': "1lI",
+	'1': "lIi",
+	'i': "1lI",
+}
+
+// return candidate corrections of s whose checksum validates, s itself if
+// it is already valid, or nil if no correction was found
+func (ce *CheckEncoding) Correct(s string) []string {
+	if ce.valid(s) {
+		return []string{s}
+	}
+
+	var found []string
+	seen := make(map[string]bool)
+	add := func(c string) {
+		if !seen[c] && ce.valid(c) {
+			seen[c] = true
+			found = append(found, c)
+		}
+	}
+
+	for _, base := range ce.lookAlikes(s) {
+		if base != s && ce.valid(base) {
+			add(base)
+			continue
+		}
+		b := []byte(base)
+		// single-character substitutions
+		for i := range b {
+			orig := b[i]
+			for _, c := range ce.enc.encode[:ce.enc.base] {
+				if c != orig {
+					b[i] = c
+					add(string(b))
+				}
+			}
+			b[i] = orig
+		}
+		// adjacent transpositions
+		for i := 0; i+1 < len(b); i++ {
+			if b[i] != b[i+1] {
+				b[i], b[i+1] = b[i+1], b[i]
+				add(string(b))
+				b[i], b[i+1] = b[i+1], b[i]
+			}
+		}
+	}
+	return found
+}
+
+// correct a base58check string with StdCheckEncoding
+func CheckCorrect(s string) []string {
+	return StdCheckEncoding.Correct(s)
+}
+
+// report whether s decodes and its checksum validates
+func (ce *CheckEncoding) valid(s string) bool {
+	_, err := ce.DecodeString(s)
+	return err == nil
+}
+
+// return s with each character outside the alphabet replaced by its
+// look-alikes in the alphabet, up to maxCorrectionBases combinations
+func (ce *CheckEncoding) lookAlikes(s string) []string {
+	bases := []string{s}
+	for i := 0; i < len(s); i++ {
+		if ce.enc.reverse[s[i]] != -1 {
+			continue
+		}
+		var next []string
+		for _, base := range bases {
+			for _, c := range []byte(confusables[s[i]]) {
+				if ce.enc.reverse[c] != -1 && len(next) < maxCorrectionBases {
+					next = append(next, base[:i]+string(c)+base[i+1:])
+				}
+			}
+		}
+		if len(next) == 0 {
+			return nil
+		}
+		bases = next
+	}
+	return bases
+}
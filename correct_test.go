@@ -0,0 +1,38 @@
+package base58_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestCheckCorrect(t *testing.T) {
+	const addr = "1BoatSLRHtKNngkdXEeobR76b53LETtpyT"
+	tests := []string{
+		addr,
+		"1B0atSLRHtKNngkdXEeobR76b53LETtpyT", // 0 for o
+		"lBoatSLRHtKNngkdXEeobR76b53LETtpyT", // l for 1
+		"1BoatSLRHtKNngkdXEeobR76b53LETtpy7", // substitution
+		"1BoatSLRHtKNngkdXEeobR67b53LETtpyT", // transposition
+		"1B0atSLRHtKNngkdXEeobR67b53LETtpyT", // look-alike and transposition
+	}
+	for _, in := range tests {
+		got := base58.CheckCorrect(in)
+		if len(got) != 1 {
+			t.Errorf("CheckCorrect(%q): got %d candidates %q, want 1", in, len(got), got)
+			continue
+		}
+		msg := fmt.Sprintf("CheckCorrect(%q): got %%q, want %%q", in)
+		testEqual(t, msg, addr, got[0])
+	}
+
+	// two substitutions are beyond the search
+	if got := base58.CheckCorrect("1BoatSLRHtKNngkdXEeobR76b53LETtp77"); got != nil {
+		t.Errorf("CheckCorrect(two errors): got %q, want nil", got)
+	}
+	// no look-alike for '#'
+	if got := base58.CheckCorrect("1BoatSLRHtKNngkdXEeobR76b53LETtpy#"); got != nil {
+		t.Errorf("CheckCorrect('#'): got %q, want nil", got)
+	}
+}
@@ -0,0 +1,141 @@
+package base58
+
+import "errors"
+
+/*
+Decoding into Caller Memory
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Decode and DecodeToBytes build the result in heap slices that the caller
+never sees (the digit array, the dividend and the reversed output), and
+Secure clears them but cannot keep them off the heap. DecodeInto instead
+accumulates the decoded number directly in dst, so key material can be
+decoded into an mlock'd or memguard-managed region:
+
+	n, err := base58.StdEncoding.DecodeInto(lockedBuf, wifBytes)
+
+Digit values are read from src one at a time and the number is built by
+multiply-and-add in place. Apart from src and dst, plaintext only passes
+through a few integer locals on the stack: the current digit, the carry,
+and the zero digit count. Nothing is allocated on success. On failure dst
+is cleared before returning. An invalid character handler sees the
+offending characters, and error messages carry offsets but never data.
+*/
+
+// decoded data does not fit the destination buffer
+var ErrShortBuffer = errors.New("base58: destination buffer too small")
+
+// decode src into dst without intermediate heap buffers, returning the
+// number of bytes written. Returns ErrShortBuffer if dst cannot hold the
+// result, or a *LimitError if the decoded length limit is smaller.
+// Honors the pad width, zero digit, skipped characters, invalid character
+// handler and length limits of enc.
+func (enc *Encoding) DecodeInto(dst, src []byte) (n int, err error) {
+	start := enc.traceStart()
+	defer func() {
+		if err != nil {
+			clear(dst)
+		}
+		if enc.instr != nil {
+			enc.observeDecode(dst[:n], err)
+		}
+		enc.traceDone("decode", "into", start, len(src), n, err)
+	}()
+	if err := enc.checkInputLen(len(src)); err != nil {
+		return 0, err
+	}
+	full := error(ErrShortBuffer)
+	if enc.maxDecoded > 0 && len(dst) > enc.maxDecoded {
+		dst = dst[:enc.maxDecoded]
+		full = &LimitError{Limit: int64(enc.maxDecoded)}
+	}
+	// the number grows leftwards from the end of dst, used bytes long
+	used, zeros, count, padZeros := 0, 0, 0, 0
+	leading := true
+	for i := 0; i < len(src); i++ {
+		val, err := enc.digitValue(src[i], i, leading)
+		if err != nil {
+			return 0, err
+		}
+		if val == -1 {
+			continue
+		}
+		count++
+		if leading && val == 0 {
+			if enc.padWidth == 0 {
+				zeros++
+			} else {
+				padZeros++
+			}
+			continue
+		}
+		leading = false
+		carry := val
+		for j := len(dst) - 1; j >= len(dst)-used; j-- {
+			carry += int(dst[j]) * enc.base
+			dst[j] = byte(carry)
+			carry >>= 8
+		}
+		for carry > 0 {
+			if zeros+used >= len(dst) {
+				return 0, full
+			}
+			used++
+			dst[len(dst)-used] = byte(carry)
+			carry >>= 8
+		}
+	}
+	if enc.padWidth > 0 {
+		if err := enc.checkPadCount(count, padZeros > 0); err != nil {
+			return 0, err
+		}
+		// the value is extended to PadSize bytes
+		zeros = max(enc.padSize-used, 0)
+	}
+	if zeros+used > len(dst) {
+		return 0, full
+	}
+	// move the number next to the leading zero bytes and clear the rest
+	copy(dst[zeros:], dst[len(dst)-used:])
+	clear(dst[:zeros])
+	clear(dst[zeros+used:])
+	if enc.littleEndian {
+		reverseBytes(dst[:zeros+used])
+	}
+	if err := enc.runValidators(dst[:zeros+used]); err != nil {
+		return 0, err
+	}
+	return zeros + used, nil
+}
+
+// return the digit value of character c at input offset i, or -1 if c is
+// skipped, applying the zero digit while leading and the skipped characters
+// and invalid character handler of enc
+func (enc *Encoding) digitValue(c byte, i int, leading bool) (int, error) {
+	val := int(enc.reverse[c])
+	if leading && enc.zero != 0 && c == enc.zero {
+		val = 0
+	}
+	if val != -1 {
+		return val, nil
+	}
+	if enc.ignored(c) {
+		return -1, nil
+	}
+	if enc.onInvalid == nil {
+		return -1, CorruptInputError(i)
+	}
+	repl, skip, err := enc.onInvalid(c, i)
+	if err != nil {
+		return -1, err
+	}
+	if skip {
+		return -1, nil
+	}
+	if val = int(enc.reverse[repl]); val == -1 {
+		return -1, CorruptInputError(i)
+	}
+	return val, nil
+}
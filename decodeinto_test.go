@@ -0,0 +1,93 @@
+package base58_test
+
+import (
+	"bytes"
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestDecodeInto(t *testing.T) {
+	r := rand.New(rand.NewPCG(5, 6))
+	encodings := []*base58.Encoding{
+		base58.StdEncoding,
+		base58.FlickrEncoding.WithSeparator('-', 4).WithWrap(10),
+		base58.StdEncoding.WithZeroDigit('_'),
+		base58.StdEncoding.WithPadWidth(50),
+		base36Encoding,
+	}
+	for _, enc := range encodings {
+		for i := 0; i < 200; i++ {
+			src := make([]byte, r.IntN(36))
+			for j := range src {
+				if r.IntN(3) > 0 {
+					src[j] = byte(r.Uint32())
+				}
+			}
+			s := enc.EncodeToString(src)
+			want, err := enc.DecodeString(s)
+			if err != nil {
+				t.Fatalf("%v: DecodeString(%q): %v", enc, s, err)
+			}
+			dst := bytes.Repeat([]byte{0xee}, len(want)+r.IntN(3))
+			n, err := enc.DecodeInto(dst, []byte(s))
+			if err != nil || !bytes.Equal(dst[:n], want) {
+				t.Fatalf("%v: DecodeInto(%q): got %x, %v, want %x", enc, s, dst[:n], err, want)
+			}
+			if !allBytes(dst[n:], 0) {
+				t.Errorf("%v: DecodeInto(%q): tail not cleared: %x", enc, s, dst[n:])
+			}
+		}
+	}
+}
+
+func allBytes(b []byte, v byte) bool {
+	for _, c := range b {
+		if c != v {
+			return false
+		}
+	}
+	return true
+}
+
+func TestDecodeIntoErrors(t *testing.T) {
+	s := []byte(base58.StdEncoding.EncodeToString([]byte("secret key")))
+	dst := make([]byte, 9)
+	if _, err := base58.StdEncoding.DecodeInto(dst, s); !errors.Is(err, base58.ErrShortBuffer) || !allBytes(dst, 0) {
+		t.Errorf("DecodeInto short: got %v, dst %x", err, dst)
+	}
+	dst = make([]byte, 2)
+	if _, err := base58.StdEncoding.DecodeInto(dst, []byte("111")); !errors.Is(err, base58.ErrShortBuffer) {
+		t.Errorf("DecodeInto(111) into 2 bytes: got %v, want ErrShortBuffer", err)
+	}
+	dst = make([]byte, 32)
+	var lerr *base58.LimitError
+	if _, err := base58.StdEncoding.WithMaxDecodedLen(8).DecodeInto(dst, s); !errors.As(err, &lerr) {
+		t.Errorf("DecodeInto over limit: got %v, want LimitError", err)
+	}
+	bad := append(append([]byte(nil), s[:5]...), '0')
+	if _, err := base58.StdEncoding.DecodeInto(dst, bad); err != base58.CorruptInputError(5) || !allBytes(dst, 0) {
+		t.Errorf("DecodeInto(%q): got %v, want CorruptInputError(5) and cleared dst", bad, err)
+	}
+	fix := base58.StdEncoding.WithInvalidHandler(func(b byte, pos int) (byte, bool, error) {
+		return 'O', false, nil
+	})
+	if _, err := fix.DecodeInto(dst, []byte("0")); err != base58.CorruptInputError(0) {
+		t.Errorf("DecodeInto with handler replacement outside the alphabet: got %v", err)
+	}
+}
+
+func TestDecodeIntoAllocs(t *testing.T) {
+	s := []byte(base58.StdEncoding.EncodeToString(bytes.Repeat([]byte{0xa5}, 32)))
+	dst := make([]byte, 32)
+	allocs := testing.AllocsPerRun(100, func() {
+		if _, err := base58.StdEncoding.DecodeInto(dst, s); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("DecodeInto: %v allocations, want 0", allocs)
+	}
+}
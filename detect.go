@@ -0,0 +1,214 @@
+package base58
+
+import (
+	"errors"
+	"sync"
+)
+
+/*
+Format Detection
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Classify guesses whether a string is base58, hex, base32 or base64 from its
+character set, case mix, length and padding, optionally probing for a valid
+Base58Check checksum, so hash-identification pipelines can route inputs to
+the right decoder. Short inputs are inherently ambiguous and get low
+confidence scores.
+
+DetectAlphabet tests a string against the registered alphabets so tools
+ingesting mixed-ecosystem data can pick a variant without being told.
+*/
+
+var encodingRegistry = struct {
+	sync.RWMutex
+	encodings []*Encoding
+}{
+	encodings: []*Encoding{StdEncoding, RippleEncoding, FlickrEncoding, GMPEncoding},
+}
+
+// add enc to the alphabets tried by DetectAlphabet
+func RegisterEncoding(enc *Encoding) error {
+	if enc == nil {
+		return errors.New("base58: cannot register a nil encoding")
+	}
+	encodingRegistry.Lock()
+	defer encodingRegistry.Unlock()
+	for _, e := range encodingRegistry.encodings {
+		if e.Equal(enc) {
+			return errors.New("base58: encoding " + enc.String() + " already registered")
+		}
+	}
+	encodingRegistry.encodings = append(encodingRegistry.encodings, enc)
+	return nil
+}
+
+// return all registered encodings in registration order
+func Encodings() []*Encoding {
+	encodingRegistry.RLock()
+	defer encodingRegistry.RUnlock()
+	return append([]*Encoding(nil), encodingRegistry.encodings...)
+}
+
+// return the registered encodings that accept every character of s, in
+// registration order
+func DetectAlphabet(s string) []*Encoding {
+	encodingRegistry.RLock()
+	defer encodingRegistry.RUnlock()
+	var found []*Encoding
+	for _, enc := range encodingRegistry.encodings {
+		if enc.Validate(s) == nil {
+			found = append(found, enc)
+		}
+	}
+	return found
+}
+
+// encoding family guessed by Classify
+type Format int
+
+const (
+	FormatUnknown Format = iota
+	FormatBase58
+	FormatHex
+	FormatBase32
+	FormatBase64
+)
+
+func (f Format) String() string {
+	switch f {
+	case FormatBase58:
+		return "base58"
+	case FormatHex:
+		return "hex"
+	case FormatBase32:
+		return "base32"
+	case FormatBase64:
+		return "base64"
+	}
+	return "unknown"
+}
+
+// result of Classify
+type Classification struct {
+	Format     Format
+	Confidence float64 // 0 to 1
+	Checksum   bool    // input is valid Base58Check
+}
+
+// guess the encoding family of s, probing for a Base58Check checksum when
+// probeChecksum is set
+func Classify(s string, probeChecksum bool) Classification {
+	if s == "" {
+		return Classification{}
+	}
+
+	var lower, upper, digit, b64Only, other bool
+	pad := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if pad > 0 && c != '=' {
+			other = true
+			break
+		}
+		switch {
+		case c >= 'a' && c <= 'z':
+			lower = true
+		case c >= 'A' && c <= 'Z':
+			upper = true
+		case c >= '0' && c <= '9':
+			digit = true
+		case c == '+' || c == '/' || c == '-' || c == '_':
+			b64Only = true
+		case c == '=':
+			pad++
+		default:
+			other = true
+		}
+	}
+	if other {
+		return Classification{}
+	}
+
+	best := Classification{}
+	consider := func(f Format, score float64) {
+		if score > best.Confidence {
+			best = Classification{Format: f, Confidence: score}
+		}
+	}
+
+	if pad == 0 && !b64Only && StdEncoding.Validate(s) == nil {
+		score := 0.6
+		if lower && upper && digit {
+			score += 0.2
+		}
+		if !isHex(s) {
+			score += 0.1
+		} else {
+			score -= 0.3
+		}
+		if len(s)%4 != 0 {
+			score += 0.1
+		}
+		if probeChecksum {
+			if _, err := StdCheckEncoding.DecodeString(s); err == nil {
+				return Classification{Format: FormatBase58, Confidence: 1, Checksum: true}
+			}
+		}
+		consider(FormatBase58, min(score, 0.95))
+	}
+	if pad == 0 && !b64Only && isHex(s) && !(lower && upper) {
+		score := 0.9
+		if len(s)%2 != 0 {
+			score = 0.4
+		}
+		consider(FormatHex, score)
+	}
+	if !b64Only && !(lower && upper) && isBase32(s[:len(s)-pad]) {
+		score := 0.5
+		if len(s)%8 == 0 {
+			score = 0.8
+		}
+		consider(FormatBase32, score)
+	}
+	if pad <= 2 {
+		score := 0.5
+		switch {
+		case pad > 0 || b64Only:
+			score = 0.9
+		case len(s)%4 != 0:
+			score = 0.3
+		}
+		consider(FormatBase64, score)
+	}
+	return best
+}
+
+// report whether s is plausibly base58, with a confidence score
+func IsBase58(s string) (bool, float64) {
+	c := Classify(s, true)
+	return c.Format == FormatBase58, c.Confidence
+}
+
+// report whether s contains only hex digits
+func isHex(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
+
+// report whether s contains only RFC 4648 base32 characters in one case
+func isBase32(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !(c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '2' && c <= '7') {
+			return false
+		}
+	}
+	return true
+}
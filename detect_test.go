@@ -0,0 +1,76 @@
+package base58_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		in       string
+		format   base58.Format
+		checksum bool
+	}{
+		{"1BoatSLRHtKNngkdXEeobR76b53LETtpyT", base58.FormatBase58, true},
+		{"2ukVBARx4fMCUZXaHR1XvNbb3HgzmGYFEEThDa86tN2q8oU", base58.FormatBase58, false},
+		{"5HueCGU8rMjxEXxiPuD5BDku4MkFqeZyd4dZ1jvhTVqvbTLvyTJ", base58.FormatBase58, true},
+		{"d41d8cd98f00b204e9800998ecf8427e", base58.FormatHex, false},
+		{"DEADBEEF", base58.FormatHex, false},
+		{"JBSWY3DPEBLW64TMMQ======", base58.FormatBase32, false},
+		{"SGVsbG8sIHdvcmxkIQ==", base58.FormatBase64, false},
+		{"a+b/c0", base58.FormatBase64, false},
+		{"", base58.FormatUnknown, false},
+		{"not base anything!", base58.FormatUnknown, false},
+	}
+	for _, tt := range tests {
+		c := base58.Classify(tt.in, true)
+		if c.Format != tt.format || c.Checksum != tt.checksum {
+			t.Errorf("Classify(%q): got %v (checksum %v, confidence %.2f), want %v (checksum %v)",
+				tt.in, c.Format, c.Checksum, c.Confidence, tt.format, tt.checksum)
+		}
+		if (c.Format == base58.FormatUnknown) != (c.Confidence == 0) {
+			t.Errorf("Classify(%q): confidence %.2f for format %v", tt.in, c.Confidence, c.Format)
+		}
+	}
+
+	ok, conf := base58.IsBase58("1BoatSLRHtKNngkdXEeobR76b53LETtpyT")
+	testEqual(t, "IsBase58(address): got %s, want %s", "true 1.00", fmt.Sprintf("%v %.2f", ok, conf))
+	ok, conf = base58.IsBase58("1BoatSLRHtKNngkdXEeobR76b53LETtpyU")
+	if !ok || conf >= 1 {
+		t.Errorf("IsBase58(bad checksum): got %v %.2f, want true below 1", ok, conf)
+	}
+}
+
+func TestDetectAlphabet(t *testing.T) {
+	names := func(encs []*base58.Encoding) string {
+		var s []string
+		for _, e := range encs {
+			s = append(s, e.Name())
+		}
+		return fmt.Sprint(s)
+	}
+	tests := []struct {
+		in, want string
+	}{
+		{"1BoatSLRHtKNngkdXEeobR76b53LETtpyT", "[bitcoin ripple flickr]"},
+		{"0AqhNJ", "[gmp]"},
+		{"E2XFRyo", "[bitcoin ripple flickr]"},
+		{"E2XFRuo", "[bitcoin ripple flickr gmp]"},
+		{"0O!", "[]"},
+	}
+	for _, tt := range tests {
+		msg := fmt.Sprintf("DetectAlphabet(%q): got %%s, want %%s", tt.in)
+		testEqual(t, msg, tt.want, names(base58.DetectAlphabet(tt.in)))
+	}
+
+	custom := base58.NewRadixEncoding("!#$%&")
+	if err := base58.RegisterEncoding(custom); err != nil {
+		t.Fatalf("RegisterEncoding failed: %v", err)
+	}
+	if err := base58.RegisterEncoding(base58.NewRadixEncoding("!#$%&")); err == nil {
+		t.Errorf("RegisterEncoding(duplicate): expected error")
+	}
+	testEqual(t, "DetectAlphabet(custom): got %s, want %s", "[custom]", names(base58.DetectAlphabet("#!%&")))
+}
@@ -0,0 +1,62 @@
+package base58
+
+/*
+Little-Endian Payloads
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Base58 encodes the payload as one big-endian number. Some ecosystems and
+legacy formats serialize their integers little-endian and expect the
+number read that way, which otherwise means reversing buffers by hand on
+both sides:
+
+	le := base58.StdEncoding.LittleEndian()
+	s := le.EncodeToString(binary.LittleEndian.AppendUint64(nil, id))
+
+A little-endian encoding reads the first byte as the least significant,
+so trailing zero bytes map to the leading zero digits, and decoding
+returns the bytes in the same order. Encode, EncodeToBytes,
+EncodeToString, EncodeInPlace, Decode, DecodeToBytes, DecodeString,
+DecodeStringContext, DecodeInto, DecodeInPlace, DecodePrefix, the
+constant-time variants, the stream encoder and decoder and the check and
+block encodings built on the encoding are covered. SortableEncoding and
+the UUID helpers depend on big-endian order and ignore the setting, as do
+the numeric helpers (EncodeUint64, DecodeUint64, EncodeBigInt and
+DecodeBigInt), which all write numbers most significant digit first.
+*/
+
+// return a copy of enc that treats payloads as little-endian numbers
+func (enc *Encoding) LittleEndian() *Encoding {
+	e := *enc
+	e.littleEndian = true
+	return &e
+}
+
+// report whether enc treats payloads as little-endian numbers
+func (enc *Encoding) IsLittleEndian() bool {
+	return enc.littleEndian
+}
+
+// return enc, or a copy of enc that reads payloads big-endian
+func (enc *Encoding) asBigEndian() *Encoding {
+	if !enc.littleEndian {
+		return enc
+	}
+	e := *enc
+	e.littleEndian = false
+	return &e
+}
+
+// return src in big-endian order for the conversion, a scratch copy to
+// release if enc is little-endian
+func (enc *Encoding) bigEndian(src []byte) []byte {
+	if !enc.littleEndian {
+		return src
+	}
+	b := enc.get(len(src))
+	for i, c := range src {
+		b[len(b)-1-i] = c
+	}
+	return b
+}
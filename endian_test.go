@@ -0,0 +1,142 @@
+package base58_test
+
+import (
+	"bytes"
+	"io"
+	"math/big"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+// reversed copy of b
+func reversed(b []byte) []byte {
+	r := slices.Clone(b)
+	slices.Reverse(r)
+	return r
+}
+
+func TestLittleEndian(t *testing.T) {
+	le := base58.StdEncoding.LittleEndian()
+	inputs := [][]byte{
+		nil,
+		{0},
+		{1, 0, 0},
+		{0, 0, 1},
+		{0x39, 0x05, 0, 0, 0, 0, 0, 0},
+		[]byte(bigtest.decoded),
+	}
+	for _, src := range inputs {
+		want := base58.StdEncoding.EncodeToString(reversed(src))
+		testEqual(t, "LittleEndian EncodeToString = %q, want %q", want, le.EncodeToString(src))
+		testEqual(t, "LittleEndian ConstantTimeEncodeToString = %q, want %q", want, le.ConstantTimeEncodeToString(src))
+
+		var w strings.Builder
+		e := base58.NewEncoder(le, &w)
+		e.Write(src)
+		e.Close()
+		testEqual(t, "LittleEndian NewEncoder = %q, want %q", want, w.String())
+
+		buf := make([]byte, le.EncodedLen(len(src)))
+		n := copy(buf, src)
+		n, err := le.EncodeInPlace(buf, n)
+		if err != nil || string(buf[:n]) != want {
+			t.Errorf("LittleEndian EncodeInPlace(%x) = %q, %v, want %q", src, buf[:n], err, want)
+		}
+
+		decodes := map[string]func(s string) ([]byte, error){
+			"DecodeString":             le.DecodeString,
+			"ConstantTimeDecodeString": le.ConstantTimeDecodeString,
+			"DecodePrefix": func(s string) ([]byte, error) {
+				b, _, err := le.DecodePrefix([]byte(s))
+				return b, err
+			},
+			"DecodeInto": func(s string) ([]byte, error) {
+				dst := make([]byte, len(s)+1)
+				n, err := le.DecodeInto(dst, []byte(s))
+				return dst[:n], err
+			},
+			"DecodeInPlace": func(s string) ([]byte, error) {
+				b := []byte(s)
+				n, err := le.DecodeInPlace(b)
+				return b[:n], err
+			},
+			"NewDecoder": func(s string) ([]byte, error) {
+				return io.ReadAll(base58.NewDecoder(le, strings.NewReader(s)))
+			},
+		}
+		for name, decode := range decodes {
+			got, err := decode(want)
+			if err != nil || !bytes.Equal(got, src) {
+				t.Errorf("LittleEndian %s(%q) = %x, %v, want %x", name, want, got, err, src)
+			}
+		}
+	}
+}
+
+func TestLittleEndianOptions(t *testing.T) {
+	src := []byte{0x39, 0x05, 0, 0}
+
+	// padded values extend to PadSize at the most significant end
+	padded := base58.StdEncoding.WithPadWidth(11).LittleEndian()
+	s := padded.EncodeToString(src)
+	testEqual(t, "padded LittleEndian EncodeToString = %q, want %q", base58.StdEncoding.WithPadWidth(11).EncodeToString([]byte{0x05, 0x39}), s)
+	got, err := padded.DecodeString(s)
+	if err != nil || !bytes.Equal(got, []byte{0x39, 0x05, 0, 0, 0, 0, 0, 0}) {
+		t.Errorf("padded LittleEndian DecodeString(%q) = %x, %v", s, got, err)
+	}
+
+	// the checksum covers the payload as given
+	ce := base58.NewCheckEncoding(base58.StdEncoding.LittleEndian(), base58.NewDoubleSHA256, 4)
+	if got, err := ce.DecodeString(ce.EncodeToString(src)); err != nil || !bytes.Equal(got, src) {
+		t.Errorf("LittleEndian CheckEncoding round trip = %x, %v, want %x", got, err, src)
+	}
+
+	var block bytes.Buffer
+	base58.EncodeStream(base58.StdEncoding.LittleEndian(), &block, bytes.NewReader(src))
+	var out bytes.Buffer
+	if _, err := base58.DecodeStream(base58.StdEncoding.LittleEndian(), &out, &block); err != nil || !bytes.Equal(out.Bytes(), src) {
+		t.Errorf("LittleEndian block round trip = %x, %v, want %x", out.Bytes(), err, src)
+	}
+
+	codec := base58.NewEncodingWithOptions(base58.FlickrAlphabet, base58.WithLittleEndian())
+	testEqual(t, "WithLittleEndian EncodeToString = %q, want %q",
+		base58.FlickrEncoding.EncodeToString(reversed(src)), codec.EncodeToString(src))
+
+	le := base58.GMPEncoding.LittleEndian()
+	if !le.IsLittleEndian() || base58.GMPEncoding.IsLittleEndian() {
+		t.Error("LittleEndian modified the original encoding")
+	}
+	if le.Equal(base58.GMPEncoding) {
+		t.Error("Equal: little-endian encoding equals big-endian one")
+	}
+	text, err := le.MarshalText()
+	testEqual(t, "MarshalText = %q, want %q", "gmp littleendian", string(text))
+	var fromText, fromBinary base58.Encoding
+	if err := fromText.UnmarshalText(text); err != nil || !fromText.Equal(le) {
+		t.Errorf("UnmarshalText(%q): %v", text, err)
+	}
+	bin, err := le.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+	if err := fromBinary.UnmarshalBinary(bin); err != nil || !fromBinary.Equal(le) {
+		t.Errorf("UnmarshalBinary: %v", err)
+	}
+}
+
+func TestLittleEndianNumeric(t *testing.T) {
+	le := base58.StdEncoding.LittleEndian()
+	for _, u := range []uint64{0, 1, 57, 58, 256, 1 << 40, ^uint64(0)} {
+		want := le.EncodeUint64(u)
+		x := new(big.Int).SetUint64(u)
+		if got := le.EncodeBigInt(x); got != want {
+			t.Errorf("little-endian EncodeBigInt(%d) = %q, want EncodeUint64 %q", u, got, want)
+		}
+		if got, err := le.DecodeBigInt(want); err != nil || got.Cmp(x) != 0 {
+			t.Errorf("little-endian DecodeBigInt(%q) = %v, %v, want %d", want, got, err, u)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package base58
+
+/*
+Text Extraction
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+ExtractBase58 walks arbitrary text (logs, documents, memory dumps) and
+returns the maximal runs of alphabet characters within a length range, with
+their byte offsets, optionally keeping only runs whose checksum validates.
+Runs longer than the maximum are skipped, not truncated.
+*/
+
+// default minimum candidate length, the shortest common address
+const DefaultExtractMinLen = 25
+
+// ExtractBase58 settings, the zero value scans for StdEncoding runs of at
+// least DefaultExtractMinLen characters
+type ExtractOptions struct {
+	Encoding *Encoding      // alphabet to match, StdEncoding if nil
+	MinLen   int            // minimum run length, DefaultExtractMinLen if 0
+	MaxLen   int            // maximum run length, unlimited if 0
+	Check    *CheckEncoding // if set, keep only runs that validate
+}
+
+// candidate found by ExtractBase58
+type Match struct {
+	Offset  int    // byte offset of Text in the input
+	Text    string // the base58 run
+	Payload []byte // decoded payload when ExtractOptions.Check is set
+}
+
+// return the base58 candidates in text, in order of appearance
+func ExtractBase58(text []byte, opts *ExtractOptions) []Match {
+	var o ExtractOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.Encoding == nil {
+		o.Encoding = StdEncoding
+		if o.Check != nil {
+			o.Encoding = o.Check.enc
+		}
+	}
+	if o.MinLen <= 0 {
+		o.MinLen = DefaultExtractMinLen
+	}
+
+	var matches []Match
+	for i := 0; i < len(text); {
+		if o.Encoding.reverse[text[i]] == -1 {
+			i++
+			continue
+		}
+		start := i
+		for i < len(text) && o.Encoding.reverse[text[i]] != -1 {
+			i++
+		}
+		n := i - start
+		if n < o.MinLen || (o.MaxLen > 0 && n > o.MaxLen) {
+			continue
+		}
+		m := Match{Offset: start, Text: string(text[start:i])}
+		if o.Check != nil {
+			payload, err := o.Check.DecodeString(m.Text)
+			if err != nil {
+				continue
+			}
+			m.Payload = payload
+		}
+		matches = append(matches, m)
+	}
+	return matches
+}
@@ -0,0 +1,36 @@
+package base58_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestExtractBase58(t *testing.T) {
+	text := []byte("2025-06-30 sent 0.5 BTC to 1BoatSLRHtKNngkdXEeobR76b53LETtpyT, " +
+		"change 1BoatSLRHtKNngkdXEeobR76b53LETtpyU (typo); id=" + bigtest.encoded + "\n")
+
+	all := base58.ExtractBase58(text, nil)
+	testEqual(t, "ExtractBase58 count: got %d, want %d", 3, len(all))
+	for _, m := range all {
+		msg := fmt.Sprintf("ExtractBase58 offset %d: got %%q, want %%q", m.Offset)
+		testEqual(t, msg, m.Text, string(text[m.Offset:m.Offset+len(m.Text)]))
+	}
+
+	checked := base58.ExtractBase58(text, &base58.ExtractOptions{Check: base58.StdCheckEncoding})
+	if len(checked) != 1 {
+		t.Fatalf("ExtractBase58 with check: got %d matches, want 1", len(checked))
+	}
+	testEqual(t, "ExtractBase58 with check: got %q, want %q", "1BoatSLRHtKNngkdXEeobR76b53LETtpyT", checked[0].Text)
+	testEqual(t, "ExtractBase58 with check offset: got %d, want %d", 27, checked[0].Offset)
+	testEqual(t, "ExtractBase58 with check payload: got %d, want %d", 21, len(checked[0].Payload))
+
+	bounded := base58.ExtractBase58(text, &base58.ExtractOptions{MinLen: 3, MaxLen: 40})
+	var got []string
+	for _, m := range bounded {
+		got = append(got, m.Text)
+	}
+	testEqual(t, "ExtractBase58 bounded: got %v, want %v",
+		"[sent BTC 1BoatSLRHtKNngkdXEeobR76b53LETtpyT change 1BoatSLRHtKNngkdXEeobR76b53LETtpyU typo]", fmt.Sprint(got))
+}
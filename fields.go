@@ -0,0 +1,410 @@
+package base58
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+/*
+Struct Tag Marshaling
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Bytes and CheckBytes need a wrapper type per encoding. Marshal, Unmarshal,
+MarshalJSON and UnmarshalJSON instead read the encoding of plain []byte and
+[N]byte fields from a base58 struct tag, so API models keep their natural
+field types:
+
+	type Address struct {
+		Hash   [20]byte `json:"hash" base58:"check,version=0x00"`
+		Ledger []byte   `json:"ledger,omitempty" base58:"ripple"`
+		Note   string   `json:"note"`
+	}
+
+	m, err := base58.Marshal(addr)      // map[string]string{"hash": "1...", "ledger": "r..."}
+	data, err := base58.MarshalJSON(addr) // other fields as encoding/json writes them
+
+The tag is a comma-separated list: an optional encoding name known to
+LookupCodec ("bitcoin" if omitted), "check" for a Base58Check double-SHA256
+checksum, and "version=V" for a version prefix of one decimal byte or any
+number of 0x-prefixed hex bytes, which Unmarshal requires and strips. Keys
+are the json tag names, or the field names without one. Only exported
+fields directly in the struct are considered.
+*/
+
+var (
+	// base58 struct tag is malformed or on a field that is not []byte or [N]byte
+	ErrInvalidTag = errors.New("base58: invalid struct tag")
+	// value passed to Marshal or Unmarshal is not a struct or pointer to struct
+	ErrInvalidTarget = errors.New("base58: target must be a struct or a pointer to one")
+)
+
+// struct field that failed to marshal or unmarshal
+type FieldError struct {
+	Field string // Go field name
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return "base58: field " + e.Field + ": " + e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// conversion of one tagged field
+type fieldCodec struct {
+	enc     *Encoding
+	ce      *CheckEncoding // nil unless the tag has "check"
+	version []byte
+}
+
+// parse the value of a base58 struct tag
+func parseFieldTag(tag string) (*fieldCodec, error) {
+	c := &fieldCodec{enc: StdEncoding}
+	check := false
+	for i, opt := range strings.Split(tag, ",") {
+		switch {
+		case opt == "check":
+			check = true
+		case strings.HasPrefix(opt, "version="):
+			v, err := parseVersion(opt[len("version="):])
+			if err != nil {
+				return nil, err
+			}
+			c.version = v
+		case i == 0 && opt == "":
+		case i == 0:
+			codec, _ := LookupCodec(opt)
+			enc, ok := codec.(*Encoding)
+			if !ok {
+				return nil, fmt.Errorf("%w: unknown encoding %q", ErrInvalidTag, opt)
+			}
+			c.enc = enc
+		default:
+			return nil, fmt.Errorf("%w: unknown option %q", ErrInvalidTag, opt)
+		}
+	}
+	if check {
+		c.ce = NewCheckEncoding(c.enc, NewDoubleSHA256, 4)
+	}
+	return c, nil
+}
+
+// parse a version of one decimal byte or 0x-prefixed hex bytes
+func parseVersion(s string) ([]byte, error) {
+	if h, ok := strings.CutPrefix(s, "0x"); ok {
+		if len(h)%2 == 1 {
+			h = "0" + h
+		}
+		v, err := hex.DecodeString(h)
+		if err != nil || len(v) == 0 {
+			return nil, fmt.Errorf("%w: bad version %q", ErrInvalidTag, s)
+		}
+		return v, nil
+	}
+	n, err := strconv.ParseUint(s, 10, 8)
+	if err != nil {
+		return nil, fmt.Errorf("%w: bad version %q", ErrInvalidTag, s)
+	}
+	return []byte{byte(n)}, nil
+}
+
+// encode b with its version prefix
+func (c *fieldCodec) encode(b []byte) string {
+	if len(c.version) > 0 {
+		b = append(append([]byte(nil), c.version...), b...)
+	}
+	if c.ce != nil {
+		return c.ce.EncodeToString(b)
+	}
+	return c.enc.EncodeToString(b)
+}
+
+// decode s and strip its version prefix
+func (c *fieldCodec) decode(s string) ([]byte, error) {
+	var data []byte
+	var err error
+	if c.ce != nil {
+		data, err = c.ce.DecodeString(s)
+	} else {
+		data, err = c.enc.DecodeString(s)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.HasPrefix(data, c.version) {
+		return nil, fmt.Errorf("%w: got %x, want %x", ErrInvalidVersion, data[:min(len(data), len(c.version))], c.version)
+	}
+	return data[len(c.version):], nil
+}
+
+// exported field of a struct type
+type structField struct {
+	index     int
+	name      string      // Go field name
+	key       string      // map key: json name or Go field name
+	codec     *fieldCodec // nil for fields without a base58 tag
+	omitEmpty bool
+}
+
+// fields and JSON shadow type of a struct type
+type structInfo struct {
+	fields []structField
+	err    error
+
+	shadowOnce sync.Once
+	shadow     reflect.Type // fields with base58 tags as json.RawMessage
+	shadowErr  error
+}
+
+var structInfoCache sync.Map // reflect.Type -> *structInfo
+
+// return the field information of struct type t
+func structInfoOf(t reflect.Type) *structInfo {
+	if si, ok := structInfoCache.Load(t); ok {
+		return si.(*structInfo)
+	}
+	si := &structInfo{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		sf := structField{index: i, name: f.Name, key: f.Name}
+		name, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			sf.key = name
+		}
+		sf.omitEmpty = strings.Contains(","+opts+",", ",omitempty,")
+		if tag, ok := f.Tag.Lookup("base58"); ok && tag != "-" {
+			k := f.Type.Kind()
+			if (k != reflect.Slice && k != reflect.Array) || f.Type.Elem().Kind() != reflect.Uint8 {
+				si.err = &FieldError{Field: f.Name, Err: fmt.Errorf("%w: %s is not []byte or [N]byte", ErrInvalidTag, f.Type)}
+				break
+			}
+			codec, err := parseFieldTag(tag)
+			if err != nil {
+				si.err = &FieldError{Field: f.Name, Err: err}
+				break
+			}
+			sf.codec = codec
+		}
+		si.fields = append(si.fields, sf)
+	}
+	actual, _ := structInfoCache.LoadOrStore(t, si)
+	return actual.(*structInfo)
+}
+
+// return the struct value v or *v points to
+func structValue(v any, settable bool) (reflect.Value, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	} else if settable {
+		return reflect.Value{}, fmt.Errorf("%w, got %T", ErrInvalidTarget, v)
+	}
+	if rv.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("%w, got %T", ErrInvalidTarget, v)
+	}
+	return rv, nil
+}
+
+// return the bytes of a []byte or [N]byte field
+func fieldBytes(fv reflect.Value) []byte {
+	if fv.Kind() == reflect.Slice {
+		return fv.Bytes()
+	}
+	b := make([]byte, fv.Len())
+	reflect.Copy(reflect.ValueOf(b), fv)
+	return b
+}
+
+// store b in a []byte or [N]byte field, which must fit exactly
+func setFieldBytes(fv reflect.Value, b []byte) error {
+	if fv.Kind() == reflect.Slice {
+		fv.SetBytes(b)
+		return nil
+	}
+	if len(b) != fv.Len() {
+		return fmt.Errorf("%w: got %d bytes, want %d", ErrInvalidLength, len(b), fv.Len())
+	}
+	reflect.Copy(fv, reflect.ValueOf(b))
+	return nil
+}
+
+// encode the base58-tagged fields of the struct v, or the struct v points
+// to, into a map keyed by json name or field name. Nil slices are left
+// out.
+func Marshal(v any) (map[string]string, error) {
+	rv, err := structValue(v, false)
+	if err != nil {
+		return nil, err
+	}
+	si := structInfoOf(rv.Type())
+	if si.err != nil {
+		return nil, si.err
+	}
+	m := make(map[string]string)
+	for _, f := range si.fields {
+		fv := rv.Field(f.index)
+		if f.codec == nil || fv.Kind() == reflect.Slice && fv.IsNil() {
+			continue
+		}
+		m[f.key] = f.codec.encode(fieldBytes(fv))
+	}
+	return m, nil
+}
+
+// decode the values of m into the base58-tagged fields of the struct v
+// points to. Fields whose key is missing are left unchanged, [N]byte
+// fields require exactly N bytes. Fails with the *FieldError of the first
+// field that does not decode.
+func Unmarshal(m map[string]string, v any) error {
+	rv, err := structValue(v, true)
+	if err != nil {
+		return err
+	}
+	si := structInfoOf(rv.Type())
+	if si.err != nil {
+		return si.err
+	}
+	for _, f := range si.fields {
+		s, ok := m[f.key]
+		if f.codec == nil || !ok {
+			continue
+		}
+		if err := f.setString(rv.Field(f.index), s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decode s into field value fv
+func (f *structField) setString(fv reflect.Value, s string) error {
+	b, err := f.codec.decode(s)
+	if err == nil {
+		err = setFieldBytes(fv, b)
+	}
+	if err != nil {
+		return &FieldError{Field: f.name, Err: err}
+	}
+	return nil
+}
+
+// return the type encoding/json sees in place of the struct: the exported
+// fields with their tags, base58-tagged ones as json.RawMessage
+func (si *structInfo) shadowType(t reflect.Type) (reflect.Type, error) {
+	si.shadowOnce.Do(func() {
+		defer func() {
+			// reflect.StructOf rejects some embedded fields
+			if r := recover(); r != nil {
+				si.shadowErr = fmt.Errorf("%w: %v", ErrInvalidTarget, r)
+			}
+		}()
+		fields := make([]reflect.StructField, len(si.fields))
+		for i, f := range si.fields {
+			fields[i] = t.Field(f.index)
+			fields[i].Index = nil
+			fields[i].Offset = 0
+			if f.codec != nil {
+				fields[i].Type = reflect.TypeFor[json.RawMessage]()
+				fields[i].Anonymous = false
+			}
+		}
+		si.shadow = reflect.StructOf(fields)
+	})
+	return si.shadow, si.shadowErr
+}
+
+// marshal the struct v, or the struct v points to, as JSON with its
+// base58-tagged fields as base58 strings and all other fields as
+// encoding/json writes them. Nil slices are written as null.
+func MarshalJSON(v any) ([]byte, error) {
+	rv, err := structValue(v, false)
+	if err != nil {
+		return nil, err
+	}
+	si := structInfoOf(rv.Type())
+	if si.err != nil {
+		return nil, si.err
+	}
+	st, err := si.shadowType(rv.Type())
+	if err != nil {
+		return nil, err
+	}
+	shadow := reflect.New(st).Elem()
+	for i, f := range si.fields {
+		fv := rv.Field(f.index)
+		if f.codec == nil {
+			shadow.Field(i).Set(fv)
+			continue
+		}
+		if fv.Kind() == reflect.Slice && (fv.IsNil() || f.omitEmpty && fv.Len() == 0) {
+			continue
+		}
+		s, _ := json.Marshal(f.codec.encode(fieldBytes(fv)))
+		shadow.Field(i).SetBytes(s)
+	}
+	return json.Marshal(shadow.Interface())
+}
+
+// unmarshal JSON written by MarshalJSON into the struct v points to,
+// decoding its base58-tagged fields. A null sets a []byte field to nil.
+// Fields missing from data are left unchanged.
+func UnmarshalJSON(data []byte, v any) error {
+	rv, err := structValue(v, true)
+	if err != nil {
+		return err
+	}
+	si := structInfoOf(rv.Type())
+	if si.err != nil {
+		return si.err
+	}
+	st, err := si.shadowType(rv.Type())
+	if err != nil {
+		return err
+	}
+	shadow := reflect.New(st).Elem()
+	for i, f := range si.fields {
+		if f.codec == nil {
+			shadow.Field(i).Set(rv.Field(f.index))
+		}
+	}
+	if err := json.Unmarshal(data, shadow.Addr().Interface()); err != nil {
+		return err
+	}
+	for i, f := range si.fields {
+		fv := rv.Field(f.index)
+		if f.codec == nil {
+			fv.Set(shadow.Field(i))
+			continue
+		}
+		raw := shadow.Field(i).Bytes()
+		switch {
+		case len(raw) == 0:
+			// missing from data
+		case string(raw) == "null":
+			fv.SetZero()
+		default:
+			var s string
+			if err := json.Unmarshal(raw, &s); err != nil {
+				return &FieldError{Field: f.name, Err: err}
+			}
+			if err := f.setString(fv, s); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package base58_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+type taggedAddress struct {
+	Hash    [20]byte `json:"hash" base58:"check,version=0x00"`
+	Ledger  []byte   `json:"ledger,omitempty" base58:"ripple"`
+	Key     []byte   `base58:""`
+	Note    string   `json:"note"`
+	Raw     []byte   `json:"raw"`
+	Skipped []byte   `json:"-" base58:"-"`
+	secret  []byte   `base58:"flickr"`
+}
+
+func TestMarshalFields(t *testing.T) {
+	a := taggedAddress{Ledger: []byte{0, 1, 2}, Note: "n", Raw: []byte{9}, Skipped: []byte{1}, secret: []byte{1}}
+	a.Hash[19] = 1
+	m, err := base58.Marshal(a)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	testEqual(t, "Marshal keys = %v, want %v", 2, len(m))
+	testEqual(t, "Marshal[hash] = %q, want %q", base58.CheckEncodeVersion([]byte{0}, a.Hash[:]), m["hash"])
+	testEqual(t, "Marshal[ledger] = %q, want %q", base58.RippleEncoding.EncodeToString(a.Ledger), m["ledger"])
+
+	var b taggedAddress
+	b.Key = []byte{7}
+	if err := base58.Unmarshal(m, &b); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if b.Hash != a.Hash || !bytes.Equal(b.Ledger, a.Ledger) || !bytes.Equal(b.Key, []byte{7}) {
+		t.Errorf("Unmarshal: got %+v, want hash and ledger of %+v and Key unchanged", b, a)
+	}
+
+	m["Key"] = "2g"
+	if err := base58.Unmarshal(m, &b); err != nil || !bytes.Equal(b.Key, []byte{0x61}) {
+		t.Errorf("Unmarshal(Key): got %x, %v", b.Key, err)
+	}
+}
+
+func TestUnmarshalFieldErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		m    map[string]string
+		want error
+	}{
+		{"version", map[string]string{"hash": base58.CheckEncodeVersion([]byte{5}, make([]byte, 20))}, base58.ErrInvalidVersion},
+		{"length", map[string]string{"hash": base58.CheckEncodeVersion([]byte{0}, make([]byte, 19))}, base58.ErrInvalidLength},
+		{"checksum", map[string]string{"hash": base58.StdEncoding.EncodeToString(make([]byte, 25))}, base58.ErrChecksumMismatch},
+		{"alphabet", map[string]string{"ledger": "0"}, nil},
+	}
+	for _, tt := range tests {
+		var a taggedAddress
+		err := base58.Unmarshal(tt.m, &a)
+		var fe *base58.FieldError
+		if !errors.As(err, &fe) || tt.want != nil && !errors.Is(err, tt.want) {
+			t.Errorf("%s: Unmarshal: got error %v, want %v", tt.name, err, tt.want)
+		}
+	}
+
+	if err := base58.Unmarshal(nil, taggedAddress{}); !errors.Is(err, base58.ErrInvalidTarget) {
+		t.Errorf("Unmarshal(non-pointer): got error %v, want %v", err, base58.ErrInvalidTarget)
+	}
+	if _, err := base58.Marshal(42); !errors.Is(err, base58.ErrInvalidTarget) {
+		t.Errorf("Marshal(int): got error %v, want %v", err, base58.ErrInvalidTarget)
+	}
+}
+
+func TestFieldTags(t *testing.T) {
+	tests := []struct {
+		name string
+		v    any
+		want error
+	}{
+		{"gmp", &struct {
+			F []byte `base58:"gmp,version=7"`
+		}{}, nil},
+		{"multi-byte version", &struct {
+			F []byte `base58:"check,version=0x0488b21e"`
+		}{}, nil},
+		{"unknown encoding", &struct {
+			F []byte `base58:"base64"`
+		}{}, base58.ErrInvalidTag},
+		{"unknown option", &struct {
+			F []byte `base58:"bitcoin,compact"`
+		}{}, base58.ErrInvalidTag},
+		{"bad version", &struct {
+			F []byte `base58:"version=256"`
+		}{}, base58.ErrInvalidTag},
+		{"wrong type", &struct {
+			F string `base58:"check"`
+		}{}, base58.ErrInvalidTag},
+	}
+	for _, tt := range tests {
+		m, err := base58.Marshal(tt.v)
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%s: Marshal: got error %v, want %v", tt.name, err, tt.want)
+		}
+		if tt.want != nil {
+			continue
+		}
+		m["F"] = ""
+		// the version is required on the way back
+		if err := base58.Unmarshal(m, tt.v); !errors.Is(err, base58.ErrInvalidVersion) && !errors.Is(err, base58.ErrInvalidFormat) {
+			t.Errorf("%s: Unmarshal(empty): got error %v", tt.name, err)
+		}
+	}
+
+	v := struct {
+		F []byte `base58:"gmp,version=0x0102"`
+	}{F: []byte{3}}
+	m, _ := base58.Marshal(v)
+	testEqual(t, "Marshal(version=0x0102) = %q, want %q", base58.GMPEncoding.EncodeToString([]byte{1, 2, 3}), m["F"])
+}
+
+func TestFieldsJSON(t *testing.T) {
+	a := taggedAddress{Note: "n", Raw: []byte{9}, Key: []byte{0, 0xff}}
+	a.Hash[0] = 0xab
+	data, err := base58.MarshalJSON(&a)
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+	want := `{"hash":"` + base58.CheckEncodeVersion([]byte{0}, a.Hash[:]) + `","Key":"15Q","note":"n","raw":"CQ=="}`
+	testEqual(t, "MarshalJSON = %s, want %s", want, string(data))
+
+	b := taggedAddress{Ledger: []byte{1}, Note: "old"}
+	if err := base58.UnmarshalJSON(data, &b); err != nil {
+		t.Fatalf("UnmarshalJSON: %v", err)
+	}
+	if b.Hash != a.Hash || !bytes.Equal(b.Key, a.Key) || b.Note != "n" || !bytes.Equal(b.Raw, a.Raw) || !bytes.Equal(b.Ledger, []byte{1}) {
+		t.Errorf("UnmarshalJSON: got %+v, want %+v with Ledger unchanged", b, a)
+	}
+	if err := base58.UnmarshalJSON([]byte(`{"Key":null}`), &b); err != nil || b.Key != nil {
+		t.Errorf("UnmarshalJSON(null): got %x, %v", b.Key, err)
+	}
+
+	var fe *base58.FieldError
+	if err := base58.UnmarshalJSON([]byte(`{"hash":"2g"}`), &b); !errors.As(err, &fe) || fe.Field != "Hash" {
+		t.Errorf("UnmarshalJSON(bad hash): got error %v", err)
+	}
+	var se *json.SyntaxError
+	if err := base58.UnmarshalJSON([]byte(`{`), &b); !errors.As(err, &se) {
+		t.Errorf("UnmarshalJSON(syntax): got error %v", err)
+	}
+}
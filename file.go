@@ -0,0 +1,99 @@
+package base58
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+/*
+File Helpers
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+EncodeFile and DecodeFile convert between a binary file and its block
+format text, e.g. for armoring backup snapshots. Files up to
+smallFileSize are read in one call, larger ones are streamed in chunks, so
+memory use stays bounded either way; the output is the same. Files are
+read rather than memory-mapped: the block conversion gains nothing from a
+mapping, which faults if the file is truncated while it is converted.
+Output is written to a temporary file in the destination directory and
+renamed into place only once complete, so a failed run never leaves a
+truncated file.
+*/
+
+// files up to this size are read whole instead of streamed
+const smallFileSize = 1 << 20
+
+// encode the file at srcPath to dstPath in the block format
+func EncodeFile(enc *Encoding, dstPath, srcPath string) error {
+	return convertFile(enc, dstPath, srcPath, func(dst io.Writer, src io.Reader) error {
+		_, err := encodeStream(enc, dst, src)
+		return err
+	})
+}
+
+// decode the block format file at srcPath to dstPath
+func DecodeFile(enc *Encoding, dstPath, srcPath string) error {
+	return convertFile(enc, dstPath, srcPath, func(dst io.Writer, src io.Reader) error {
+		_, err := decodeStream(enc, dst, src)
+		return err
+	})
+}
+
+// run convert from srcPath into a temporary file renamed to dstPath on
+// success, keeping the permissions of the source and reporting progress
+// to the callback of enc
+func convertFile(enc *Encoding, dstPath, srcPath string, convert func(io.Writer, io.Reader) error) (err error) {
+	src, err := os.Open(srcPath)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	info, err := src.Stat()
+	if err != nil {
+		return err
+	}
+	var r io.Reader = bufio.NewReaderSize(src, 64*1024)
+	if info.Size() <= smallFileSize {
+		data, err := io.ReadAll(src)
+		if err != nil {
+			return err
+		}
+		r = bytes.NewReader(data)
+	}
+	if enc.progress != nil {
+		r = &progressReader{r: r, fn: enc.progress, total: info.Size()}
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(dstPath), "."+filepath.Base(dstPath)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}
+	}()
+	w := bufio.NewWriterSize(tmp, 64*1024)
+	if err = convert(w, r); err != nil {
+		return err
+	}
+	if err = w.Flush(); err != nil {
+		return err
+	}
+	if err = tmp.Chmod(info.Mode().Perm()); err != nil {
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), dstPath)
+}
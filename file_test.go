@@ -0,0 +1,67 @@
+package base58_test
+
+import (
+	"bytes"
+	"errors"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestEncodeDecodeFile(t *testing.T) {
+	dir := t.TempDir()
+	for _, n := range []int{0, 5000, 1<<20 + 1} {
+		data := make([]byte, n)
+		// random content for small files, sparse content keeps the large
+		// streamed case fast
+		if n < 1<<20 {
+			rand.New(rand.NewSource(int64(n))).Read(data)
+		} else {
+			data[n-1] = 1
+		}
+		src := filepath.Join(dir, "snapshot.bin")
+		if err := os.WriteFile(src, data, 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		armored := filepath.Join(dir, "snapshot.b58")
+		if err := base58.EncodeFile(base58.StdEncoding, armored, src); err != nil {
+			t.Fatalf("EncodeFile(%d bytes) failed: %v", n, err)
+		}
+		restored := filepath.Join(dir, "restored.bin")
+		if err := base58.DecodeFile(base58.StdEncoding, restored, armored); err != nil {
+			t.Fatalf("DecodeFile(%d bytes) failed: %v", n, err)
+		}
+		got, _ := os.ReadFile(restored)
+		if !bytes.Equal(got, data) {
+			t.Errorf("EncodeFile/DecodeFile round trip of %d bytes failed", n)
+		}
+		if info, _ := os.Stat(restored); info.Mode().Perm() != 0o600 {
+			t.Errorf("DecodeFile: got mode %v, want %v", info.Mode().Perm(), os.FileMode(0o600))
+		}
+	}
+
+	// a failed decode leaves the destination untouched and no temp files
+	bad := filepath.Join(dir, "bad.b58")
+	os.WriteFile(bad, []byte(bigtest.encoded+"\n"), 0o600)
+	dst := filepath.Join(dir, "existing.bin")
+	os.WriteFile(dst, []byte("keep"), 0o600)
+	if err := base58.DecodeFile(base58.StdEncoding, dst, bad); !errors.Is(err, base58.ErrChecksumMismatch) {
+		t.Errorf("DecodeFile(corrupt): got %v, want %v", err, base58.ErrBlockChecksum)
+	}
+	if got, _ := os.ReadFile(dst); string(got) != "keep" {
+		t.Errorf("DecodeFile(corrupt) overwrote destination with %q", got)
+	}
+	entries, _ := os.ReadDir(dir)
+	for _, e := range entries {
+		if filepath.Ext(e.Name()) != ".bin" && filepath.Ext(e.Name()) != ".b58" {
+			t.Errorf("leftover temporary file %q", e.Name())
+		}
+	}
+	if err := base58.EncodeFile(base58.StdEncoding, dst, filepath.Join(dir, "missing")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("EncodeFile(missing): got %v, want %v", err, os.ErrNotExist)
+	}
+}
@@ -0,0 +1,78 @@
+package base58
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+/*
+Fixed-Size Decoding
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Decode straight into byte arrays such as [32]byte public keys or [64]byte
+signatures, failing loudly when the decoded payload has the wrong size
+instead of silently truncating or zero-extending it.
+*/
+
+// decoded payload does not have the required length
+var ErrInvalidLength = errors.New("base58: invalid decoded length")
+
+// decode s with enc into a byte array type A, e.g. DecodeFixed[[32]byte],
+// returning ErrInvalidLength unless s decodes to exactly len(A) bytes
+func DecodeFixed[A any](enc *Encoding, s string) (A, error) {
+	var out A
+	v := reflect.ValueOf(&out).Elem()
+	if v.Kind() != reflect.Array || v.Type().Elem().Kind() != reflect.Uint8 {
+		panic("base58: DecodeFixed requires a byte array type, got " + v.Type().String())
+	}
+	decoded, err := enc.DecodeString(s)
+	if err != nil {
+		return out, err
+	}
+	if len(decoded) != v.Len() {
+		return out, fmt.Errorf("%w: got %d bytes, want %d", ErrInvalidLength, len(decoded), v.Len())
+	}
+	reflect.Copy(v, reflect.ValueOf(decoded))
+	return out, nil
+}
+
+// encode src as a number and left-pad the result with the zero digit to
+// width characters
+func (enc *Encoding) encodeWidth(src []byte, width int) []byte {
+	encoded := enc.encodeDigits(trimLeadingZeros(src))
+	if len(encoded) >= width {
+		return encoded
+	}
+	out := enc.get(width)
+	pad := width - len(encoded)
+	zero := enc.zeroDigit()
+	for i := 0; i < pad; i++ {
+		out[i] = zero
+	}
+	copy(out[pad:], encoded)
+	enc.release(encoded)
+	return out
+}
+
+// decode src as a number and return it as exactly n big-endian bytes,
+// leading zero digits carry no length information
+func (enc *Encoding) decodeWidth(src []byte, n int) ([]byte, error) {
+	decoded, err := enc.decodeDigits(context.Background(), src)
+	if err != nil {
+		return nil, err
+	}
+	decoded = trimLeadingZeros(decoded)
+	if len(decoded) > n {
+		enc.release(decoded)
+		return nil, fmt.Errorf("%w: value does not fit in %d bytes", ErrInvalidLength, n)
+	}
+	out := enc.get(n)
+	clear(out[:n-len(decoded)])
+	copy(out[n-len(decoded):], decoded)
+	enc.release(decoded)
+	return out, nil
+}
@@ -0,0 +1,46 @@
+package base58_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestDecodeFixed(t *testing.T) {
+	key := [32]byte{}
+	for i := range key {
+		key[i] = byte(i + 1)
+	}
+	s := base58.StdEncoding.EncodeToString(key[:])
+	got, err := base58.DecodeFixed[[32]byte](base58.StdEncoding, s)
+	if err != nil {
+		t.Fatalf("DecodeFixed[[32]byte](%q) failed: %v", s, err)
+	}
+	testEqual(t, "DecodeFixed[[32]byte]: got %x, want %x", key, got)
+
+	type publicKey [32]byte
+	pk, err := base58.DecodeFixed[publicKey](base58.StdEncoding, s)
+	if err != nil || pk != publicKey(key) {
+		t.Errorf("DecodeFixed[publicKey](%q): got %x, %v", s, pk, err)
+	}
+
+	if _, err := base58.DecodeFixed[[31]byte](base58.StdEncoding, s); !errors.Is(err, base58.ErrInvalidLength) {
+		t.Errorf("DecodeFixed[[31]byte]: got error %v, want %v", err, base58.ErrInvalidLength)
+	}
+	if _, err := base58.DecodeFixed[[33]byte](base58.StdEncoding, s); !errors.Is(err, base58.ErrInvalidLength) {
+		t.Errorf("DecodeFixed[[33]byte]: got error %v, want %v", err, base58.ErrInvalidLength)
+	}
+	if _, err := base58.DecodeFixed[[32]byte](base58.StdEncoding, "0OIl"); err == nil {
+		t.Errorf("DecodeFixed[[32]byte](%q): expected invalid character error", "0OIl")
+	}
+}
+
+func TestDecodeFixedPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("DecodeFixed[[4]int] did not panic")
+		}
+	}()
+	base58.DecodeFixed[[4]int](base58.StdEncoding, "")
+}
@@ -0,0 +1,88 @@
+package base58
+
+import "fmt"
+
+/*
+Command-Line Flags
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Flag implements flag.Value, flag.Getter and pflag's Value (which adds
+Type), parsing a base58 flag argument into bytes with an optional decoded
+length and Base58Check verification:
+
+	key := base58.NewFlag(base58.StdEncoding, 32)
+	flag.Var(key, "key", "32-byte public key")
+	flag.Parse()
+	use(key.Bytes())
+*/
+
+// base58 command-line flag value, see NewFlag and NewCheckFlag
+type Flag struct {
+	enc   *Encoding
+	ce    *CheckEncoding
+	size  int
+	value []byte
+}
+
+// return a flag decoding its argument with enc, requiring size decoded
+// bytes unless size is 0
+func NewFlag(enc *Encoding, size int) *Flag {
+	return &Flag{enc: enc, size: size}
+}
+
+// return a flag verifying and decoding its argument with ce, requiring a
+// payload of size bytes unless size is 0
+func NewCheckFlag(ce *CheckEncoding, size int) *Flag {
+	return &Flag{ce: ce, size: size}
+}
+
+// decode s into the flag, implementing flag.Value
+func (f *Flag) Set(s string) error {
+	var decoded []byte
+	var err error
+	if f.ce != nil {
+		decoded, err = f.ce.DecodeString(s)
+	} else {
+		decoded, err = f.enc.DecodeString(s)
+	}
+	if err != nil {
+		return err
+	}
+	if f.size > 0 && len(decoded) != f.size {
+		return fmt.Errorf("%w: got %d bytes, want %d", ErrInvalidLength, len(decoded), f.size)
+	}
+	f.value = decoded
+	return nil
+}
+
+// return the encoded value, implementing flag.Value. The flag package
+// calls String on a zero Flag, which returns "".
+func (f *Flag) String() string {
+	switch {
+	case f == nil || f.value == nil:
+		return ""
+	case f.ce != nil:
+		return f.ce.EncodeToString(f.value)
+	}
+	return f.enc.EncodeToString(f.value)
+}
+
+// return the decoded value, implementing flag.Getter
+func (f *Flag) Get() any {
+	return f.value
+}
+
+// return the value type name shown in pflag usage
+func (f *Flag) Type() string {
+	if f.ce != nil {
+		return "base58check"
+	}
+	return "base58"
+}
+
+// return the decoded value, nil if the flag was not set
+func (f *Flag) Bytes() []byte {
+	return f.value
+}
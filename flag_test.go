@@ -0,0 +1,52 @@
+package base58_test
+
+import (
+	"errors"
+	"flag"
+	"io"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+var _ flag.Getter = (*base58.Flag)(nil)
+
+func TestFlag(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	msg := base58.NewFlag(base58.StdEncoding, 0)
+	key := base58.NewFlag(base58.StdEncoding, 5)
+	addr := base58.NewCheckFlag(base58.StdCheckEncoding, 21)
+	fs.Var(msg, "msg", "message")
+	fs.Var(key, "key", "5-byte key")
+	fs.Var(addr, "addr", "address")
+	if err := fs.Parse([]string{"-key", "E2XFRyo", "-addr", "1BoatSLRHtKNngkdXEeobR76b53LETtpyT"}); err != nil {
+		t.Fatal(err)
+	}
+	if msg.Bytes() != nil || msg.String() != "" {
+		t.Errorf("unset flag: got %q, %q", msg.Bytes(), msg.String())
+	}
+	if string(key.Bytes()) != "sure." || key.String() != "E2XFRyo" || string(key.Get().([]byte)) != "sure." {
+		t.Errorf("-key: got %q, %q", key.Bytes(), key.String())
+	}
+	if len(addr.Bytes()) != 21 || addr.String() != "1BoatSLRHtKNngkdXEeobR76b53LETtpyT" {
+		t.Errorf("-addr: got %x, %q", addr.Bytes(), addr.String())
+	}
+	testEqual(t, "Type: got %q, want %q", "base58", key.Type())
+	testEqual(t, "Type: got %q, want %q", "base58check", addr.Type())
+
+	if err := key.Set("2ukV"); !errors.Is(err, base58.ErrInvalidLength) {
+		t.Errorf("-key wrong length: got %v", err)
+	}
+	if string(key.Bytes()) != "sure." {
+		t.Errorf("-key after failed Set: got %q", key.Bytes())
+	}
+	if err := addr.Set("1BoatSLRHtKNngkdXEeobR76b53LETtpyU"); !errors.Is(err, base58.ErrChecksumMismatch) {
+		t.Errorf("-addr bad checksum: got %v", err)
+	}
+	if err := fs.Parse([]string{"-msg", "E2X0Ryo"}); err == nil {
+		t.Error("-msg invalid: got nil error")
+	}
+	// PrintDefaults calls String on zero values
+	fs.PrintDefaults()
+}
@@ -0,0 +1,98 @@
+package base58
+
+import "hash"
+
+/*
+Functional Options
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+NewEncodingWithOptions builds an encoding from an alphabet and a list of
+options in one call, for configuration assembled at run time where chaining
+the With* methods is awkward:
+
+	codec := base58.NewEncodingWithOptions(base58.BitcoinAlphabet,
+		base58.WithWrap(76),
+		base58.WithIgnoreChars(" \t"),
+		base58.WithChecksum(base58.NewDoubleSHA256, 4),
+	)
+
+The options apply the Encoding methods of the same name, so they panic on
+the same invalid values.
+*/
+
+// option for NewEncodingWithOptions
+type Option func(*encodingOptions)
+
+type encodingOptions struct {
+	steps       []func(*Encoding) *Encoding
+	checksum    func() hash.Hash
+	checksumLen int
+}
+
+// left-pad encoded output to width characters, see Encoding.WithPadWidth
+func WithPadWidth(width int) Option {
+	return func(o *encodingOptions) {
+		o.steps = append(o.steps, func(e *Encoding) *Encoding { return e.WithPadWidth(width) })
+	}
+}
+
+// wrap encoded output every lineLen characters, see Encoding.WithWrap
+func WithWrap(lineLen int) Option {
+	return func(o *encodingOptions) {
+		o.steps = append(o.steps, func(e *Encoding) *Encoding { return e.WithWrap(lineLen) })
+	}
+}
+
+// skip the characters in chars on decode, see Encoding.WithIgnore
+func WithIgnoreChars(chars string) Option {
+	return func(o *encodingOptions) {
+		o.steps = append(o.steps, func(e *Encoding) *Encoding { return e.WithIgnore(chars) })
+	}
+}
+
+// limit the decoded length to n bytes, see Encoding.WithMaxDecodedLen
+func WithMaxDecodedLen(n int) Option {
+	return func(o *encodingOptions) {
+		o.steps = append(o.steps, func(e *Encoding) *Encoding { return e.WithMaxDecodedLen(n) })
+	}
+}
+
+// treat payloads as little-endian numbers, see Encoding.LittleEndian
+func WithLittleEndian() Option {
+	return func(o *encodingOptions) {
+		o.steps = append(o.steps, (*Encoding).LittleEndian)
+	}
+}
+
+// append the first checksumLen bytes of h's digest, making the result a
+// *CheckEncoding, see NewCheckEncoding
+func WithChecksum(h func() hash.Hash, checksumLen int) Option {
+	return func(o *encodingOptions) {
+		o.checksum, o.checksumLen = h, checksumLen
+	}
+}
+
+// return an encoding of alphabet with opts applied in order. The result is
+// an *Encoding, or a *CheckEncoding if WithChecksum is given. A 58-character
+// alphabet is built with NewEncoding, any other with NewRadixEncoding.
+func NewEncodingWithOptions(alphabet string, opts ...Option) Codec {
+	var o encodingOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	var enc *Encoding
+	if len(alphabet) == 58 {
+		enc = NewEncoding(alphabet)
+	} else {
+		enc = NewRadixEncoding(alphabet)
+	}
+	for _, step := range o.steps {
+		enc = step(enc)
+	}
+	if o.checksum != nil {
+		return NewCheckEncoding(enc, o.checksum, o.checksumLen)
+	}
+	return enc
+}
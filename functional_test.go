@@ -0,0 +1,50 @@
+package base58_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestNewEncodingWithOptions(t *testing.T) {
+	codec := base58.NewEncodingWithOptions(base58.BitcoinAlphabet,
+		base58.WithWrap(8), base58.WithIgnoreChars(" "), base58.WithMaxDecodedLen(16))
+	enc, ok := codec.(*base58.Encoding)
+	if !ok {
+		t.Fatalf("NewEncodingWithOptions: got %T, want *Encoding", codec)
+	}
+	want := base58.StdEncoding.WithWrap(8).WithIgnore(" ").WithMaxDecodedLen(16)
+	if !enc.Equal(want) {
+		t.Errorf("NewEncodingWithOptions: got %v, want %v", enc, want)
+	}
+	s := enc.EncodeToString([]byte("hello world"))
+	if got, err := enc.DecodeString(" " + s); err != nil || string(got) != "hello world" {
+		t.Errorf("DecodeString: got %q, %v", got, err)
+	}
+
+	padded := base58.NewEncodingWithOptions("0123456789abcdefghijklmnopqrstuvwxyz", base58.WithPadWidth(8))
+	testEqual(t, "padded EncodeToString = %q, want %q", "0000000z", padded.EncodeToString([]byte{35}))
+}
+
+func TestNewEncodingWithChecksum(t *testing.T) {
+	codec := base58.NewEncodingWithOptions(base58.BitcoinAlphabet,
+		base58.WithChecksum(base58.NewDoubleSHA256, 4), base58.WithWrap(10))
+	ce, ok := codec.(*base58.CheckEncoding)
+	if !ok {
+		t.Fatalf("NewEncodingWithOptions: got %T, want *CheckEncoding", codec)
+	}
+	testEqual(t, "ChecksumLen() = %v, want %v", 4, ce.ChecksumLen())
+	// the Base58Check string of the payload, wrapped
+	raw, _ := base58.StdEncoding.DecodeString(base58.StdCheckEncoding.EncodeToString([]byte("payload")))
+	s := ce.EncodeToString([]byte("payload"))
+	testEqual(t, "EncodeToString = %q, want %q", base58.StdEncoding.WithWrap(10).EncodeToString(raw), s)
+	if got, err := ce.DecodeString(s); err != nil || string(got) != "payload" {
+		t.Errorf("DecodeString: got %q, %v", got, err)
+	}
+	damaged := []byte(s)
+	damaged[0] ^= 1
+	if _, err := ce.DecodeString(string(damaged)); !errors.Is(err, base58.ErrChecksumMismatch) {
+		t.Errorf("DecodeString of damaged input: got %v, want ErrChecksumMismatch", err)
+	}
+}
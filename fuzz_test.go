@@ -0,0 +1,75 @@
+package base58_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+	"github.com/cyclone-github/base58/reference"
+)
+
+// seed inputs shared by the fuzz targets
+var fuzzSeeds = []string{"", "\x00", "\x00\x00\x01", "hello world", "\xff\xff\xff\xff", "abcdefghijklmnopqrstuvwxyz"}
+
+func FuzzEncodeDecode(f *testing.F) {
+	for _, s := range fuzzSeeds {
+		f.Add([]byte(s))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for _, enc := range []*base58.Encoding{base58.StdEncoding, base58.FlickrEncoding, base58.RippleEncoding} {
+			if err := reference.CheckBytes(enc, data); err != nil {
+				t.Fatal(err)
+			}
+		}
+		buf := make([]byte, base58.StdEncoding.EncodedLen(len(data)))
+		copy(buf, data)
+		n, err := base58.StdEncoding.EncodeInPlace(buf, len(data))
+		if want := base58.StdEncoding.EncodeToString(data); err != nil || string(buf[:n]) != want {
+			t.Fatalf("EncodeInPlace(%x): got %q, %v, want %q", data, buf[:n], err, want)
+		}
+		if n, err = base58.StdEncoding.DecodeInPlace(buf[:n]); err != nil || !bytes.Equal(buf[:n], data) {
+			t.Fatalf("DecodeInPlace round trip of %x: got %x, %v", data, buf[:n], err)
+		}
+		ct, err := base58.StdEncoding.ConstantTimeDecodeString(base58.StdEncoding.ConstantTimeEncodeToString(data))
+		if err != nil || !bytes.Equal(ct, data) {
+			t.Fatalf("constant-time round trip of %x: got %x, %v", data, ct, err)
+		}
+	})
+}
+
+func FuzzDecode(f *testing.F) {
+	for _, s := range fuzzSeeds {
+		f.Add(base58.StdEncoding.EncodeToString([]byte(s)))
+	}
+	f.Add("0OIl")
+	f.Add("1111z")
+	f.Fuzz(func(t *testing.T, s string) {
+		if err := reference.CheckString(base58.StdEncoding, s); err != nil {
+			t.Fatal(err)
+		}
+		// every decode path agrees on validity and result
+		want, err := base58.StdEncoding.DecodeString(s)
+		valid := err == nil
+		if err := base58.StdEncoding.Validate(s); (err == nil) != valid {
+			t.Fatalf("Validate(%q): %v, DecodeString valid %v", s, err, valid)
+		}
+		dst := make([]byte, len(s))
+		n, err := base58.StdEncoding.DecodeInto(dst, []byte(s))
+		if (err == nil) != valid || valid && !bytes.Equal(dst[:n], want) {
+			t.Fatalf("DecodeInto(%q): got %x, %v, want %x", s, dst[:n], err, want)
+		}
+		inPlace := []byte(s)
+		n, err = base58.StdEncoding.DecodeInPlace(inPlace)
+		if (err == nil) != valid || valid && !bytes.Equal(inPlace[:n], want) {
+			t.Fatalf("DecodeInPlace(%q): got %x, %v, want %x", s, inPlace[:n], err, want)
+		}
+		ct, err := base58.StdEncoding.ConstantTimeDecodeString(s)
+		if (err == nil) != valid || valid && !bytes.Equal(ct, want) {
+			t.Fatalf("ConstantTimeDecodeString(%q): got %x, %v, want %x", s, ct, err, want)
+		}
+		prefix, consumed, err := base58.StdEncoding.DecodePrefix([]byte(s))
+		if (err == nil) != valid || valid && (consumed != len(s) || !bytes.Equal(prefix, want)) {
+			t.Fatalf("DecodePrefix(%q): got %x, %d, %v, want %x", s, prefix, consumed, err, want)
+		}
+	})
+}
@@ -1,3 +1,7 @@
 module github.com/cyclone-github/base58
 
 go 1.24.4
+
+require golang.org/x/crypto v0.48.0
+
+require golang.org/x/sys v0.41.0 // indirect
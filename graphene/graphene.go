@@ -0,0 +1,125 @@
+package graphene
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cyclone-github/base58"
+	"golang.org/x/crypto/ripemd160"
+)
+
+/*
+EOS/Graphene Key Format
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Graphene based chains (EOS, Steem, BitShares, ...) encode keys as a text
+prefix followed by base58(payload || checksum), where the checksum is the
+first 4 bytes of RIPEMD160 over the payload. Typed EOS keys and signatures
+("PUB_K1_", "PVT_R1_", "SIG_K1_", ...) append the key type suffix ("K1",
+"R1") to the payload before hashing, so the checksum covers the suffix too.
+*/
+
+const checksumLen = 4
+
+// length of a compressed public key in bytes
+const PublicKeyLen = 33
+
+// legacy chain prefixes
+const (
+	EOS       = "EOS"
+	Steem     = "STM"
+	BitShares = "BTS"
+)
+
+// typed EOS prefixes
+const (
+	PubK1 = "PUB_K1_"
+	PubR1 = "PUB_R1_"
+	PvtK1 = "PVT_K1_"
+	PvtR1 = "PVT_R1_"
+	SigK1 = "SIG_K1_"
+	SigR1 = "SIG_R1_"
+)
+
+// prefixes recognized by Decode, typed prefixes first
+var Prefixes = []string{PubK1, PubR1, PvtK1, PvtR1, SigK1, SigR1, EOS, Steem, BitShares}
+
+var (
+	// string does not start with a known prefix
+	ErrUnknownPrefix = errors.New("graphene: unknown key prefix")
+	// public key is not 33 bytes
+	ErrInvalidLength = errors.New("graphene: invalid key length")
+	// decoded checksum does not match the payload
+	ErrChecksumMismatch = fmt.Errorf("%w: graphene", base58.ErrChecksumMismatch)
+)
+
+// return the key type suffix covered by the checksum, e.g. "K1" for
+// "PUB_K1_", or "" for legacy prefixes
+func suffix(prefix string) string {
+	parts := strings.Split(prefix, "_")
+	if len(parts) == 3 && parts[2] == "" {
+		return parts[1]
+	}
+	return ""
+}
+
+// report whether keys with prefix are public keys
+func isPublic(prefix string) bool {
+	return strings.HasPrefix(prefix, "PUB_") || suffix(prefix) == ""
+}
+
+// RIPEMD160 checksum over payload and the key type suffix
+func checksum(payload []byte, suffix string) []byte {
+	h := ripemd160.New()
+	h.Write(payload)
+	h.Write([]byte(suffix))
+	return h.Sum(nil)[:checksumLen]
+}
+
+// encode payload with a chain or typed prefix
+func Encode(prefix string, payload []byte) (string, error) {
+	if isPublic(prefix) && len(payload) != PublicKeyLen {
+		return "", fmt.Errorf("%w: %d bytes, want %d", ErrInvalidLength, len(payload), PublicKeyLen)
+	}
+	b := make([]byte, 0, len(payload)+checksumLen)
+	b = append(b, payload...)
+	b = append(b, checksum(payload, suffix(prefix))...)
+	return prefix + base58.StdEncoding.EncodeToString(b), nil
+}
+
+// decode s, which must start with prefix, and verify its checksum
+func DecodePrefix(prefix, s string) ([]byte, error) {
+	if !strings.HasPrefix(s, prefix) {
+		return nil, ErrUnknownPrefix
+	}
+	decoded, err := base58.StdEncoding.DecodeString(s[len(prefix):])
+	if err != nil {
+		return nil, err
+	}
+	if len(decoded) < checksumLen {
+		return nil, base58.ErrInvalidFormat
+	}
+	payload := decoded[:len(decoded)-checksumLen]
+	if !bytes.Equal(checksum(payload, suffix(prefix)), decoded[len(payload):]) {
+		return nil, ErrChecksumMismatch
+	}
+	if isPublic(prefix) && len(payload) != PublicKeyLen {
+		return nil, fmt.Errorf("%w: %d bytes, want %d", ErrInvalidLength, len(payload), PublicKeyLen)
+	}
+	return payload, nil
+}
+
+// detect the prefix of s from Prefixes, decode and verify it
+func Decode(s string) (prefix string, payload []byte, err error) {
+	for _, p := range Prefixes {
+		if strings.HasPrefix(s, p) {
+			payload, err := DecodePrefix(p, s)
+			return p, payload, err
+		}
+	}
+	return "", nil, ErrUnknownPrefix
+}
@@ -0,0 +1,61 @@
+package graphene_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+	"github.com/cyclone-github/base58/graphene"
+)
+
+// default eosio development public key
+const devKey = "02c0ded2bc1f1305fb0faac5e6c03ee3a1924234985427b6167ca569d13df435cf"
+
+func TestGraphene(t *testing.T) {
+	key, _ := hex.DecodeString(devKey)
+	tests := []struct {
+		prefix, encoded string
+	}{
+		{graphene.EOS, "EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV"},
+		{graphene.Steem, "STM6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV"},
+		{graphene.PubK1, "PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5BoDq63"},
+	}
+	for _, tt := range tests {
+		got, err := graphene.Encode(tt.prefix, key)
+		if err != nil {
+			t.Fatalf("Encode(%s) failed: %v", tt.prefix, err)
+		}
+		if got != tt.encoded {
+			t.Errorf("Encode(%s): got %q, want %q", tt.prefix, got, tt.encoded)
+		}
+		prefix, payload, err := graphene.Decode(tt.encoded)
+		if err != nil {
+			t.Fatalf("Decode(%q) failed: %v", tt.encoded, err)
+		}
+		if prefix != tt.prefix || hex.EncodeToString(payload) != devKey {
+			t.Errorf("Decode(%q): got %s/%x, want %s/%s", tt.encoded, prefix, payload, tt.prefix, devKey)
+		}
+	}
+}
+
+func TestGrapheneErrors(t *testing.T) {
+	// a legacy checksum under a typed prefix fails, the suffix is covered
+	_, err := graphene.DecodePrefix(graphene.PubK1, "PUB_K1_6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV")
+	if !errors.Is(err, graphene.ErrChecksumMismatch) || !errors.Is(err, base58.ErrChecksumMismatch) {
+		t.Errorf("DecodePrefix(legacy checksum): got error %v, want %v", err, graphene.ErrChecksumMismatch)
+	}
+	if _, _, err := graphene.Decode("XYZ6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV"); !errors.Is(err, graphene.ErrUnknownPrefix) {
+		t.Errorf("Decode(unknown prefix): got error %v, want %v", err, graphene.ErrUnknownPrefix)
+	}
+	if _, err := graphene.Encode(graphene.EOS, make([]byte, 32)); !errors.Is(err, graphene.ErrInvalidLength) {
+		t.Errorf("Encode(32-byte key): got error %v, want %v", err, graphene.ErrInvalidLength)
+	}
+	sig, err := graphene.Encode(graphene.SigK1, make([]byte, 65))
+	if err != nil {
+		t.Fatalf("Encode(SIG_K1_) failed: %v", err)
+	}
+	if prefix, payload, err := graphene.Decode(sig); err != nil || prefix != graphene.SigK1 || len(payload) != 65 {
+		t.Errorf("Decode(%q): got %s/%d bytes/%v", sig, prefix, len(payload), err)
+	}
+}
@@ -0,0 +1,77 @@
+package base58
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+/*
+Hashcat $HEX[] Output
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Hashcat and the tools around it print plaintexts containing bytes outside
+printable ASCII as $HEX[...] with the bytes in lowercase hex, and plain text
+otherwise. FormatHashcat renders decoded payloads the same way, so decoded
+lists can be fed straight into cracking and analysis pipelines:
+
+	base58.FormatHashcat([]byte("sure."))   // sure.
+	base58.FormatHashcat([]byte{0x00, 0xff}) // $HEX[00ff]
+
+Text that itself starts with "$HEX[" is also hex-encoded so that the output
+always parses back to the original bytes with ParseHashcat.
+*/
+
+const (
+	hashcatPrefix = "$HEX["
+	hashcatSuffix = "]"
+)
+
+// malformed $HEX[...] string
+var ErrInvalidHashcat = errors.New("base58: invalid $HEX[] string")
+
+// report whether b must be written in $HEX[] form: it holds a byte outside
+// printable ASCII or would otherwise be mistaken for $HEX[] output
+func NeedsHashcatHex(b []byte) bool {
+	for _, c := range b {
+		if c < 0x20 || c > 0x7e {
+			return true
+		}
+	}
+	return strings.HasPrefix(string(b), hashcatPrefix)
+}
+
+// return b as plain text, or as $HEX[...] if NeedsHashcatHex(b)
+func FormatHashcat(b []byte) string {
+	if !NeedsHashcatHex(b) {
+		return string(b)
+	}
+	return hashcatPrefix + hex.EncodeToString(b) + hashcatSuffix
+}
+
+// return the bytes of a FormatHashcat string: the hex bytes of $HEX[...],
+// otherwise s itself
+func ParseHashcat(s string) ([]byte, error) {
+	if !strings.HasPrefix(s, hashcatPrefix) {
+		return []byte(s), nil
+	}
+	if !strings.HasSuffix(s, hashcatSuffix) {
+		return nil, ErrInvalidHashcat
+	}
+	b, err := hex.DecodeString(s[len(hashcatPrefix) : len(s)-len(hashcatSuffix)])
+	if err != nil {
+		return nil, ErrInvalidHashcat
+	}
+	return b, nil
+}
+
+// decode base58 string s and format it with FormatHashcat
+func (enc *Encoding) ToHashcat(s string) (string, error) {
+	b, err := enc.DecodeString(s)
+	if err != nil {
+		return "", err
+	}
+	return FormatHashcat(b), nil
+}
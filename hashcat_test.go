@@ -0,0 +1,51 @@
+package base58_test
+
+import (
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestFormatHashcat(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"", ""},
+		{"sure.", "sure."},
+		{"pass word:~", "pass word:~"},
+		{"\x00\xff", "$HEX[00ff]"},
+		{"caf\xc3\xa9", "$HEX[636166c3a9]"},
+		{"tab\there", "$HEX[7461620968657265]"},
+		{"$HEX[41]", "$HEX[244845585b34315d]"},
+		{"$HEX", "$HEX"},
+	}
+	for _, tt := range tests {
+		got := base58.FormatHashcat([]byte(tt.in))
+		testEqual(t, "FormatHashcat = %q, want %q", tt.want, got)
+		back, err := base58.ParseHashcat(got)
+		if err != nil || string(back) != tt.in {
+			t.Errorf("ParseHashcat(%q) = %q, %v, want %q", got, back, err, tt.in)
+		}
+	}
+
+	for _, bad := range []string{"$HEX[", "$HEX[0g]", "$HEX[123]", "$HEX[00"} {
+		if _, err := base58.ParseHashcat(bad); err != base58.ErrInvalidHashcat {
+			t.Errorf("ParseHashcat(%q): got error %v, want %v", bad, err, base58.ErrInvalidHashcat)
+		}
+	}
+}
+
+func TestToHashcat(t *testing.T) {
+	got, err := base58.StdEncoding.ToHashcat("E2XFRyo")
+	if err != nil || got != "sure." {
+		t.Errorf("ToHashcat(E2XFRyo) = %q, %v, want \"sure.\"", got, err)
+	}
+	got, err = base58.StdEncoding.ToHashcat("112")
+	if err != nil || got != "$HEX[000001]" {
+		t.Errorf("ToHashcat(112) = %q, %v, want \"$HEX[000001]\"", got, err)
+	}
+	if _, err := base58.StdEncoding.ToHashcat("0OIl"); err == nil {
+		t.Error("ToHashcat(0OIl): got no error")
+	}
+}
@@ -0,0 +1,127 @@
+package base58
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+/*
+Validation Hooks
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Application invariants on decoded data, such as the length of a key or the
+version byte of an address, can be attached to an Encoding so every decode
+through it enforces them instead of each call site:
+
+	addrs := base58.NewCheckEncoding(
+		base58.StdEncoding.WithValidator(base58.ExactLen(21), base58.RequireVersion(0x00, 0x05)),
+		base58.NewDoubleSHA256, 4)
+	payload, err := addrs.DecodeString(s) // errors.Is(err, base58.ErrValidation)
+
+Validators run in order after a successful Decode, DecodeToBytes,
+DecodeString, DecodeStringContext, DecodeInto, DecodeInPlace or NewDecoder.
+A CheckEncoding runs the validators of its encoding on the payload once the
+checksum is verified, so they never see the checksum bytes. DecodePrefix,
+Validate and the block format do not run them.
+*/
+
+// decoded data was rejected by a validator
+var ErrValidation = errors.New("base58: validation failed")
+
+// decoded version byte is not one of the required ones
+var ErrInvalidVersion = errors.New("base58: invalid version byte")
+
+// check of decoded data, returning an error to reject it. Validators must
+// not retain or modify decoded.
+type Validator func(decoded []byte) error
+
+// return a copy of enc that runs validators, after any it already has, on
+// the result of every decode. A rejection is returned wrapped in
+// ErrValidation. Encodings with validators cannot be compared with Equal
+// or serialized.
+func (enc *Encoding) WithValidator(validators ...Validator) *Encoding {
+	e := *enc
+	e.validators = append(slices.Clip(enc.validators), validators...)
+	return &e
+}
+
+// return a copy of enc without validators
+func (enc *Encoding) WithoutValidators() *Encoding {
+	e := *enc
+	e.validators = nil
+	return &e
+}
+
+// report whether enc has validators
+func (enc *Encoding) HasValidators() bool {
+	return len(enc.validators) > 0
+}
+
+// run the validators of enc on decoded
+func (enc *Encoding) runValidators(decoded []byte) error {
+	for _, v := range enc.validators {
+		if err := v(decoded); err != nil {
+			return fmt.Errorf("%w: %w", ErrValidation, err)
+		}
+	}
+	return nil
+}
+
+// run the validators of enc on a successful decode, releasing decoded if
+// it is rejected
+func (enc *Encoding) validated(decoded []byte, err error) ([]byte, error) {
+	if err != nil || len(enc.validators) == 0 {
+		return decoded, err
+	}
+	if err := enc.runValidators(decoded); err != nil {
+		enc.release(decoded)
+		return nil, err
+	}
+	return decoded, nil
+}
+
+// validator requiring a decoded length of one of n bytes
+func ExactLen(n ...int) Validator {
+	return func(decoded []byte) error {
+		if slices.Contains(n, len(decoded)) {
+			return nil
+		}
+		if len(n) == 1 {
+			return fmt.Errorf("%w: got %d bytes, want %d", ErrInvalidLength, len(decoded), n[0])
+		}
+		return fmt.Errorf("%w: got %d bytes, want one of %v", ErrInvalidLength, len(decoded), n)
+	}
+}
+
+// validator requiring the first decoded byte to be one of versions
+func RequireVersion(versions ...byte) Validator {
+	return func(decoded []byte) error {
+		if len(decoded) == 0 {
+			return fmt.Errorf("%w: no version byte", ErrInvalidVersion)
+		}
+		if !slices.Contains(versions, decoded[0]) {
+			return fmt.Errorf("%w: got %#02x", ErrInvalidVersion, decoded[0])
+		}
+		return nil
+	}
+}
+
+// validator rejecting decoded data for which pred is false, with reason as
+// the error message
+func Require(reason string, pred func(decoded []byte) bool) Validator {
+	err := errors.New(reason)
+	return func(decoded []byte) error {
+		if !pred(decoded) {
+			return err
+		}
+		return nil
+	}
+}
+
+// return a copy of ce whose encoding runs validators on the payload
+func (ce *CheckEncoding) WithValidator(validators ...Validator) *CheckEncoding {
+	return NewCheckEncoding(ce.enc.WithValidator(validators...), ce.hash, ce.checksumLen)
+}
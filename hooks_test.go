@@ -0,0 +1,185 @@
+package base58_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestWithValidator(t *testing.T) {
+	enc := base58.StdEncoding.WithValidator(base58.ExactLen(4))
+	good := base58.StdEncoding.EncodeToString([]byte{0, 1, 2, 3})
+	bad := base58.StdEncoding.EncodeToString([]byte{0, 1, 2})
+
+	if got, err := enc.DecodeString(good); err != nil || !bytes.Equal(got, []byte{0, 1, 2, 3}) {
+		t.Errorf("DecodeString(%q): got %x, %v", good, got, err)
+	}
+	if base58.StdEncoding.HasValidators() || !enc.HasValidators() {
+		t.Error("WithValidator modified the original encoding")
+	}
+
+	decodes := map[string]func(s string) error{
+		"DecodeString": func(s string) error {
+			_, err := enc.DecodeString(s)
+			return err
+		},
+		"DecodeToBytes": func(s string) error {
+			_, err := enc.DecodeToBytes([]byte(s))
+			return err
+		},
+		"Decode": func(s string) error {
+			_, err := enc.Decode(make([]byte, len(s)), []byte(s))
+			return err
+		},
+		"DecodeStringContext": func(s string) error {
+			_, err := enc.DecodeStringContext(context.Background(), s)
+			return err
+		},
+		"DecodeInto": func(s string) error {
+			dst := make([]byte, 8)
+			_, err := enc.DecodeInto(dst, []byte(s))
+			if err != nil && !allBytes(dst, 0) {
+				t.Errorf("DecodeInto(%q): dst not cleared on rejection", s)
+			}
+			return err
+		},
+		"DecodeInPlace": func(s string) error {
+			_, err := enc.DecodeInPlace([]byte(s))
+			return err
+		},
+		"NewDecoder": func(s string) error {
+			d := base58.NewDecoder(enc, strings.NewReader(s))
+			defer d.Close()
+			got, err := io.ReadAll(d)
+			if err != nil && len(got) > 0 {
+				t.Errorf("NewDecoder(%q): got %x with the rejection", s, got)
+			}
+			return err
+		},
+	}
+	for name, decode := range decodes {
+		if err := decode(good); err != nil {
+			t.Errorf("%s(%q): %v", name, good, err)
+		}
+		err := decode(bad)
+		if !errors.Is(err, base58.ErrValidation) || !errors.Is(err, base58.ErrInvalidLength) {
+			t.Errorf("%s(%q): got error %v, want %v", name, bad, err, base58.ErrValidation)
+		}
+	}
+}
+
+func TestValidatorOrder(t *testing.T) {
+	var calls []string
+	record := func(name string, err error) base58.Validator {
+		return func([]byte) error {
+			calls = append(calls, name)
+			return err
+		}
+	}
+	first := base58.StdEncoding.WithValidator(record("a", nil))
+	enc := first.WithValidator(record("b", errors.New("rejected")), record("c", nil))
+	_, err := enc.DecodeString("2g")
+	testEqual(t, "validator calls = %q, want %q", "a,b", strings.Join(calls, ","))
+	testEqual(t, "error = %q, want %q", "base58: validation failed: rejected", err.Error())
+
+	// appending to a derived encoding leaves its parent alone
+	calls = nil
+	first.DecodeString("2g")
+	testEqual(t, "parent validator calls = %q, want %q", "a", strings.Join(calls, ","))
+	calls = nil
+	enc.WithoutValidators().DecodeString("2g")
+	testEqual(t, "WithoutValidators calls = %v, want %v", 0, len(calls))
+}
+
+func TestCheckValidators(t *testing.T) {
+	p2pkh := base58.CheckEncodeVersion([]byte{0x00}, make([]byte, 20))
+	p2sh := base58.CheckEncodeVersion([]byte{0x05}, make([]byte, 20))
+	short := base58.CheckEncodeVersion([]byte{0x00}, make([]byte, 19))
+	ce := base58.StdCheckEncoding.WithValidator(base58.ExactLen(21), base58.RequireVersion(0x00))
+
+	// the validators see the 21-byte payload, not the checksum
+	if _, err := ce.DecodeString(p2pkh); err != nil {
+		t.Errorf("DecodeString(P2PKH): %v", err)
+	}
+	if _, err := ce.DecodeString(p2sh); !errors.Is(err, base58.ErrValidation) || !errors.Is(err, base58.ErrInvalidVersion) {
+		t.Errorf("DecodeString(P2SH): got error %v, want %v", err, base58.ErrInvalidVersion)
+	}
+	if _, _, err := ce.DecodeVersion(short, 1); !errors.Is(err, base58.ErrInvalidLength) {
+		t.Errorf("DecodeVersion(19-byte hash): got error %v, want %v", err, base58.ErrInvalidLength)
+	}
+	// the checksum is verified first
+	corrupt := p2sh[:len(p2sh)-1] + "2"
+	if _, err := ce.DecodeString(corrupt); !errors.Is(err, base58.ErrChecksumMismatch) {
+		t.Errorf("DecodeString(corrupt): got error %v, want %v", err, base58.ErrChecksumMismatch)
+	}
+	if _, err := io.ReadAll(base58.NewCheckDecoder(ce, strings.NewReader(p2sh))); !errors.Is(err, base58.ErrInvalidVersion) {
+		t.Errorf("NewCheckDecoder(P2SH): got error %v, want %v", err, base58.ErrInvalidVersion)
+	}
+	if got, err := io.ReadAll(base58.NewCheckDecoder(ce, strings.NewReader(p2pkh))); err != nil || len(got) != 21 {
+		t.Errorf("NewCheckDecoder(P2PKH): got %x, %v", got, err)
+	}
+
+	// an encoding with validators behaves the same through NewCheckEncoding
+	ce = base58.NewCheckEncoding(base58.StdEncoding.WithValidator(base58.RequireVersion(0x05)), base58.NewDoubleSHA256, 4)
+	if _, err := ce.DecodeString(p2sh); err != nil {
+		t.Errorf("NewCheckEncoding DecodeString(P2SH): %v", err)
+	}
+	if _, err := ce.DecodeString(p2pkh); !errors.Is(err, base58.ErrInvalidVersion) {
+		t.Errorf("NewCheckEncoding DecodeString(P2PKH): got error %v, want %v", err, base58.ErrInvalidVersion)
+	}
+}
+
+func TestValidatorHelpers(t *testing.T) {
+	tests := []struct {
+		name    string
+		v       base58.Validator
+		decoded []byte
+		want    string
+	}{
+		{"ExactLen ok", base58.ExactLen(32, 33), make([]byte, 33), ""},
+		{"ExactLen", base58.ExactLen(32), make([]byte, 31), "base58: invalid decoded length: got 31 bytes, want 32"},
+		{"ExactLen any", base58.ExactLen(32, 33), nil, "base58: invalid decoded length: got 0 bytes, want one of [32 33]"},
+		{"RequireVersion ok", base58.RequireVersion(0x80, 0xef), []byte{0xef, 1}, ""},
+		{"RequireVersion", base58.RequireVersion(0x80), []byte{0x05}, "base58: invalid version byte: got 0x05"},
+		{"RequireVersion empty", base58.RequireVersion(0x80), nil, "base58: invalid version byte: no version byte"},
+		{"Require ok", base58.Require("odd", func(b []byte) bool { return len(b)%2 == 1 }), []byte{1}, ""},
+		{"Require", base58.Require("odd length required", func(b []byte) bool { return len(b)%2 == 1 }), []byte{1, 2}, "odd length required"},
+	}
+	for _, tt := range tests {
+		got := ""
+		if err := tt.v(tt.decoded); err != nil {
+			got = err.Error()
+		}
+		testEqual(t, tt.name+": error = %q, want %q", tt.want, got)
+	}
+}
+
+func TestValidatorEncodingProperties(t *testing.T) {
+	enc := base58.StdEncoding.WithValidator(base58.ExactLen(4))
+	if enc.Equal(enc.WithValidator()) || enc.Equal(base58.StdEncoding) {
+		t.Error("Equal: encodings with validators compared equal")
+	}
+	if !enc.WithoutValidators().Equal(base58.StdEncoding) {
+		t.Error("Equal(WithoutValidators): got false, want true")
+	}
+	if _, err := enc.MarshalText(); !errors.Is(err, base58.ErrInvalidEncoding) {
+		t.Errorf("MarshalText: got error %v, want %v", err, base58.ErrInvalidEncoding)
+	}
+	if _, err := enc.MarshalBinary(); !errors.Is(err, base58.ErrInvalidEncoding) {
+		t.Errorf("MarshalBinary: got error %v, want %v", err, base58.ErrInvalidEncoding)
+	}
+	_, err := enc.DecodeString("2g")
+	testEqual(t, "ErrorKind = %q, want %q", base58.ErrorValidation, base58.ErrorKind(err))
+
+	// a rejected result goes back to the allocator
+	alloc := newCountingAllocator(t)
+	if _, err := enc.WithAllocator(alloc).DecodeString("2g"); err == nil {
+		t.Fatal("DecodeString(1 byte): got nil error")
+	}
+	testEqual(t, "outstanding buffers = %v, want %v", 0, alloc.outstanding())
+}
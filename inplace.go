@@ -0,0 +1,267 @@
+package base58
+
+import (
+	"fmt"
+)
+
+/*
+In-Place Encoding and Decoding
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Pipelines that own one large buffer can convert it without a second
+allocation. DecodeInPlace replaces base58 text with its decoded bytes, and
+EncodeInPlace replaces bytes with their encoding. The encoding needs more
+room than its input, so buf must have EncodedLen(n) bytes:
+
+	buf := make([]byte, enc.EncodedLen(len(data)))
+	n := copy(buf, data)
+	n, err := enc.EncodeInPlace(buf, n) // buf[:n] is the encoding
+
+Both build the number at the front of buf by multiply-and-add while the
+input is read from further back. The number never grows past the next
+unread input byte, so nothing is allocated and no input is overwritten
+before it is read. Encode and Decode also accept overlapping dst and src,
+but go through a scratch buffer.
+*/
+
+// encode buf[:n] into buf and return the encoded length. buf must hold
+// enc.EncodedLen(n) bytes, otherwise ErrShortBuffer is returned and buf is
+// unchanged. Should the formatted result still not fit, buf is cleared and
+// ErrShortBuffer returned rather than writing past it. Honors the pad
+// width, zero digit, separators and line wrapping of enc.
+func (enc *Encoding) EncodeInPlace(buf []byte, n int) (int, error) {
+	start := enc.traceStart()
+	if n < 0 || n > len(buf) {
+		panic("base58: EncodeInPlace length out of range")
+	}
+	need := enc.EncodedLen(n)
+	if len(buf) < need {
+		return 0, fmt.Errorf("%w: need %d bytes, have %d", ErrShortBuffer, need, len(buf))
+	}
+	buf = buf[:need]
+	// move the input to the end, the digits grow from the front
+	in := need - n
+	copy(buf[in:], buf[:n])
+	if enc.littleEndian {
+		reverseBytes(buf[in:need])
+	}
+	f := newDigitFolder(enc, buf)
+	f.fold(buf[in:need])
+	m, err := f.finish()
+	if err != nil {
+		return 0, err
+	}
+	enc.observeEncode(n)
+	enc.traceDone("encode", "in-place", start, n, m, nil)
+	return m, nil
+}
+
+// base-256 to base-N conversion into a caller-owned buffer, one input chunk
+// at a time. The digits grow little-endian from the front of buf, so input
+// may also sit further back in buf as long as it is folded before the
+// digits reach it.
+type digitFolder struct {
+	enc     *Encoding
+	buf     []byte
+	used    int  // digits in buf
+	zeros   int  // leading zero bytes
+	leading bool // still counting leading zero bytes
+}
+
+// folder writing to buf, which must hold the formatted encoding
+func newDigitFolder(enc *Encoding, buf []byte) *digitFolder {
+	// padded encodings carry no leading zero bytes
+	return &digitFolder{enc: enc, buf: buf, leading: enc.padWidth == 0}
+}
+
+// fold the big-endian bytes of p into the number
+func (f *digitFolder) fold(p []byte) {
+	base := uint(f.enc.base)
+	buf := f.buf
+	for _, c := range p {
+		carry := uint(c)
+		if f.leading && carry == 0 {
+			f.zeros++
+			continue
+		}
+		f.leading = false
+		// base58 divides by a constant, which avoids hardware division
+		if base == 58 {
+			carry = shiftIn58(buf[:f.used], carry)
+		} else {
+			carry = shiftIn(buf[:f.used], carry, base)
+		}
+		for carry > 0 {
+			buf[f.used] = byte(carry % base)
+			f.used++
+			carry /= base
+		}
+	}
+}
+
+// multiply the little-endian base-N digits by 256, add carry and return
+// the carry out of the top digit
+func shiftIn(digits []byte, carry, base uint) uint {
+	for j, d := range digits {
+		carry += uint(d) << 8
+		digits[j] = byte(carry % base)
+		carry /= base
+	}
+	return carry
+}
+
+// shiftIn for base 58
+func shiftIn58(digits []byte, carry uint) uint {
+	for j, d := range digits {
+		carry += uint(d) << 8
+		digits[j] = byte(carry % 58)
+		carry /= 58
+	}
+	return carry
+}
+
+// write the encoding of the folded number to the front of buf, padded and
+// formatted as enc, clear the rest and return its length. buf is cleared
+// and ErrShortBuffer returned if the formatted result does not fit.
+func (f *digitFolder) finish() (int, error) {
+	enc, buf, used := f.enc, f.buf, f.used
+	reverseBytes(buf[:used])
+	for j := range buf[:used] {
+		buf[j] = enc.encode[buf[j]]
+	}
+	zeros := f.zeros
+	if enc.padWidth > used {
+		zeros = enc.padWidth - used
+	}
+	m := zeros + used
+	if l := enc.formattedLen(m); l > len(buf) {
+		clear(buf)
+		return 0, fmt.Errorf("%w: need %d bytes, have %d", ErrShortBuffer, l, len(buf))
+	}
+	copy(buf[zeros:], buf[:used])
+	zero := enc.zeroDigit()
+	for j := range buf[:zeros] {
+		buf[j] = zero
+	}
+	if enc.groupSize > 0 {
+		m = expand(buf, m, enc.groupSize, enc.sep)
+	}
+	if enc.lineLen > 0 {
+		m = expand(buf, m, enc.lineLen, '\n')
+	}
+	clear(buf[m:])
+	return m, nil
+}
+
+// return the length of m digits once separators and line breaks are
+// inserted
+func (enc *Encoding) formattedLen(m int) int {
+	if m == 0 {
+		return 0
+	}
+	if enc.groupSize > 0 {
+		m += (m - 1) / enc.groupSize
+	}
+	if enc.lineLen > 0 {
+		m += (m - 1) / enc.lineLen
+	}
+	return m
+}
+
+// insert sep between every size characters of buf[:n] in place, working
+// backwards, and return the new length
+func expand(buf []byte, n, size int, sep byte) int {
+	if n == 0 {
+		return 0
+	}
+	m := n + (n-1)/size
+	for i := n - 1; i >= 0; i-- {
+		d := i + i/size
+		buf[d] = buf[i]
+		if i > 0 && i%size == 0 {
+			buf[d-1] = sep
+		}
+	}
+	return m
+}
+
+// decode the base58 text in buf into buf and return the decoded length.
+// The decoded bytes never outnumber the characters, so no extra room is
+// needed. buf beyond the result is cleared, all of buf on failure. Honors
+// the pad width, zero digit, skipped characters, invalid character handler
+// and length limits of enc.
+func (enc *Encoding) DecodeInPlace(buf []byte) (n int, err error) {
+	start := enc.traceStart()
+	inLen := len(buf)
+	defer func() {
+		if err != nil {
+			clear(buf)
+		}
+		if enc.instr != nil {
+			enc.observeDecode(buf[:n], err)
+		}
+		enc.traceDone("decode", "in-place", start, inLen, n, err)
+	}()
+	if err := enc.checkInputLen(len(buf)); err != nil {
+		return 0, err
+	}
+	// the number grows from the front of buf, used bytes little-endian
+	used, zeros, count, padZeros := 0, 0, 0, 0
+	leading := true
+	for i := 0; i < len(buf); i++ {
+		val, err := enc.digitValue(buf[i], i, leading)
+		if err != nil {
+			return 0, err
+		}
+		if val == -1 {
+			continue
+		}
+		count++
+		if leading && val == 0 {
+			if enc.padWidth == 0 {
+				zeros++
+			} else {
+				padZeros++
+			}
+			continue
+		}
+		leading = false
+		carry := val
+		for j := 0; j < used; j++ {
+			carry += int(buf[j]) * enc.base
+			buf[j] = byte(carry)
+			carry >>= 8
+		}
+		for carry > 0 {
+			buf[used] = byte(carry)
+			used++
+			carry >>= 8
+		}
+		if enc.maxDecoded > 0 && zeros+used > enc.maxDecoded {
+			return 0, &LimitError{Limit: int64(enc.maxDecoded)}
+		}
+	}
+	if enc.padWidth > 0 {
+		if err := enc.checkPadCount(count, padZeros > 0); err != nil {
+			return 0, err
+		}
+		// the value is extended to PadSize bytes
+		zeros = max(enc.padSize-used, 0)
+	}
+	if enc.maxDecoded > 0 && zeros > enc.maxDecoded {
+		return 0, &LimitError{Limit: int64(enc.maxDecoded)}
+	}
+	reverseBytes(buf[:used])
+	copy(buf[zeros:], buf[:used])
+	clear(buf[:zeros])
+	clear(buf[zeros+used:])
+	if enc.littleEndian {
+		reverseBytes(buf[:zeros+used])
+	}
+	if err := enc.runValidators(buf[:zeros+used]); err != nil {
+		return 0, err
+	}
+	return zeros + used, nil
+}
@@ -0,0 +1,129 @@
+package base58_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestEncodeInPlace(t *testing.T) {
+	encodings := []*base58.Encoding{
+		base58.StdEncoding,
+		base58.GMPEncoding,
+		base58.StdEncoding.WithPadWidth(50),
+		base58.StdEncoding.WithSeparator('-', 4),
+		base58.StdEncoding.WithSeparator('-', 4).WithWrap(10),
+		base58.StdEncoding.WithZeroDigit('_'),
+		base58.StdEncoding.WithPadWidth(12).WithSeparator('-', 4),
+		base58.StdEncoding.WithPadWidth(12).WithSeparator('-', 4).WithWrap(5),
+		base36Encoding,
+	}
+	inputs := [][]byte{
+		{},
+		{0},
+		{0, 0, 0},
+		{0, 0, 1, 2, 3},
+		[]byte("Hello World!"),
+		bytes.Repeat([]byte{0xff}, 64),
+		append([]byte{0, 0}, bytes.Repeat([]byte{0xa5}, 32)...),
+	}
+	for _, enc := range encodings {
+		for _, in := range inputs {
+			want := enc.EncodeToString(in)
+			buf := make([]byte, enc.EncodedLen(len(in)))
+			copy(buf, in)
+			n, err := enc.EncodeInPlace(buf, len(in))
+			if err != nil || string(buf[:n]) != want {
+				t.Errorf("%v EncodeInPlace(%x) = %q, %v, want %q", enc, in, buf[:n], err, want)
+			}
+			if !allBytes(buf[n:], 0) {
+				t.Errorf("%v EncodeInPlace(%x) left data after the result", enc, in)
+			}
+
+			// a larger buffer works the same
+			big := make([]byte, len(buf)+7)
+			copy(big, in)
+			if n, err := enc.EncodeInPlace(big, len(in)); err != nil || string(big[:n]) != want {
+				t.Errorf("%v EncodeInPlace(%x) in a larger buffer = %q, %v, want %q", enc, in, big[:n], err, want)
+			}
+		}
+	}
+
+	in := []byte("Hello World!")
+	buf := make([]byte, base58.StdEncoding.EncodedLen(len(in))-1)
+	copy(buf, in)
+	if _, err := base58.StdEncoding.EncodeInPlace(buf, len(in)); !errors.Is(err, base58.ErrShortBuffer) {
+		t.Errorf("EncodeInPlace with a short buffer: got error %v, want %v", err, base58.ErrShortBuffer)
+	}
+	if !bytes.Equal(buf[:len(in)], in) {
+		t.Errorf("EncodeInPlace with a short buffer changed the input")
+	}
+}
+
+func TestDecodeInPlace(t *testing.T) {
+	encodings := []*base58.Encoding{
+		base58.StdEncoding,
+		base58.GMPEncoding,
+		base58.StdEncoding.WithPadWidth(50),
+		base58.StdEncoding.WithSeparator('-', 4).WithWrap(10),
+		base58.StdEncoding.WithZeroDigit('_'),
+		base58.StdEncoding.WithPadWidth(12).WithSeparator('-', 4),
+		base58.StdEncoding.WithPadWidth(12).WithSeparator('-', 4).WithWrap(5),
+		base36Encoding,
+	}
+	inputs := [][]byte{
+		{},
+		{0, 0, 1, 2, 3},
+		[]byte("Hello World!"),
+		bytes.Repeat([]byte{0xff}, 64),
+	}
+	for _, enc := range encodings {
+		for _, in := range inputs {
+			encoded := enc.EncodeToString(in)
+			want, wantErr := enc.DecodeString(encoded)
+			buf := []byte(encoded)
+			n, err := enc.DecodeInPlace(buf)
+			if wantErr != nil {
+				// longer than the pad width
+				if err == nil {
+					t.Errorf("%v DecodeInPlace(%q): got no error, want %v", enc, encoded, wantErr)
+				}
+				continue
+			}
+			if err != nil || !bytes.Equal(buf[:n], want) {
+				t.Errorf("%v DecodeInPlace(%q) = %x, %v, want %x", enc, encoded, buf[:n], err, want)
+			}
+			if !allBytes(buf[n:], 0) {
+				t.Errorf("%v DecodeInPlace(%q) left data after the result", enc, encoded)
+			}
+		}
+	}
+
+	buf := []byte("2NEpo7TZR0RrLZSi2U")
+	if _, err := base58.StdEncoding.DecodeInPlace(buf); err != base58.CorruptInputError(9) {
+		t.Errorf("DecodeInPlace invalid: got error %v, want %v", err, base58.CorruptInputError(9))
+	}
+	if !allBytes(buf, 0) {
+		t.Errorf("DecodeInPlace invalid: buffer not cleared")
+	}
+	var lerr *base58.LimitError
+	if _, err := base58.StdEncoding.WithMaxDecodedLen(4).DecodeInPlace([]byte("2NEpo7TZRRrLZSi2U")); !errors.As(err, &lerr) {
+		t.Errorf("DecodeInPlace over limit: got error %v, want *LimitError", err)
+	}
+}
+
+func TestEncodeDecodeOverlap(t *testing.T) {
+	in := []byte("Hello World!")
+	want := base58.StdEncoding.EncodeToString(in)
+	buf := make([]byte, len(want))
+	copy(buf, in)
+	n := base58.StdEncoding.Encode(buf, buf[:len(in)])
+	testEqual(t, "Encode overlapping = %q, want %q", want, string(buf[:n]))
+
+	n, err := base58.StdEncoding.Decode(buf, buf)
+	if err != nil || string(buf[:n]) != string(in) {
+		t.Errorf("Decode overlapping = %q, %v, want %q", buf[:n], err, in)
+	}
+}
@@ -0,0 +1,99 @@
+package base58
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+/*
+String Inspection
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Inspect answers "what is this string?" for CLIs and debuggers: which
+registered alphabets accept it, what it decodes to and, if it is valid
+Base58Check, its version prefix and likely network.
+*/
+
+// structured description of an encoded string, see Inspect
+type Report struct {
+	Input        string
+	Encodings    []*Encoding // registered encodings accepting every character
+	Encoding     *Encoding   // encoding used to decode, nil if none accepts
+	Decoded      []byte
+	LeadingZeros int  // leading zero bytes of Decoded
+	Checksum     bool // valid double-SHA256 Base58Check
+	Version      []byte
+	Network      *Network    // registered network of Version, if known
+	Kind         VersionKind // version kind within Network
+}
+
+// checked encodings probed by Inspect, in order of preference
+var inspectChecks = []*CheckEncoding{StdCheckEncoding, RippleCheckEncoding}
+
+// describe s, see Report
+func Inspect(s string) *Report {
+	r := &Report{Input: s, Encodings: DetectAlphabet(s)}
+	if len(r.Encodings) == 0 {
+		return r
+	}
+	r.Encoding = r.Encodings[0]
+	for _, ce := range inspectChecks {
+		if !accepts(r.Encodings, ce.enc) {
+			continue
+		}
+		if decoded, err := ce.DecodeString(s); err == nil && len(decoded) > 0 {
+			r.Encoding, r.Checksum = ce.enc, true
+			if ce == StdCheckEncoding {
+				var versionLen int
+				r.Network, r.Kind, versionLen = lookupVersion(decoded)
+				r.Version = decoded[:max(versionLen, 1)]
+			} else {
+				r.Version = decoded[:1]
+			}
+			break
+		}
+	}
+	r.Decoded, _ = r.Encoding.DecodeString(s)
+	for r.LeadingZeros < len(r.Decoded) && r.Decoded[r.LeadingZeros] == 0 {
+		r.LeadingZeros++
+	}
+	return r
+}
+
+func accepts(encs []*Encoding, enc *Encoding) bool {
+	for _, e := range encs {
+		if e == enc {
+			return true
+		}
+	}
+	return false
+}
+
+// return the report as "key: value" lines
+func (r *Report) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "input: %q (%d chars)\n", r.Input, len(r.Input))
+	if r.Encoding == nil {
+		sb.WriteString("alphabet: none\n")
+		return sb.String()
+	}
+	names := make([]string, len(r.Encodings))
+	for i, e := range r.Encodings {
+		names[i] = e.Name()
+	}
+	fmt.Fprintf(&sb, "alphabet: %s (candidates: %s)\n", r.Encoding.Name(), strings.Join(names, ", "))
+	fmt.Fprintf(&sb, "decoded length: %d\n", len(r.Decoded))
+	fmt.Fprintf(&sb, "leading zeros: %d\n", r.LeadingZeros)
+	fmt.Fprintf(&sb, "hex: %s\n", hex.EncodeToString(r.Decoded))
+	fmt.Fprintf(&sb, "base58check: %v\n", r.Checksum)
+	if r.Checksum {
+		fmt.Fprintf(&sb, "version: %x\n", r.Version)
+		if r.Network != nil {
+			fmt.Fprintf(&sb, "network: %s %s\n", r.Network.Name, r.Kind)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,39 @@
+package base58_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestInspect(t *testing.T) {
+	r := base58.Inspect("1BoatSLRHtKNngkdXEeobR76b53LETtpyT")
+	want := `input: "1BoatSLRHtKNngkdXEeobR76b53LETtpyT" (34 chars)
+alphabet: bitcoin (candidates: bitcoin, ripple, flickr)
+decoded length: 25
+leading zeros: 1
+hex: 007680adec8eabcabac676be9e83854ade0bd22cdb0bb960de
+base58check: true
+version: 00
+network: btc pubkeyhash
+`
+	testEqual(t, "Inspect(address): got %q, want %q", want, r.String())
+
+	r = base58.Inspect("rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh")
+	if !r.Checksum || r.Encoding != base58.RippleEncoding || r.Network != nil {
+		t.Errorf("Inspect(ripple): got %+v", r)
+	}
+	testEqual(t, "Inspect(ripple) version: got %q, want %q", "00", fmt.Sprintf("%x", r.Version))
+
+	r = base58.Inspect("11E2XFRyo")
+	if r.Checksum || r.LeadingZeros != 2 || string(r.Decoded[2:]) != "sure." {
+		t.Errorf("Inspect(plain): got %+v", r)
+	}
+
+	r = base58.Inspect("0OIl!")
+	if r.Encoding != nil || r.Decoded != nil {
+		t.Errorf("Inspect(invalid): got %+v", r)
+	}
+	testEqual(t, "Inspect(invalid): got %q, want %q", "input: \"0OIl!\" (5 chars)\nalphabet: none\n", r.String())
+}
@@ -0,0 +1,103 @@
+package base58
+
+import "encoding/json"
+
+/*
+JSON Marshaling
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+encoding/json writes []byte fields as base64. Bytes, BytesWith, CheckBytes
+and CheckBytesWith marshal to base58 JSON strings instead, with a nil slice
+as null, so API types can expose addresses and IDs without hand-written
+MarshalJSON methods:
+
+	type Account struct {
+		Address base58.CheckBytes `json:"address"`
+		ID      base58.Bytes      `json:"id,omitempty"`
+	}
+*/
+
+// selects the checked encoding of a CheckBytesWith value
+type CheckEncodingSelector interface {
+	CheckEncoding() *CheckEncoding
+}
+
+func (Bitcoin) CheckEncoding() *CheckEncoding { return StdCheckEncoding }
+func (Ripple) CheckEncoding() *CheckEncoding  { return RippleCheckEncoding }
+
+// byte slice marshaled as text with the checked encoding selected by S,
+// unmarshaling fails unless the checksum verifies
+type CheckBytesWith[S CheckEncodingSelector] []byte
+
+// byte slice marshaled as StdCheckEncoding (Base58Check) text
+type CheckBytes = CheckBytesWith[Bitcoin]
+
+// return the checked encoding selected by S
+func (b CheckBytesWith[S]) checkEncoding() *CheckEncoding {
+	var s S
+	return s.CheckEncoding()
+}
+
+// encode b with its checksum, implementing encoding.TextMarshaler
+func (b CheckBytesWith[S]) MarshalText() ([]byte, error) {
+	return []byte(b.checkEncoding().EncodeToString(b)), nil
+}
+
+// verify and decode text into b, implementing encoding.TextUnmarshaler
+func (b *CheckBytesWith[S]) UnmarshalText(text []byte) error {
+	decoded, err := b.checkEncoding().DecodeString(string(text))
+	if err != nil {
+		return err
+	}
+	*b = decoded
+	return nil
+}
+
+// return the encoded form of b
+func (b CheckBytesWith[S]) String() string {
+	return b.checkEncoding().EncodeToString(b)
+}
+
+// encode b as a JSON string, or null if b is nil
+func (b BytesWith[S]) MarshalJSON() ([]byte, error) {
+	if b == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(b.String())
+}
+
+// decode a JSON string into b, null sets b to nil
+func (b *BytesWith[S]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, b)
+}
+
+// encode b with its checksum as a JSON string, or null if b is nil
+func (b CheckBytesWith[S]) MarshalJSON() ([]byte, error) {
+	if b == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(b.String())
+}
+
+// verify and decode a JSON string into b, null sets b to nil
+func (b *CheckBytesWith[S]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, b)
+}
+
+// decode a JSON string or null into the byte slice behind u
+func unmarshalJSON[T ~[]byte, P interface {
+	*T
+	UnmarshalText([]byte) error
+}](data []byte, u P) error {
+	if string(data) == "null" {
+		*u = nil
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return u.UnmarshalText([]byte(s))
+}
@@ -0,0 +1,63 @@
+package base58_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+// custom checked encoding selector for CheckBytesWith
+type crc32Check struct{}
+
+func (crc32Check) CheckEncoding() *base58.CheckEncoding { return base58.CRC32CheckEncoding }
+
+func TestBytesJSON(t *testing.T) {
+	type account struct {
+		Address base58.CheckBytes                    `json:"address"`
+		Ledger  base58.CheckBytesWith[base58.Ripple] `json:"ledger"`
+		Tag     base58.CheckBytesWith[crc32Check]    `json:"tag"`
+		ID      base58.Bytes                         `json:"id"`
+		Short   base58.BytesWith[base58.Flickr]      `json:"short,omitempty"`
+		Custom  base58.BytesWith[base36]             `json:"custom"`
+		List    []base58.Bytes                       `json:"list"`
+	}
+	hash := []byte("\x00\x76\x80\xad\xec\x8e\xab\xca\xba\xc6\x76\xbe\x9e\x83\x85\x4a\xde\x0b\xd2\x2c\xdb")
+	in := account{
+		Address: hash,
+		Ledger:  base58.CheckBytesWith[base58.Ripple]{0x00},
+		Tag:     base58.CheckBytesWith[crc32Check]("tag"),
+		Custom:  base58.BytesWith[base36]("sure."),
+		List:    []base58.Bytes{base58.Bytes("sure."), nil},
+	}
+	got, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"address":"1BoatSLRHtKNngkdXEeobR76b53LETtpyT","ledger":"` + base58.RippleCheckEncoding.EncodeToString([]byte{0}) +
+		`","tag":"` + base58.CRC32CheckEncoding.EncodeToString([]byte("tag")) + `","id":null,"custom":"` +
+		base36Encoding.EncodeToString([]byte("sure.")) + `","list":["E2XFRyo",null]}`
+	testEqual(t, "json.Marshal: got %s, want %s", want, string(got))
+
+	var out account
+	if err := json.Unmarshal(got, &out); err != nil {
+		t.Fatal(err)
+	}
+	if string(out.Address) != string(hash) || string(out.Tag) != "tag" || string(out.Custom) != "sure." ||
+		out.ID != nil || len(out.List) != 2 || string(out.List[0]) != "sure." || out.List[1] != nil {
+		t.Errorf("json.Unmarshal: got %+v", out)
+	}
+
+	var bad account
+	err = json.Unmarshal([]byte(`{"address":"1BoatSLRHtKNngkdXEeobR76b53LETtpyU"}`), &bad)
+	if !errors.Is(err, base58.ErrChecksumMismatch) {
+		t.Errorf("json.Unmarshal bad checksum: got %v", err)
+	}
+	if err := json.Unmarshal([]byte(`{"id":42}`), &bad); err == nil {
+		t.Error("json.Unmarshal number: got nil error")
+	}
+	if err := json.Unmarshal([]byte(`{"id":"E2X0Ryo"}`), &bad); err == nil {
+		t.Error("json.Unmarshal invalid character: got nil error")
+	}
+}
@@ -0,0 +1,138 @@
+package base58
+
+import (
+	"context"
+	"fmt"
+	"math"
+)
+
+/*
+Input Limits
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Decoding is quadratic in the input length, so a server decoding
+attacker-supplied strings should bound what it accepts. Limits are options
+like any other and are checked before the radix conversion runs:
+
+	tokens := base58.StdEncoding.WithMaxInputLen(64).WithMaxDecodedLen(32)
+
+DecodeStringContext additionally stops a long conversion once its context
+is cancelled or its deadline passes.
+*/
+
+// return a copy of enc that rejects input longer than n bytes on decode with
+// an ErrInvalidLength error, before copying or scanning it. The length
+// counts separators and other skipped characters. An n of 0 removes the
+// limit.
+func (enc *Encoding) WithMaxInputLen(n int) *Encoding {
+	if n < 0 {
+		panic("base58: negative max input length")
+	}
+	e := *enc
+	e.maxInput = n
+	return &e
+}
+
+// return the maximum input length of enc, 0 if unlimited
+func (enc *Encoding) MaxInputLen() int {
+	return enc.maxInput
+}
+
+// return a copy of enc that fails with a *LimitError when the decoded
+// result would exceed n bytes. Input that cannot decode to n bytes or fewer
+// is rejected from its digit count alone, before the conversion. The limit
+// also applies to NewDecoder. An n of 0 removes the limit.
+func (enc *Encoding) WithMaxDecodedLen(n int) *Encoding {
+	if n < 0 {
+		panic("base58: negative max decoded length")
+	}
+	e := *enc
+	e.maxDecoded = n
+	return &e
+}
+
+// return the maximum decoded length of enc, 0 if unlimited
+func (enc *Encoding) MaxDecodedLen() int {
+	return enc.maxDecoded
+}
+
+// decode s like DecodeString, returning ctx.Err() if ctx is done before
+// the conversion finishes
+func (enc *Encoding) DecodeStringContext(ctx context.Context, s string) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return enc.observeDecode(nil, err)
+	}
+	start := enc.traceStart()
+	src := enc.get(len(s))
+	copy(src, s)
+	defer enc.release(src)
+	decoded, err := enc.observeDecode(enc.validated(enc.decodeToBytes(ctx, src)))
+	enc.traceDone("decode", enc.radixPath(), start, len(s), len(decoded), err)
+	return decoded, err
+}
+
+// reject input of n bytes if it exceeds the input limit
+func (enc *Encoding) checkInputLen(n int) error {
+	if enc.maxInput > 0 && n > enc.maxInput {
+		return fmt.Errorf("%w: got %d characters, max %d", ErrInvalidLength, n, enc.maxInput)
+	}
+	return nil
+}
+
+// reject digits whose smallest possible decoded length exceeds the decoded
+// limit: leading zero digits decode to one byte each and k further digits
+// to at least floor((k-1)*log2(base)/8)+1 bytes, rounded down here to stay
+// a lower bound under floating point error
+func (enc *Encoding) checkMinDecodedLen(digits []byte) error {
+	if enc.maxDecoded == 0 {
+		return nil
+	}
+	zeros := 0
+	for zeros < len(digits) && digits[zeros] == 0 {
+		zeros++
+	}
+	n := zeros
+	if enc.padWidth > 0 {
+		n = 0
+	}
+	if k := len(digits) - zeros; k > 0 {
+		n += int(float64(k-1) * math.Log2(float64(enc.base)) / 8)
+	}
+	if enc.padWidth > 0 {
+		n = max(n, enc.padSize)
+	}
+	if n > enc.maxDecoded {
+		return &LimitError{Limit: int64(enc.maxDecoded)}
+	}
+	return nil
+}
+
+// reject n digits that decode past the decoded limit whatever their
+// values. Leading zero digits decode to a byte each, more than any other
+// digit, so the bound of checkMinDecodedLen without them holds for every
+// string of n digits. It depends only on n, for the constant-time decoder.
+func (enc *Encoding) checkDigitCount(n int) error {
+	if enc.maxDecoded == 0 || n == 0 {
+		return nil
+	}
+	if int(float64(n-1)*math.Log2(float64(enc.base))/8) > enc.maxDecoded {
+		return &LimitError{Limit: int64(enc.maxDecoded)}
+	}
+	return nil
+}
+
+// reject decoded if it exceeds the decoded limit, counting padded
+// results at their PadSize bytes rather than the zero digits
+func (enc *Encoding) checkDecodedLen(decoded []byte) error {
+	n := len(decoded)
+	if enc.padWidth > 0 {
+		n = max(len(trimLeadingZeros(decoded)), enc.padSize)
+	}
+	if enc.maxDecoded > 0 && n > enc.maxDecoded {
+		enc.release(decoded)
+		return &LimitError{Limit: int64(enc.maxDecoded)}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package base58_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestMaxInputLen(t *testing.T) {
+	enc := base58.StdEncoding.WithMaxInputLen(8)
+	if _, err := enc.DecodeString("StV1DL6C"); err != nil {
+		t.Errorf("DecodeString at limit: %v", err)
+	}
+	if _, err := enc.DecodeString("StV1DL6CW"); !errors.Is(err, base58.ErrInvalidLength) {
+		t.Errorf("DecodeString over limit: got %v, want ErrInvalidLength", err)
+	}
+	if _, _, err := enc.DecodePrefix([]byte("StV1DL6CW")); !errors.Is(err, base58.ErrInvalidLength) {
+		t.Errorf("DecodePrefix over limit: got %v, want ErrInvalidLength", err)
+	}
+	if err := enc.Validate("StV1DL6CW"); !errors.Is(err, base58.ErrInvalidLength) {
+		t.Errorf("Validate over limit: got %v, want ErrInvalidLength", err)
+	}
+	testEqual(t, "MaxInputLen() = %v, want %v", 8, enc.MaxInputLen())
+	testEqual(t, "MaxInputLen() = %v, want %v", 0, enc.WithMaxInputLen(0).MaxInputLen())
+}
+
+func TestMaxDecodedLen(t *testing.T) {
+	enc := base58.StdEncoding.WithMaxDecodedLen(4)
+	for _, tt := range []struct {
+		s    string
+		want []byte
+	}{
+		{"7YXq9G", []byte{0xff, 0xff, 0xff, 0xff}},
+		{"1111", []byte{0, 0, 0, 0}},
+		{"11112", []byte{0, 0, 0, 0, 1}},
+		{"7YXq9H", []byte{1, 0, 0, 0, 0}},
+		{strings.Repeat("z", 1000), nil},
+	} {
+		got, err := enc.DecodeString(tt.s)
+		if tt.want == nil || len(tt.want) > 4 {
+			var lerr *base58.LimitError
+			if !errors.As(err, &lerr) || lerr.Limit != 4 {
+				t.Errorf("DecodeString(%q): got %v, want LimitError", tt.s, err)
+			}
+			continue
+		}
+		if err != nil || !bytes.Equal(got, tt.want) {
+			t.Errorf("DecodeString(%q): got %x, %v, want %x", tt.s, got, err, tt.want)
+		}
+	}
+
+	// padded encodings count their PadSize bytes, not the zero digits
+	padded := base58.StdEncoding.WithPadWidth(10).WithMaxDecodedLen(7)
+	if got, err := padded.DecodeString("111111111z"); err != nil || !bytes.Equal(got, []byte{0, 0, 0, 0, 0, 0, 57}) {
+		t.Errorf("padded DecodeString: got %x, %v", got, err)
+	}
+	var plerr *base58.LimitError
+	if _, err := padded.WithMaxDecodedLen(6).DecodeString("111111111z"); !errors.As(err, &plerr) {
+		t.Errorf("padded DecodeString over limit: got %v, want LimitError", err)
+	}
+
+	var lerr *base58.LimitError
+	_, err := io.ReadAll(base58.NewDecoder(enc, strings.NewReader("7YXq9H")))
+	if !errors.As(err, &lerr) {
+		t.Errorf("NewDecoder over limit: got %v, want LimitError", err)
+	}
+	testEqual(t, "MaxDecodedLen() = %v, want %v", 4, enc.MaxDecodedLen())
+}
+
+func TestDecodeStringContext(t *testing.T) {
+	s := base58.StdEncoding.EncodeToString([]byte("hello world"))
+	got, err := base58.StdEncoding.DecodeStringContext(context.Background(), s)
+	if err != nil || string(got) != "hello world" {
+		t.Errorf("DecodeStringContext: got %q, %v", got, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := base58.StdEncoding.DecodeStringContext(ctx, s); !errors.Is(err, context.Canceled) {
+		t.Errorf("DecodeStringContext cancelled: got %v, want context.Canceled", err)
+	}
+
+	// long enough that the deadline passes during the conversion
+	ctx, cancel = context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	long := strings.Repeat("z", 200000)
+	start := time.Now()
+	if _, err := base58.StdEncoding.DecodeStringContext(ctx, long); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("DecodeStringContext past deadline: got %v, want context.DeadlineExceeded", err)
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Errorf("DecodeStringContext took %v after the deadline", d)
+	}
+}
@@ -0,0 +1,176 @@
+package base58
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strconv"
+)
+
+/*
+Line-Oriented Decoding
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+LineDecoder decodes newline-delimited base58 values, such as hash or address
+lists, one record per line. Surrounding whitespace and CR are trimmed and
+blank lines skipped. What happens to a line that fails to decode is set by
+the error policy: skip it, collect its *LineError for Errors and Err, or
+abort. Records are delivered scanner-style, through a callback or through a
+channel:
+
+	d := base58.NewLineDecoder(base58.StdEncoding, f)
+	d.SetErrorPolicy(base58.CollectLineErrors)
+	for d.Scan() {
+		rec := d.Record()
+		fmt.Println(rec.Line, hex.EncodeToString(rec.Data))
+	}
+	for _, e := range d.Errors() {
+		log.Println(e)
+	}
+*/
+
+// longest line a LineDecoder or transcoder accepts
+const MaxLineLen = 1 << 20
+
+// what a LineDecoder does with a line that fails to decode
+type LineErrorPolicy int
+
+const (
+	SkipLineErrors    LineErrorPolicy = iota // drop the line
+	CollectLineErrors                        // drop the line and record its error
+	AbortOnLineError                         // stop with the line's error
+)
+
+// decoded line
+type Record struct {
+	Line int    // line number, counting from 1
+	Text string // the trimmed line
+	Data []byte // decoded bytes
+}
+
+// line that failed to decode
+type LineError struct {
+	Line int
+	Text string
+	Err  error
+}
+
+func (e *LineError) Error() string {
+	return "base58: line " + strconv.Itoa(e.Line) + ": " + e.Err.Error()
+}
+
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
+// decoder of newline-delimited base58 values
+type LineDecoder struct {
+	enc    *Encoding
+	s      *bufio.Scanner
+	policy LineErrorPolicy
+	line   int
+	rec    Record
+	errs   []*LineError
+	err    error
+	done   bool
+}
+
+// return a decoder reading lines from r with the SkipLineErrors policy
+func NewLineDecoder(enc *Encoding, r io.Reader) *LineDecoder {
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 0, 4096), MaxLineLen)
+	return &LineDecoder{enc: enc, s: s}
+}
+
+// set the policy for lines that fail to decode, before the first Scan
+func (d *LineDecoder) SetErrorPolicy(p LineErrorPolicy) {
+	d.policy = p
+}
+
+// advance to the next decoded record, returning false at the end of the
+// input, on a read error or on a failed line with AbortOnLineError
+func (d *LineDecoder) Scan() bool {
+	for !d.done && d.s.Scan() {
+		d.line++
+		line := bytes.TrimSpace(d.s.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		data, err := d.enc.DecodeToBytes(line)
+		if err != nil {
+			lerr := &LineError{Line: d.line, Text: string(line), Err: err}
+			switch d.policy {
+			case CollectLineErrors:
+				d.errs = append(d.errs, lerr)
+			case AbortOnLineError:
+				d.err = lerr
+				d.done = true
+			}
+			continue
+		}
+		d.rec = Record{Line: d.line, Text: string(line), Data: data}
+		return true
+	}
+	if !d.done {
+		d.done = true
+		d.err = d.s.Err()
+	}
+	return false
+}
+
+// return the most recent record
+func (d *LineDecoder) Record() Record {
+	return d.rec
+}
+
+// return the failed lines recorded with CollectLineErrors
+func (d *LineDecoder) Errors() []*LineError {
+	return d.errs
+}
+
+// return the read error, the line error that aborted decoding, or with
+// CollectLineErrors the joined errors of all failed lines
+func (d *LineDecoder) Err() error {
+	if d.err != nil || len(d.errs) == 0 {
+		return d.err
+	}
+	errs := make([]error, len(d.errs))
+	for i, e := range d.errs {
+		errs[i] = e
+	}
+	return errors.Join(errs...)
+}
+
+// call fn for every record, stopping early with the first error fn
+// returns, and return that error or Err
+func (d *LineDecoder) Each(fn func(Record) error) error {
+	for d.Scan() {
+		if err := fn(d.Record()); err != nil {
+			return err
+		}
+	}
+	return d.Err()
+}
+
+// deliver the records on a channel that is closed at the end of the input
+// or once ctx is done; Err is valid after the channel is closed and
+// returns ctx.Err() if ctx ended decoding
+func (d *LineDecoder) Chan(ctx context.Context) <-chan Record {
+	c := make(chan Record)
+	go func() {
+		defer close(c)
+		for d.Scan() {
+			select {
+			case c <- d.Record():
+			case <-ctx.Done():
+				d.err = ctx.Err()
+				return
+			}
+		}
+	}()
+	return c
+}
@@ -0,0 +1,117 @@
+package base58_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+const lineInput = "2NEpo7TZRRrLZSi2U\r\n\n  1112 \nnot-base58\n3yZe7d\n0OIl\n"
+
+func TestLineDecoder(t *testing.T) {
+	tests := []struct {
+		policy base58.LineErrorPolicy
+		lines  []int
+		errs   []int
+	}{
+		{base58.SkipLineErrors, []int{1, 3, 5}, nil},
+		{base58.CollectLineErrors, []int{1, 3, 5}, []int{4, 6}},
+		{base58.AbortOnLineError, []int{1, 3}, nil},
+	}
+	for _, tt := range tests {
+		d := base58.NewLineDecoder(base58.StdEncoding, strings.NewReader(lineInput))
+		d.SetErrorPolicy(tt.policy)
+		var lines []int
+		for d.Scan() {
+			rec := d.Record()
+			want, _ := base58.StdEncoding.DecodeString(rec.Text)
+			if string(rec.Data) != string(want) {
+				t.Errorf("policy %d line %d: got %x, want %x", tt.policy, rec.Line, rec.Data, want)
+			}
+			lines = append(lines, rec.Line)
+		}
+		testEqual(t, "records = %v, want %v", fmt.Sprint(tt.lines), fmt.Sprint(lines))
+
+		var errLines []int
+		for _, e := range d.Errors() {
+			errLines = append(errLines, e.Line)
+		}
+		testEqual(t, "errors = %v, want %v", fmt.Sprint(tt.errs), fmt.Sprint(errLines))
+
+		err := d.Err()
+		switch tt.policy {
+		case base58.SkipLineErrors:
+			testEqual(t, "Err() = %v, want %v", nil, err)
+		case base58.CollectLineErrors:
+			if !errors.Is(err, d.Errors()[0]) || !errors.Is(err, d.Errors()[1]) {
+				t.Errorf("Err() = %v, want both line errors", err)
+			}
+		case base58.AbortOnLineError:
+			var lerr *base58.LineError
+			if !errors.As(err, &lerr) || lerr.Line != 4 || lerr.Text != "not-base58" {
+				t.Errorf("Err() = %v, want line 4 error", err)
+			}
+			testEqual(t, "Error() = %q, want %q", "base58: line 4: "+lerr.Err.Error(), err.Error())
+		}
+	}
+}
+
+func TestLineDecoderEach(t *testing.T) {
+	d := base58.NewLineDecoder(base58.StdEncoding, strings.NewReader(lineInput))
+	var n int
+	if err := d.Each(func(base58.Record) error { n++; return nil }); err != nil {
+		t.Errorf("Each: %v", err)
+	}
+	testEqual(t, "Each records = %d, want %d", 3, n)
+
+	stop := errors.New("stop")
+	d = base58.NewLineDecoder(base58.StdEncoding, strings.NewReader(lineInput))
+	if err := d.Each(func(base58.Record) error { return stop }); err != stop {
+		t.Errorf("Each with callback error: got %v, want %v", err, stop)
+	}
+}
+
+func TestLineDecoderChan(t *testing.T) {
+	d := base58.NewLineDecoder(base58.StdEncoding, strings.NewReader(lineInput))
+	var texts []string
+	for rec := range d.Chan(context.Background()) {
+		texts = append(texts, rec.Text)
+	}
+	testEqual(t, "Chan records = %q, want %q", "2NEpo7TZRRrLZSi2U 1112 3yZe7d", strings.Join(texts, " "))
+	testEqual(t, "Err() = %v, want %v", nil, d.Err())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	d = base58.NewLineDecoder(base58.StdEncoding, strings.NewReader(strings.Repeat("2g\n", 100)))
+	c := d.Chan(ctx)
+	<-c
+	cancel()
+	for range c {
+	}
+	testEqual(t, "canceled Err() = %v, want %v", context.Canceled, d.Err())
+}
+
+func TestLineDecoderReadError(t *testing.T) {
+	d := base58.NewLineDecoder(base58.StdEncoding, io.MultiReader(strings.NewReader("2g\n"), iotestErrReader{}))
+	var n int
+	for d.Scan() {
+		n++
+	}
+	testEqual(t, "records before read error = %d, want %d", 1, n)
+	testEqual(t, "Err() = %v, want %v", errTestRead, d.Err())
+
+	d = base58.NewLineDecoder(base58.StdEncoding, strings.NewReader(strings.Repeat("z", base58.MaxLineLen+1)))
+	if d.Scan() || d.Err() == nil {
+		t.Errorf("line longer than MaxLineLen: got no error")
+	}
+}
+
+var errTestRead = errors.New("read failed")
+
+type iotestErrReader struct{}
+
+func (iotestErrReader) Read([]byte) (int, error) { return 0, errTestRead }
@@ -0,0 +1,361 @@
+package base58
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+/*
+Encoding Serialization
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Encoding implements encoding.TextMarshaler and encoding.BinaryMarshaler so
+custom alphabets and options can live in config files or travel over the
+wire. The text form is a predefined encoding name or a quoted alphabet,
+followed by the non-default options:
+
+	bitcoin
+	flickr wrap=76 lenient
+	alphabet="0123456789abcdefghijklmnopqrstuvwxyz" pad=12 sep="-" group=4
+	bitcoin maxinput=128 maxdecoded=64
+	gmp littleendian
+
+Encodings with an invalid character handler or validators cannot be
+serialized.
+*/
+
+// serialized encoding is malformed or describes an invalid encoding
+var ErrInvalidEncoding = errors.New("base58: invalid encoding description")
+
+// version byte of the binary form
+const encodingBinaryVersion = 1
+
+// describe enc as text, implementing encoding.TextMarshaler
+func (enc *Encoding) MarshalText() ([]byte, error) {
+	if enc.onInvalid != nil {
+		return nil, fmt.Errorf("%w: invalid character handler cannot be serialized", ErrInvalidEncoding)
+	}
+	if enc.HasValidators() {
+		return nil, fmt.Errorf("%w: validators cannot be serialized", ErrInvalidEncoding)
+	}
+	var b []byte
+	if enc.name != "" {
+		b = append(b, enc.name...)
+	} else {
+		b = append(b, "alphabet="...)
+		b = strconv.AppendQuote(b, enc.Alphabet())
+	}
+	if enc.padWidth > 0 {
+		b = append(b, " pad="...)
+		b = strconv.AppendInt(b, int64(enc.padWidth), 10)
+	}
+	if enc.groupSize > 0 {
+		b = append(b, " sep="...)
+		b = strconv.AppendQuote(b, string(enc.sep))
+		b = append(b, " group="...)
+		b = strconv.AppendInt(b, int64(enc.groupSize), 10)
+	}
+	if enc.hasIgnore {
+		b = append(b, " ignore="...)
+		b = strconv.AppendQuote(b, enc.ignoreChars())
+	}
+	if enc.lineLen > 0 {
+		b = append(b, " wrap="...)
+		b = strconv.AppendInt(b, int64(enc.lineLen), 10)
+	}
+	if enc.lenient {
+		b = append(b, " lenient"...)
+	}
+	if enc.zero != 0 {
+		b = append(b, " zero="...)
+		b = strconv.AppendQuote(b, string(enc.zero))
+	}
+	if enc.secure {
+		b = append(b, " secure"...)
+	}
+	if enc.littleEndian {
+		b = append(b, " littleendian"...)
+	}
+	if enc.maxInput > 0 {
+		b = append(b, " maxinput="...)
+		b = strconv.AppendInt(b, int64(enc.maxInput), 10)
+	}
+	if enc.maxDecoded > 0 {
+		b = append(b, " maxdecoded="...)
+		b = strconv.AppendInt(b, int64(enc.maxDecoded), 10)
+	}
+	return b, nil
+}
+
+// rebuild enc from its text form, implementing encoding.TextUnmarshaler
+func (enc *Encoding) UnmarshalText(text []byte) (err error) {
+	fields, err := splitEncodingText(string(text))
+	if err != nil {
+		return err
+	}
+	if len(fields) == 0 {
+		return fmt.Errorf("%w: empty", ErrInvalidEncoding)
+	}
+	// the With* options panic on invalid values, as they do for constants
+	// in code; report those as errors here
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: %v", ErrInvalidEncoding, r)
+		}
+	}()
+
+	var e *Encoding
+	switch base := fields[0]; {
+	case base.key == "alphabet" && base.quoted:
+		if len(base.value) == 58 {
+			e = NewEncoding(base.value)
+		} else {
+			e = NewRadixEncoding(base.value)
+		}
+	case !base.hasValue:
+		for _, r := range Encodings() {
+			if r.name != "" && r.name == base.key {
+				e = r
+				break
+			}
+		}
+		if e == nil {
+			return fmt.Errorf("%w: unknown encoding %q", ErrInvalidEncoding, base.key)
+		}
+	default:
+		return fmt.Errorf("%w: missing encoding name or alphabet", ErrInvalidEncoding)
+	}
+
+	sep, group := byte(0), 0
+	for _, f := range fields[1:] {
+		switch {
+		case f.key == "lenient" && !f.hasValue:
+			e = e.Lenient()
+		case f.key == "secure" && !f.hasValue:
+			e = e.Secure()
+		case f.key == "littleendian" && !f.hasValue:
+			e = e.LittleEndian()
+		case f.key == "pad" || f.key == "group" || f.key == "wrap" ||
+			f.key == "maxinput" || f.key == "maxdecoded":
+			n, err := strconv.Atoi(f.value)
+			if err != nil || f.quoted {
+				return fmt.Errorf("%w: bad %s value %q", ErrInvalidEncoding, f.key, f.value)
+			}
+			switch f.key {
+			case "pad":
+				e = e.WithPadWidth(n)
+			case "group":
+				group = n
+			case "wrap":
+				e = e.WithWrap(n)
+			case "maxinput":
+				e = e.WithMaxInputLen(n)
+			case "maxdecoded":
+				e = e.WithMaxDecodedLen(n)
+			}
+		case f.key == "sep" && f.quoted && len(f.value) == 1:
+			sep = f.value[0]
+		case f.key == "ignore" && f.quoted:
+			e = e.WithIgnore(f.value)
+		case f.key == "zero" && f.quoted && len(f.value) == 1:
+			e = e.WithZeroDigit(f.value[0])
+		default:
+			return fmt.Errorf("%w: bad option %q", ErrInvalidEncoding, f.key)
+		}
+	}
+	if group > 0 {
+		e = e.WithSeparator(sep, group)
+	}
+	*enc = *e
+	return nil
+}
+
+// key[=value] field of the text form
+type encodingField struct {
+	key, value string
+	hasValue   bool
+	quoted     bool
+}
+
+// split the text form into space-separated fields, values may be Go
+// quoted strings
+func splitEncodingText(s string) ([]encodingField, error) {
+	var fields []encodingField
+	for {
+		s = strings.TrimLeft(s, " \t\r\n")
+		if s == "" {
+			return fields, nil
+		}
+		var f encodingField
+		end := strings.IndexAny(s, "= \t\r\n")
+		if end < 0 {
+			end = len(s)
+		}
+		f.key, s = s[:end], s[end:]
+		if strings.HasPrefix(s, "=") {
+			f.hasValue = true
+			s = s[1:]
+			if strings.HasPrefix(s, `"`) {
+				q, err := strconv.QuotedPrefix(s)
+				if err != nil {
+					return nil, fmt.Errorf("%w: bad quoted %s value", ErrInvalidEncoding, f.key)
+				}
+				f.value, _ = strconv.Unquote(q)
+				f.quoted = true
+				s = s[len(q):]
+			} else {
+				end = strings.IndexAny(s, " \t\r\n")
+				if end < 0 {
+					end = len(s)
+				}
+				f.value, s = s[:end], s[end:]
+			}
+		}
+		if f.key == "" {
+			return nil, fmt.Errorf("%w: missing key", ErrInvalidEncoding)
+		}
+		fields = append(fields, f)
+	}
+}
+
+// return the characters added with WithIgnore, in byte order
+func (enc *Encoding) ignoreChars() string {
+	var b []byte
+	for c, ok := range enc.ignore {
+		if ok {
+			b = append(b, byte(c))
+		}
+	}
+	return string(b)
+}
+
+// describe enc in a compact binary form, implementing
+// encoding.BinaryMarshaler
+func (enc *Encoding) MarshalBinary() ([]byte, error) {
+	if enc.onInvalid != nil {
+		return nil, fmt.Errorf("%w: invalid character handler cannot be serialized", ErrInvalidEncoding)
+	}
+	if enc.HasValidators() {
+		return nil, fmt.Errorf("%w: validators cannot be serialized", ErrInvalidEncoding)
+	}
+	b := []byte{encodingBinaryVersion}
+	b = appendBinaryString(b, enc.name)
+	b = appendBinaryString(b, enc.Alphabet())
+	b = binary.AppendUvarint(b, uint64(enc.padWidth))
+	b = append(b, enc.sep)
+	b = binary.AppendUvarint(b, uint64(enc.groupSize))
+	b = appendBinaryString(b, enc.ignoreChars())
+	b = binary.AppendUvarint(b, uint64(enc.lineLen))
+	var flags byte
+	if enc.lenient {
+		flags |= 1
+	}
+	if enc.secure {
+		flags |= 2
+	}
+	if enc.littleEndian {
+		flags |= 4
+	}
+	b = append(b, flags, enc.zero)
+	b = binary.AppendUvarint(b, uint64(enc.maxInput))
+	return binary.AppendUvarint(b, uint64(enc.maxDecoded)), nil
+}
+
+// rebuild enc from its binary form, implementing
+// encoding.BinaryUnmarshaler
+func (enc *Encoding) UnmarshalBinary(data []byte) (err error) {
+	r := binaryReader{data: data}
+	if r.byte() != encodingBinaryVersion {
+		return fmt.Errorf("%w: unsupported binary version", ErrInvalidEncoding)
+	}
+	name, alphabet := r.string(), r.string()
+	pad := r.uvarint()
+	sep := r.byte()
+	group := r.uvarint()
+	ignore := r.string()
+	lineLen := r.uvarint()
+	flags, zero := r.byte(), r.byte()
+	maxInput, maxDecoded := r.uvarint(), r.uvarint()
+	if r.err || len(r.data) > 0 || flags > 7 {
+		return fmt.Errorf("%w: malformed binary form", ErrInvalidEncoding)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: %v", ErrInvalidEncoding, r)
+		}
+	}()
+	var e *Encoding
+	if len(alphabet) == 58 {
+		e = NewEncoding(alphabet)
+	} else {
+		e = NewRadixEncoding(alphabet)
+	}
+	e.name = name
+	e = e.WithPadWidth(pad).WithSeparator(sep, group).WithWrap(lineLen)
+	if ignore != "" {
+		e = e.WithIgnore(ignore)
+	}
+	if flags&1 != 0 {
+		e = e.Lenient()
+	}
+	if flags&2 != 0 {
+		e = e.Secure()
+	}
+	if flags&4 != 0 {
+		e = e.LittleEndian()
+	}
+	if zero != 0 {
+		e = e.WithZeroDigit(zero)
+	}
+	e = e.WithMaxInputLen(maxInput).WithMaxDecodedLen(maxDecoded)
+	*enc = *e
+	return nil
+}
+
+func appendBinaryString(b []byte, s string) []byte {
+	b = binary.AppendUvarint(b, uint64(len(s)))
+	return append(b, s...)
+}
+
+// sequential reader over the binary form, err is set on truncated input
+type binaryReader struct {
+	data []byte
+	err  bool
+}
+
+func (r *binaryReader) byte() byte {
+	if len(r.data) == 0 {
+		r.err = true
+		return 0
+	}
+	c := r.data[0]
+	r.data = r.data[1:]
+	return c
+}
+
+// read a uvarint that fits in an int
+func (r *binaryReader) uvarint() int {
+	v, n := binary.Uvarint(r.data)
+	if n <= 0 || v > 1<<31-1 {
+		r.err = true
+		return 0
+	}
+	r.data = r.data[n:]
+	return int(v)
+}
+
+func (r *binaryReader) string() string {
+	n := r.uvarint()
+	if r.err || n > len(r.data) {
+		r.err = true
+		return ""
+	}
+	s := string(r.data[:n])
+	r.data = r.data[n:]
+	return s
+}
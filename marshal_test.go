@@ -0,0 +1,106 @@
+package base58_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestEncodingMarshal(t *testing.T) {
+	custom58 := base58.NewEncoding("\x01" + base58.BitcoinAlphabet[1:])
+	tests := []struct {
+		enc  *base58.Encoding
+		text string
+	}{
+		{base58.StdEncoding, "bitcoin"},
+		{base58.GMPEncoding, "gmp"},
+		{base58.FlickrEncoding.WithWrap(76).Lenient(), "flickr wrap=76 lenient"},
+		{base58.StdEncoding.Secure(), "bitcoin secure"},
+		{base58.StdEncoding.WithMaxInputLen(128).WithMaxDecodedLen(64), "bitcoin maxinput=128 maxdecoded=64"},
+		{base58.StdEncoding.WithPadWidth(12).WithSeparator('-', 4), `bitcoin pad=12 sep="-" group=4`},
+		{base58.RippleEncoding.WithIgnore(" \n").WithZeroDigit('_'), `ripple ignore="\n " zero="_"`},
+		{base36Encoding.WithSeparator(' ', 5), `alphabet="0123456789abcdefghijklmnopqrstuvwxyz" sep=" " group=5`},
+		{custom58, `alphabet="\x0123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"`},
+	}
+	for _, tt := range tests {
+		text, err := tt.enc.MarshalText()
+		if err != nil {
+			t.Fatalf("%v: MarshalText: %v", tt.enc, err)
+		}
+		testEqual(t, "MarshalText: got %q, want %q", tt.text, string(text))
+		var got base58.Encoding
+		if err := got.UnmarshalText(text); err != nil || !got.Equal(tt.enc) {
+			t.Errorf("UnmarshalText(%q): got %v, %v", text, &got, err)
+		}
+		testEqual(t, "UnmarshalText name: got %q, want %q", tt.enc.Name(), got.Name())
+
+		bin, err := tt.enc.MarshalBinary()
+		if err != nil {
+			t.Fatalf("%v: MarshalBinary: %v", tt.enc, err)
+		}
+		got = base58.Encoding{}
+		if err := got.UnmarshalBinary(bin); err != nil || !got.Equal(tt.enc) || got.Name() != tt.enc.Name() {
+			t.Errorf("UnmarshalBinary(%x): got %v, %v", bin, &got, err)
+		}
+		for i := range bin {
+			if err := got.UnmarshalBinary(bin[:i]); !errors.Is(err, base58.ErrInvalidEncoding) {
+				t.Errorf("UnmarshalBinary truncated to %d bytes: got %v", i, err)
+			}
+		}
+	}
+}
+
+func TestEncodingMarshalConfig(t *testing.T) {
+	var config struct {
+		IDs  *base58.Encoding `json:"ids"`
+		Keys *base58.Encoding `json:"keys"`
+	}
+	data := `{"ids":"flickr pad=11","keys":"alphabet=\"0123456789abcdefghijklmnopqrstuvwxyz\""}`
+	if err := json.Unmarshal([]byte(data), &config); err != nil {
+		t.Fatal(err)
+	}
+	if !config.IDs.Equal(base58.FlickrEncoding.WithPadWidth(11)) || !config.Keys.Equal(base36Encoding) {
+		t.Errorf("json.Unmarshal: got %v, %v", config.IDs, config.Keys)
+	}
+	out, err := json.Marshal(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testEqual(t, "json.Marshal: got %s, want %s", data, string(out))
+}
+
+func TestEncodingUnmarshalErrors(t *testing.T) {
+	for _, text := range []string{
+		"",
+		"bitcoinx",
+		"bitcoin pad=-1",
+		"bitcoin pad=x",
+		`bitcoin pad="3"`,
+		"bitcoin unknown=1",
+		`bitcoin sep="1" group=4`,
+		`bitcoin ignore="a"`,
+		`bitcoin zero="1x"`,
+		`alphabet="aa"`,
+		`alphabet="abc`,
+		"alphabet=abc",
+		"=bitcoin",
+	} {
+		var enc base58.Encoding
+		if err := enc.UnmarshalText([]byte(text)); !errors.Is(err, base58.ErrInvalidEncoding) {
+			t.Errorf("UnmarshalText(%q): got %v, want ErrInvalidEncoding", text, err)
+		}
+	}
+	handler := base58.StdEncoding.WithInvalidHandler(func(byte, int) (byte, bool, error) { return 0, true, nil })
+	if _, err := handler.MarshalText(); !errors.Is(err, base58.ErrInvalidEncoding) {
+		t.Errorf("MarshalText with handler: got %v", err)
+	}
+	if _, err := handler.MarshalBinary(); !errors.Is(err, base58.ErrInvalidEncoding) {
+		t.Errorf("MarshalBinary with handler: got %v", err)
+	}
+	var enc base58.Encoding
+	if err := enc.UnmarshalBinary([]byte{2}); !errors.Is(err, base58.ErrInvalidEncoding) {
+		t.Errorf("UnmarshalBinary version 2: got %v", err)
+	}
+}
@@ -0,0 +1,185 @@
+package base58
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"sync"
+	"sync/atomic"
+)
+
+/*
+Instrumentation
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+An Encoding can report every encode, decode and failure to an
+Instrumentation hook, e.g. to feed Prometheus counters. Counters is a ready
+implementation that also serves as an expvar variable:
+
+	enc := base58.StdEncoding.WithInstrumentation(base58.PublishCounters("base58"))
+
+Encode, EncodeToBytes, EncodeToString, Decode, DecodeToBytes, DecodeString,
+DecodeStringContext, DecodeInto, DecodePrefix, the stream encoder and
+decoder and the check encodings built on the Encoding are covered. Hooks are
+called synchronously and may be called concurrently.
+*/
+
+// error categories passed to Instrumentation.Failed
+const (
+	ErrorInvalidCharacter = "invalid_character"
+	ErrorInvalidLength    = "invalid_length"
+	ErrorLimit            = "limit"
+	ErrorChecksum         = "checksum"
+	ErrorInvalidFormat    = "invalid_format"
+	ErrorCanceled         = "canceled"
+	ErrorValidation       = "validation"
+	ErrorOther            = "other"
+)
+
+// receiver of encoding events
+type Instrumentation interface {
+	Encoded(n int)                 // n input bytes were encoded
+	Decoded(n int)                 // a decode produced n bytes
+	Failed(kind string, err error) // a decode failed, kind is one of the Error* categories
+}
+
+// return a copy of enc that reports to ins, nil disables reporting.
+// Instrumentation does not change the encoding, so Equal ignores it and it
+// is not serialized.
+func (enc *Encoding) WithInstrumentation(ins Instrumentation) *Encoding {
+	e := *enc
+	e.instr = ins
+	return &e
+}
+
+// return the instrumentation of enc, nil if none
+func (enc *Encoding) Instrumentation() Instrumentation {
+	return enc.instr
+}
+
+// report an encode of n bytes
+func (enc *Encoding) observeEncode(n int) {
+	if enc.instr != nil {
+		enc.instr.Encoded(n)
+	}
+}
+
+// report the outcome of a decode and pass it through
+func (enc *Encoding) observeDecode(decoded []byte, err error) ([]byte, error) {
+	if enc.instr != nil {
+		if err != nil {
+			enc.instr.Failed(ErrorKind(err), err)
+		} else {
+			enc.instr.Decoded(len(decoded))
+		}
+	}
+	return decoded, err
+}
+
+// report a failure after a successful decode, e.g. a checksum mismatch,
+// and pass it through
+func (enc *Encoding) observeFailure(err error) error {
+	if enc.instr != nil {
+		enc.instr.Failed(ErrorKind(err), err)
+	}
+	return err
+}
+
+// return the Error* category of err
+func ErrorKind(err error) string {
+	var corrupt CorruptInputError
+	var limit *LimitError
+	switch {
+	case errors.Is(err, ErrValidation):
+		return ErrorValidation
+	case errors.Is(err, ErrChecksumMismatch):
+		return ErrorChecksum
+	case errors.Is(err, ErrInvalidFormat):
+		return ErrorInvalidFormat
+	case errors.Is(err, errInvalidCharacter) || errors.As(err, &corrupt):
+		return ErrorInvalidCharacter
+	case errors.As(err, &limit):
+		return ErrorLimit
+	case errors.Is(err, ErrInvalidLength) || errors.Is(err, ErrShortBuffer):
+		return ErrorInvalidLength
+	case errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded):
+		return ErrorCanceled
+	}
+	return ErrorOther
+}
+
+// Instrumentation keeping totals, safe for concurrent use. Its String
+// method returns the totals as JSON, so it can be published with expvar.
+type Counters struct {
+	encodeCalls  atomic.Int64
+	encodedBytes atomic.Int64
+	decodeCalls  atomic.Int64
+	decodedBytes atomic.Int64
+
+	mu     sync.Mutex
+	errors map[string]int64
+}
+
+// totals of a Counters
+type CounterSnapshot struct {
+	EncodeCalls      int64            `json:"encode_calls"`
+	EncodedBytes     int64            `json:"encoded_bytes"`
+	DecodeCalls      int64            `json:"decode_calls"`
+	DecodedBytes     int64            `json:"decoded_bytes"`
+	Errors           map[string]int64 `json:"errors"`
+	ChecksumFailures int64            `json:"checksum_failures"`
+}
+
+// return new Counters published with expvar under name. Panics if name is
+// already published, as expvar.Publish does.
+func PublishCounters(name string) *Counters {
+	c := new(Counters)
+	expvar.Publish(name, c)
+	return c
+}
+
+func (c *Counters) Encoded(n int) {
+	c.encodeCalls.Add(1)
+	c.encodedBytes.Add(int64(n))
+}
+
+func (c *Counters) Decoded(n int) {
+	c.decodeCalls.Add(1)
+	c.decodedBytes.Add(int64(n))
+}
+
+func (c *Counters) Failed(kind string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.errors == nil {
+		c.errors = make(map[string]int64)
+	}
+	c.errors[kind]++
+}
+
+// return the current totals
+func (c *Counters) Snapshot() CounterSnapshot {
+	c.mu.Lock()
+	errs := make(map[string]int64, len(c.errors))
+	for k, v := range c.errors {
+		errs[k] = v
+	}
+	c.mu.Unlock()
+	return CounterSnapshot{
+		EncodeCalls:      c.encodeCalls.Load(),
+		EncodedBytes:     c.encodedBytes.Load(),
+		DecodeCalls:      c.decodeCalls.Load(),
+		DecodedBytes:     c.decodedBytes.Load(),
+		Errors:           errs,
+		ChecksumFailures: errs[ErrorChecksum],
+	}
+}
+
+// return the totals as JSON, implementing expvar.Var
+func (c *Counters) String() string {
+	b, _ := json.Marshal(c.Snapshot())
+	return string(b)
+}
@@ -0,0 +1,85 @@
+package base58_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestInstrumentation(t *testing.T) {
+	c := new(base58.Counters)
+	enc := base58.StdEncoding.WithInstrumentation(c)
+	if enc.Instrumentation() != c || !enc.Equal(base58.StdEncoding) {
+		t.Fatal("WithInstrumentation: hook not set or encoding changed")
+	}
+
+	s := enc.EncodeToString([]byte("hello world"))
+	enc.DecodeString(s)
+	enc.DecodeString("0")
+	enc.WithMaxDecodedLen(2).DecodeString(s)
+	dst := make([]byte, 32)
+	enc.DecodeInto(dst, []byte(s))
+	io.ReadAll(base58.NewDecoder(enc, strings.NewReader(s)))
+
+	ce := base58.NewCheckEncoding(enc, base58.NewDoubleSHA256, 4)
+	checked := ce.EncodeToString([]byte("payload"))
+	ce.DecodeString(checked)
+	ce.DecodeString(s)
+
+	got := c.Snapshot()
+	want := base58.CounterSnapshot{
+		EncodeCalls:  2,
+		EncodedBytes: 11 + 11,
+		DecodeCalls:  5,
+		DecodedBytes: 11 + 11 + 11 + 11 + 11,
+		Errors: map[string]int64{
+			base58.ErrorInvalidCharacter: 1,
+			base58.ErrorLimit:            1,
+			base58.ErrorChecksum:         1,
+		},
+		ChecksumFailures: 1,
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("Snapshot: got %+v, want %+v", got, want)
+	}
+
+	var decoded base58.CounterSnapshot
+	if err := json.Unmarshal([]byte(c.String()), &decoded); err != nil || decoded.DecodeCalls != 5 {
+		t.Errorf("String: got %s, %v", c.String(), err)
+	}
+}
+
+func TestErrorKind(t *testing.T) {
+	for _, tt := range []struct {
+		err  error
+		want string
+	}{
+		{base58.CorruptInputError(3), base58.ErrorInvalidCharacter},
+		{fmt.Errorf("%w: got 3", base58.ErrInvalidLength), base58.ErrorInvalidLength},
+		{base58.ErrShortBuffer, base58.ErrorInvalidLength},
+		{&base58.LimitError{Limit: 4}, base58.ErrorLimit},
+		{base58.ErrChecksumMismatch, base58.ErrorChecksum},
+		{base58.ErrInvalidFormat, base58.ErrorInvalidFormat},
+		{context.Canceled, base58.ErrorCanceled},
+		{errors.New("boom"), base58.ErrorOther},
+	} {
+		testEqual(t, "ErrorKind = %q, want %q", tt.want, base58.ErrorKind(tt.err))
+	}
+	_, err := base58.StdEncoding.DecodeString("0")
+	testEqual(t, "ErrorKind(DecodeString error) = %q, want %q", base58.ErrorInvalidCharacter, base58.ErrorKind(err))
+}
+
+func TestPublishCounters(t *testing.T) {
+	c := base58.PublishCounters("base58_test")
+	base58.StdEncoding.WithInstrumentation(c).EncodeToString([]byte{1, 2, 3})
+	if v := expvar.Get("base58_test"); v == nil || !strings.Contains(v.String(), `"encoded_bytes":3`) {
+		t.Errorf("expvar: got %v", v)
+	}
+}
@@ -0,0 +1,157 @@
+package base58
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/bits"
+)
+
+/*
+Monero Block Base58
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Monero splits the input into 8-byte blocks and encodes each block as a fixed
+11-character group, left-padded with the first alphabet character. A final
+partial block of n bytes is encoded into moneroEncodedBlockSizes[n]
+characters. Standard base58 cannot round-trip Monero addresses because of
+this block padding.
+https://github.com/monero-project/monero/blob/master/src/common/base58.cpp
+*/
+
+const (
+	moneroBlockSize        = 8
+	moneroEncodedBlockSize = 11
+)
+
+// encoded size of a block of n bytes
+var moneroEncodedBlockSizes = [moneroBlockSize + 1]int{0, 2, 3, 5, 6, 7, 9, 10, 11}
+
+var (
+	// encoded length does not correspond to any decoded length
+	ErrMoneroInvalidLength = errors.New("base58: invalid monero block length")
+	// encoded block value does not fit in its decoded size
+	ErrMoneroOverflow = errors.New("base58: monero block overflow")
+)
+
+// monero block base58 encoding scheme
+type MoneroEncoding struct {
+	enc *Encoding
+}
+
+// monero block encoding over the alphabet of enc, which must be radix 58
+func NewMoneroEncoding(enc *Encoding) *MoneroEncoding {
+	if enc.base != 58 {
+		panic("base58: monero encoding requires a radix-58 alphabet")
+	}
+	return &MoneroEncoding{enc: enc}
+}
+
+// std monero block encoding using the bitcoin alphabet
+var StdMoneroEncoding = NewMoneroEncoding(StdEncoding)
+
+// return the encoded length of n source bytes
+func (me *MoneroEncoding) EncodedLen(n int) int {
+	return n/moneroBlockSize*moneroEncodedBlockSize + moneroEncodedBlockSizes[n%moneroBlockSize]
+}
+
+// return the decoded length of n encoded characters, or -1 if no input
+// encodes to n characters
+func (me *MoneroEncoding) DecodedLen(n int) int {
+	rem := n % moneroEncodedBlockSize
+	for size, encSize := range moneroEncodedBlockSizes {
+		if encSize == rem {
+			return n/moneroEncodedBlockSize*moneroBlockSize + size
+		}
+	}
+	return -1
+}
+
+// encode src and write EncodedLen(len(src)) bytes to dst
+func (me *MoneroEncoding) Encode(dst, src []byte) int {
+	n := 0
+	for len(src) > 0 {
+		size := min(len(src), moneroBlockSize)
+		n += me.encodeBlock(dst[n:], src[:size])
+		src = src[size:]
+	}
+	return n
+}
+
+// encode one block of up to 8 bytes into its fixed-size group
+func (me *MoneroEncoding) encodeBlock(dst, block []byte) int {
+	var buf [moneroBlockSize]byte
+	copy(buf[moneroBlockSize-len(block):], block)
+	num := binary.BigEndian.Uint64(buf[:])
+	size := moneroEncodedBlockSizes[len(block)]
+	for i := size - 1; i >= 0; i-- {
+		dst[i] = me.enc.encode[num%58]
+		num /= 58
+	}
+	return size
+}
+
+// return monero block encoding of src
+func (me *MoneroEncoding) EncodeToString(src []byte) string {
+	dst := make([]byte, me.EncodedLen(len(src)))
+	me.Encode(dst, src)
+	return string(dst)
+}
+
+// decode src and write DecodedLen(len(src)) bytes to dst
+func (me *MoneroEncoding) Decode(dst, src []byte) (int, error) {
+	if me.DecodedLen(len(src)) < 0 {
+		return 0, ErrMoneroInvalidLength
+	}
+	n := 0
+	for len(src) > 0 {
+		size := min(len(src), moneroEncodedBlockSize)
+		m, err := me.decodeBlock(dst[n:], src[:size])
+		if err != nil {
+			return n, err
+		}
+		n += m
+		src = src[size:]
+	}
+	return n, nil
+}
+
+// decode one fixed-size group into its block
+func (me *MoneroEncoding) decodeBlock(dst, group []byte) (int, error) {
+	size := me.DecodedLen(len(group))
+	var num uint64
+	for _, c := range group {
+		val := me.enc.reverse[c]
+		if val == -1 {
+			return 0, errInvalidCharacter
+		}
+		hi, lo := bits.Mul64(num, 58)
+		lo, carry := bits.Add64(lo, uint64(val), 0)
+		if hi != 0 || carry != 0 {
+			return 0, ErrMoneroOverflow
+		}
+		num = lo
+	}
+	if size < moneroBlockSize && num>>(8*size) != 0 {
+		return 0, ErrMoneroOverflow
+	}
+	var buf [moneroBlockSize]byte
+	binary.BigEndian.PutUint64(buf[:], num)
+	copy(dst, buf[moneroBlockSize-size:])
+	return size, nil
+}
+
+// return the bytes represented by monero block encoded s
+func (me *MoneroEncoding) DecodeString(s string) ([]byte, error) {
+	n := me.DecodedLen(len(s))
+	if n < 0 {
+		return nil, ErrMoneroInvalidLength
+	}
+	dst := make([]byte, n)
+	_, err := me.Decode(dst, []byte(s))
+	if err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
@@ -0,0 +1,62 @@
+package base58_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+// hex-encoded input and its monero block encoding
+var moneroPairs = []testpair{
+	{"", ""},
+	{"00", "11"},
+	{"39", "1z"},
+	{"ff", "5Q"},
+	{"0000", "111"},
+	{"0039", "11z"},
+	{"ffffffffffffffff", "jpXCZedGfVQ"},
+	{"06156a0d4d0e8f8f22", "2226z9Sfm1Y1b"},
+	{"ffffffffffffffffffffffffffffffff", "jpXCZedGfVQjpXCZedGfVQ"},
+}
+
+func TestMoneroEncoding(t *testing.T) {
+	for _, p := range moneroPairs {
+		src, _ := hex.DecodeString(p.decoded)
+		got := base58.StdMoneroEncoding.EncodeToString(src)
+		msg := fmt.Sprintf("Monero EncodeToString(%s): got %%q, want %%q", p.decoded)
+		testEqual(t, msg, p.encoded, got)
+		testEqual(t, "EncodedLen: got %d, want %d", len(p.encoded), base58.StdMoneroEncoding.EncodedLen(len(src)))
+
+		res, err := base58.StdMoneroEncoding.DecodeString(p.encoded)
+		if err != nil {
+			t.Errorf("Monero DecodeString(%q) failed: %v", p.encoded, err)
+			continue
+		}
+		msg = fmt.Sprintf("Monero DecodeString(%q): got %%q, want %%q", p.encoded)
+		testEqual(t, msg, p.decoded, hex.EncodeToString(res))
+	}
+}
+
+func TestMoneroDecodeErrors(t *testing.T) {
+	tests := []struct {
+		in   string
+		want error
+	}{
+		{"1", base58.ErrMoneroInvalidLength},
+		{"1111", base58.ErrMoneroInvalidLength},
+		{"5R", base58.ErrMoneroOverflow},          // 256 does not fit in 1 byte
+		{"jpXCZedGfVR", base58.ErrMoneroOverflow}, // 2^64 does not fit in 8 bytes
+		{"zzzzzzzzzzz", base58.ErrMoneroOverflow},
+	}
+	for _, tt := range tests {
+		if _, err := base58.StdMoneroEncoding.DecodeString(tt.in); !errors.Is(err, tt.want) {
+			t.Errorf("Monero DecodeString(%q): got error %v, want %v", tt.in, err, tt.want)
+		}
+	}
+	if _, err := base58.StdMoneroEncoding.DecodeString("0O"); err == nil {
+		t.Errorf("Monero DecodeString(%q): expected invalid character error", "0O")
+	}
+}
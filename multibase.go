@@ -0,0 +1,43 @@
+package base58
+
+import (
+	"errors"
+	"fmt"
+)
+
+/*
+Multibase base58btc
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Multibase prefixes encoded data with a single character identifying the
+encoding. 'z' denotes base58btc, used by IPFS CIDv1 strings and did:key.
+https://github.com/multiformats/multibase
+*/
+
+// multibase prefix for base58btc
+const MultibasePrefix = 'z'
+
+var (
+	// input is empty and carries no multibase prefix
+	ErrMultibaseEmpty = errors.New("base58: empty multibase string")
+	// input uses a multibase prefix other than base58btc
+	ErrUnsupportedMultibase = errors.New("base58: unsupported multibase prefix")
+)
+
+// return the multibase base58btc encoding of src
+func EncodeMultibase(src []byte) string {
+	return string(MultibasePrefix) + StdEncoding.EncodeToString(src)
+}
+
+// decode a multibase base58btc string
+func DecodeMultibase(s string) ([]byte, error) {
+	if s == "" {
+		return nil, ErrMultibaseEmpty
+	}
+	if s[0] != MultibasePrefix {
+		return nil, fmt.Errorf("%w %q, want %q (base58btc)", ErrUnsupportedMultibase, s[0], MultibasePrefix)
+	}
+	return StdEncoding.DecodeString(s[1:])
+}
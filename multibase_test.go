@@ -0,0 +1,44 @@
+package base58_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestMultibase(t *testing.T) {
+	for _, p := range pairs {
+		got := base58.EncodeMultibase([]byte(p.decoded))
+		msg := fmt.Sprintf("EncodeMultibase(%q): got %%q, want %%q", p.decoded)
+		testEqual(t, msg, "z"+p.encoded, got)
+
+		res, err := base58.DecodeMultibase(got)
+		if err != nil {
+			t.Errorf("DecodeMultibase(%q) failed: %v", got, err)
+			continue
+		}
+		msg = fmt.Sprintf("DecodeMultibase(%q): got %%q, want %%q", got)
+		testEqual(t, msg, p.decoded, string(res))
+	}
+	// did:key ed25519 multicodec header 0xed01
+	testEqual(t, "EncodeMultibase(did:key): got %q, want %q", "z6Mk", base58.EncodeMultibase(append([]byte{0xed, 0x01}, make([]byte, 32)...))[:4])
+}
+
+func TestMultibaseErrors(t *testing.T) {
+	tests := []struct {
+		in   string
+		want error
+	}{
+		{"", base58.ErrMultibaseEmpty},
+		{"Zsure", base58.ErrUnsupportedMultibase},
+		{"bafy", base58.ErrUnsupportedMultibase},
+		{"QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG", base58.ErrUnsupportedMultibase},
+	}
+	for _, tt := range tests {
+		if _, err := base58.DecodeMultibase(tt.in); !errors.Is(err, tt.want) {
+			t.Errorf("DecodeMultibase(%q): got error %v, want %v", tt.in, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package base58
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+/*
+Base58Check Network Registry
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Version prefixes for common coins so Base58Check strings can be attributed to
+the network and key type they belong to. Additional networks can be added
+with Register.
+*/
+
+// version prefix does not belong to any registered network
+var ErrUnknownNetwork = errors.New("base58: unknown network version")
+
+// kind of payload identified by a version prefix
+type VersionKind int
+
+const (
+	PubKeyHash VersionKind = iota // P2PKH address
+	ScriptHash                    // P2SH address
+	PrivateKey                    // WIF private key
+)
+
+// return the version kind name
+func (k VersionKind) String() string {
+	switch k {
+	case PubKeyHash:
+		return "pubkeyhash"
+	case ScriptHash:
+		return "scripthash"
+	case PrivateKey:
+		return "privatekey"
+	}
+	return fmt.Sprintf("VersionKind(%d)", int(k))
+}
+
+// Base58Check version prefixes of a network
+type Network struct {
+	Name             string
+	PubKeyHashAddrID []byte // P2PKH address version
+	ScriptHashAddrID []byte // P2SH address version
+	PrivateKeyID     []byte // WIF version
+}
+
+// return the version prefix of kind k
+func (n *Network) Version(k VersionKind) []byte {
+	switch k {
+	case PubKeyHash:
+		return n.PubKeyHashAddrID
+	case ScriptHash:
+		return n.ScriptHashAddrID
+	case PrivateKey:
+		return n.PrivateKeyID
+	}
+	return nil
+}
+
+// predefined networks
+var (
+	BitcoinMainNet  = &Network{Name: "btc", PubKeyHashAddrID: []byte{0x00}, ScriptHashAddrID: []byte{0x05}, PrivateKeyID: []byte{WIFMainnet}}
+	BitcoinTestNet  = &Network{Name: "btc-testnet", PubKeyHashAddrID: []byte{0x6f}, ScriptHashAddrID: []byte{0xc4}, PrivateKeyID: []byte{WIFTestnet}}
+	LitecoinMainNet = &Network{Name: "ltc", PubKeyHashAddrID: []byte{0x30}, ScriptHashAddrID: []byte{0x32}, PrivateKeyID: []byte{0xb0}}
+	DogecoinMainNet = &Network{Name: "doge", PubKeyHashAddrID: []byte{0x1e}, ScriptHashAddrID: []byte{0x16}, PrivateKeyID: []byte{0x9e}}
+	DashMainNet     = &Network{Name: "dash", PubKeyHashAddrID: []byte{0x4c}, ScriptHashAddrID: []byte{0x10}, PrivateKeyID: []byte{0xcc}}
+	ZcashMainNet    = &Network{Name: "zec", PubKeyHashAddrID: []byte{0x1c, 0xb8}, ScriptHashAddrID: []byte{0x1c, 0xbd}, PrivateKeyID: []byte{0x80}}
+)
+
+var registry = struct {
+	sync.RWMutex
+	networks []*Network
+}{
+	networks: []*Network{BitcoinMainNet, BitcoinTestNet, LitecoinMainNet, DogecoinMainNet, DashMainNet, ZcashMainNet},
+}
+
+// add net to the network registry
+func Register(net *Network) error {
+	if net == nil || net.Name == "" {
+		return errors.New("base58: network must have a name")
+	}
+	registry.Lock()
+	defer registry.Unlock()
+	for _, n := range registry.networks {
+		if n.Name == net.Name {
+			return fmt.Errorf("base58: network %q already registered", net.Name)
+		}
+	}
+	registry.networks = append(registry.networks, net)
+	return nil
+}
+
+// return the registered network called name
+func Lookup(name string) (*Network, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	for _, n := range registry.networks {
+		if n.Name == name {
+			return n, true
+		}
+	}
+	return nil, false
+}
+
+// return all registered networks in registration order
+func Networks() []*Network {
+	registry.RLock()
+	defer registry.RUnlock()
+	return append([]*Network(nil), registry.networks...)
+}
+
+// find the registered network and version kind whose prefix starts decoded,
+// preferring the longest matching prefix
+func lookupVersion(decoded []byte) (net *Network, kind VersionKind, versionLen int) {
+	registry.RLock()
+	defer registry.RUnlock()
+	return matchVersion(decoded, registry.networks)
+}
+
+// find the network in nets and version kind whose prefix starts decoded,
+// preferring the longest matching prefix
+func matchVersion(decoded []byte, nets []*Network) (net *Network, kind VersionKind, versionLen int) {
+	for _, n := range nets {
+		for _, k := range []VersionKind{PubKeyHash, ScriptHash, PrivateKey} {
+			v := n.Version(k)
+			if len(v) > versionLen && bytes.HasPrefix(decoded, v) {
+				net, kind, versionLen = n, k, len(v)
+			}
+		}
+	}
+	return net, kind, versionLen
+}
+
+// decode base58check string s and identify the registered network and
+// version kind of its version prefix
+func CheckDecodeNetwork(s string) (net *Network, kind VersionKind, payload []byte, err error) {
+	decoded, err := CheckDecode(s)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	net, kind, versionLen := lookupVersion(decoded)
+	if net == nil {
+		return nil, 0, nil, ErrUnknownNetwork
+	}
+	return net, kind, decoded[versionLen:], nil
+}
+
+// result of validating one input with ValidateAddresses
+type AddressResult struct {
+	Input   string
+	Valid   bool
+	Network *Network    // network of the version prefix, if valid
+	Kind    VersionKind // address or key kind, if valid
+	Payload []byte      // payload without version and checksum, if valid
+	Err     error       // reason the input is invalid
+}
+
+// validate inputs as Base58Check strings of the given networks, or of any
+// registered network if none are given, using one worker per CPU. Results
+// are returned in input order.
+func ValidateAddresses(inputs []string, networks ...*Network) []AddressResult {
+	if len(networks) == 0 {
+		networks = Networks()
+	}
+	results := make([]AddressResult, len(inputs))
+	workers := min(runtime.GOMAXPROCS(0), len(inputs))
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := w; i < len(inputs); i += workers {
+				results[i] = validateAddress(inputs[i], networks)
+			}
+		}(w)
+	}
+	wg.Wait()
+	return results
+}
+
+func validateAddress(s string, networks []*Network) AddressResult {
+	r := AddressResult{Input: s}
+	decoded, err := CheckDecode(s)
+	if err != nil {
+		r.Err = err
+		return r
+	}
+	net, kind, versionLen := matchVersion(decoded, networks)
+	if net == nil {
+		r.Err = ErrUnknownNetwork
+		return r
+	}
+	r.Valid, r.Network, r.Kind, r.Payload = true, net, kind, decoded[versionLen:]
+	return r
+}
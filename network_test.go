@@ -0,0 +1,109 @@
+package base58_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestCheckDecodeNetwork(t *testing.T) {
+	tests := []struct {
+		in      string
+		net     *base58.Network
+		kind    base58.VersionKind
+		payload int
+	}{
+		{"16UwLL9Risc3QfPqBUvKofHmBQ7wMtjvM", base58.BitcoinMainNet, base58.PubKeyHash, 20},
+		{"3QJmV3qfvL9SuYo34YihAf3sRCW3qSinyC", base58.BitcoinMainNet, base58.ScriptHash, 20},
+		{"mfcSEPR8EkJrpX91YkTJ9iscdAzppJrG9j", base58.BitcoinTestNet, base58.PubKeyHash, 20},
+		{"KwdMAjGmerYanjeui5SHS7JkmpZvVipYvB2LJGU1ZxJwYvP98617", base58.BitcoinMainNet, base58.PrivateKey, 33},
+		{base58.CheckEncodeVersion([]byte{0x30}, make([]byte, 20)), base58.LitecoinMainNet, base58.PubKeyHash, 20},
+		{base58.CheckEncodeVersion([]byte{0x1e}, make([]byte, 20)), base58.DogecoinMainNet, base58.PubKeyHash, 20},
+		{base58.CheckEncodeVersion([]byte{0x1c, 0xb8}, make([]byte, 20)), base58.ZcashMainNet, base58.PubKeyHash, 20},
+	}
+	for _, tt := range tests {
+		net, kind, payload, err := base58.CheckDecodeNetwork(tt.in)
+		if err != nil {
+			t.Errorf("CheckDecodeNetwork(%q) failed: %v", tt.in, err)
+			continue
+		}
+		if net != tt.net || kind != tt.kind || len(payload) != tt.payload {
+			t.Errorf("CheckDecodeNetwork(%q): got %s/%s/%d bytes, want %s/%s/%d bytes", tt.in, net.Name, kind, len(payload), tt.net.Name, tt.kind, tt.payload)
+		}
+	}
+	if _, _, _, err := base58.CheckDecodeNetwork(base58.CheckEncodeVersion([]byte{0xfe}, make([]byte, 20))); !errors.Is(err, base58.ErrUnknownNetwork) {
+		t.Errorf("CheckDecodeNetwork(unknown version): got error %v, want %v", err, base58.ErrUnknownNetwork)
+	}
+}
+
+func TestRegister(t *testing.T) {
+	custom := &base58.Network{Name: "test-register", PubKeyHashAddrID: []byte{0xfe, 0x01}}
+	if err := base58.Register(custom); err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+	if err := base58.Register(custom); err == nil {
+		t.Errorf("Register: duplicate name accepted")
+	}
+	if err := base58.Register(&base58.Network{}); err == nil {
+		t.Errorf("Register: unnamed network accepted")
+	}
+	if net, ok := base58.Lookup("test-register"); !ok || net != custom {
+		t.Errorf("Lookup(%q): got %v, %v", "test-register", net, ok)
+	}
+	if _, ok := base58.Lookup("no-such-network"); ok {
+		t.Errorf("Lookup(%q): unexpectedly found", "no-such-network")
+	}
+	net, kind, _, err := base58.CheckDecodeNetwork(base58.CheckEncodeVersion([]byte{0xfe, 0x01}, make([]byte, 20)))
+	if err != nil || net != custom || kind != base58.PubKeyHash {
+		t.Errorf("CheckDecodeNetwork(custom): got %v, %s, %v", net, kind, err)
+	}
+}
+
+func TestValidateAddresses(t *testing.T) {
+	inputs := []string{
+		"1BoatSLRHtKNngkdXEeobR76b53LETtpyT",
+		"3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy",
+		"5HueCGU8rMjxEXxiPuD5BDku4MkFqeZyd4dZ1jvhTVqvbTLvyTJ",
+		"1BoatSLRHtKNngkdXEeobR76b53LETtpyU",
+		"0OIl",
+	}
+	results := base58.ValidateAddresses(inputs)
+	testEqual(t, "ValidateAddresses count: got %d, want %d", len(inputs), len(results))
+	want := []struct {
+		valid bool
+		kind  base58.VersionKind
+		err   error
+	}{
+		{true, base58.PubKeyHash, nil},
+		{true, base58.ScriptHash, nil},
+		{true, base58.PrivateKey, nil},
+		{false, 0, base58.ErrChecksumMismatch},
+		{false, 0, nil},
+	}
+	for i, r := range results {
+		testEqual(t, "result input: got %q, want %q", inputs[i], r.Input)
+		if r.Valid != want[i].valid || r.Kind != want[i].kind {
+			t.Errorf("ValidateAddresses(%q): got valid %v kind %v, want %v %v", inputs[i], r.Valid, r.Kind, want[i].valid, want[i].kind)
+		}
+		if r.Valid && (r.Network != base58.BitcoinMainNet || r.Err != nil) {
+			t.Errorf("ValidateAddresses(%q): got network %v err %v, want btc", inputs[i], r.Network, r.Err)
+		}
+		if !r.Valid && r.Err == nil {
+			t.Errorf("ValidateAddresses(%q): invalid without error", inputs[i])
+		}
+		if want[i].err != nil && !errors.Is(r.Err, want[i].err) {
+			t.Errorf("ValidateAddresses(%q): got %v, want %v", inputs[i], r.Err, want[i].err)
+		}
+	}
+
+	// restricted to other networks, bitcoin inputs are unknown
+	for _, r := range base58.ValidateAddresses(inputs[:1], base58.LitecoinMainNet) {
+		if r.Valid || !errors.Is(r.Err, base58.ErrUnknownNetwork) {
+			t.Errorf("ValidateAddresses(ltc only): got %v %v, want %v", r.Valid, r.Err, base58.ErrUnknownNetwork)
+		}
+	}
+	if got := base58.ValidateAddresses(nil); len(got) != 0 {
+		t.Errorf("ValidateAddresses(nil): got %d results", len(got))
+	}
+}
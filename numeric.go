@@ -0,0 +1,89 @@
+package base58
+
+import (
+	"errors"
+	"math/big"
+	"math/bits"
+)
+
+/*
+Numeric Encoding
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Encode integers directly as base58 digits, most significant first, without
+going through the byte-slice big-number routines. Numbers carry no leading
+zero bytes, so 0 encodes as the single zero digit and leading zero digits
+are ignored on decode. This is the format used by URL shorteners and
+database-ID obfuscation.
+*/
+
+// max digits of a uint64 in the smallest radix
+const maxUint64Digits = 64
+
+var (
+	// decoded number does not fit the destination type
+	ErrOverflow = errors.New("base58: numeric value overflows")
+	// numeric input is empty
+	errEmptyNumber = errors.New("base58: empty numeric string")
+)
+
+// return the base58 digits of u
+func (enc *Encoding) EncodeUint64(u uint64) string {
+	var buf [maxUint64Digits]byte
+	i := len(buf)
+	for {
+		i--
+		buf[i] = enc.encode[u%uint64(enc.base)]
+		u /= uint64(enc.base)
+		if u == 0 {
+			break
+		}
+	}
+	return string(buf[i:])
+}
+
+// decode base58 digits s into a uint64
+func (enc *Encoding) DecodeUint64(s string) (uint64, error) {
+	if s == "" {
+		return 0, errEmptyNumber
+	}
+	var u uint64
+	for i := 0; i < len(s); i++ {
+		val := enc.reverse[s[i]]
+		if val == -1 {
+			return 0, errInvalidCharacter
+		}
+		hi, lo := bits.Mul64(u, uint64(enc.base))
+		lo, carry := bits.Add64(lo, uint64(val), 0)
+		if hi != 0 || carry != 0 {
+			return 0, ErrOverflow
+		}
+		u = lo
+	}
+	return u, nil
+}
+
+// return the base58 digits of non-negative x, panics if x is negative
+func (enc *Encoding) EncodeBigInt(x *big.Int) string {
+	if x.Sign() < 0 {
+		panic("base58: EncodeBigInt of negative number")
+	}
+	if x.Sign() == 0 {
+		return string(enc.encode[0])
+	}
+	return enc.asBigEndian().EncodeToString(x.Bytes())
+}
+
+// decode base58 digits s into a big.Int
+func (enc *Encoding) DecodeBigInt(s string) (*big.Int, error) {
+	if s == "" {
+		return nil, errEmptyNumber
+	}
+	decoded, err := enc.asBigEndian().DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(decoded), nil
+}
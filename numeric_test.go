@@ -0,0 +1,107 @@
+package base58_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestUint64(t *testing.T) {
+	tests := []struct {
+		u       uint64
+		encoded string
+	}{
+		{0, "1"},
+		{1, "2"},
+		{57, "z"},
+		{58, "21"},
+		{3392387861, "6Amsit"},
+		{math.MaxUint64, "jpXCZedGfVQ"},
+	}
+	for _, tt := range tests {
+		got := base58.StdEncoding.EncodeUint64(tt.u)
+		msg := fmt.Sprintf("EncodeUint64(%d): got %%q, want %%q", tt.u)
+		testEqual(t, msg, tt.encoded, got)
+		u, err := base58.StdEncoding.DecodeUint64(tt.encoded)
+		if err != nil {
+			t.Errorf("DecodeUint64(%q) failed: %v", tt.encoded, err)
+			continue
+		}
+		msg = fmt.Sprintf("DecodeUint64(%q): got %%d, want %%d", tt.encoded)
+		testEqual(t, msg, tt.u, u)
+	}
+	// the flickr short URL of photo 3392387861
+	testEqual(t, "Flickr EncodeUint64: got %q, want %q", "6aLSHT", base58.FlickrEncoding.EncodeUint64(3392387861))
+	if u, err := base58.StdEncoding.DecodeUint64("1112"); err != nil || u != 1 {
+		t.Errorf("DecodeUint64(%q): got %d, %v, want 1", "1112", u, err)
+	}
+}
+
+func TestDecodeUint64Errors(t *testing.T) {
+	if _, err := base58.StdEncoding.DecodeUint64("jpXCZedGfVR"); !errors.Is(err, base58.ErrOverflow) {
+		t.Errorf("DecodeUint64(MaxUint64+1): got error %v, want %v", err, base58.ErrOverflow)
+	}
+	for _, s := range []string{"", "0", "abc0"} {
+		if _, err := base58.StdEncoding.DecodeUint64(s); err == nil {
+			t.Errorf("DecodeUint64(%q): expected error", s)
+		}
+	}
+}
+
+func TestBigInt(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890123456789012345678901234567890", 10)
+	tests := []struct {
+		x       *big.Int
+		encoded string
+	}{
+		{big.NewInt(0), "1"},
+		{big.NewInt(57), "z"},
+		{new(big.Int).SetUint64(math.MaxUint64), "jpXCZedGfVQ"},
+		{huge, base58.StdEncoding.EncodeToString(huge.Bytes())},
+	}
+	for _, tt := range tests {
+		got := base58.StdEncoding.EncodeBigInt(tt.x)
+		msg := fmt.Sprintf("EncodeBigInt(%s): got %%q, want %%q", tt.x)
+		testEqual(t, msg, tt.encoded, got)
+		x, err := base58.StdEncoding.DecodeBigInt(tt.encoded)
+		if err != nil {
+			t.Errorf("DecodeBigInt(%q) failed: %v", tt.encoded, err)
+			continue
+		}
+		if x.Cmp(tt.x) != 0 {
+			t.Errorf("DecodeBigInt(%q): got %s, want %s", tt.encoded, x, tt.x)
+		}
+	}
+	// leading zero digits do not change the value
+	if x, err := base58.StdEncoding.DecodeBigInt("111z"); err != nil || x.Int64() != 57 {
+		t.Errorf("DecodeBigInt(%q): got %v, %v, want 57", "111z", x, err)
+	}
+	if _, err := base58.StdEncoding.DecodeBigInt(""); err == nil {
+		t.Errorf("DecodeBigInt(\"\"): expected error")
+	}
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("EncodeBigInt(-1) did not panic")
+			}
+		}()
+		base58.StdEncoding.EncodeBigInt(big.NewInt(-1))
+	}()
+}
+
+func BenchmarkEncodeUint64(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		base58.StdEncoding.EncodeUint64(uint64(i) * 2654435761)
+	}
+}
+
+func BenchmarkDecodeUint64(b *testing.B) {
+	s := base58.StdEncoding.EncodeUint64(math.MaxUint64)
+	for i := 0; i < b.N; i++ {
+		base58.StdEncoding.DecodeUint64(s)
+	}
+}
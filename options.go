@@ -0,0 +1,279 @@
+package base58
+
+import (
+	"fmt"
+	"math/big"
+)
+
+/*
+Encoding Options
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Following encoding/base64's WithPadding and Strict, options are chainable
+methods returning a derived copy of an Encoding, leaving the original
+untouched:
+
+	ids := base58.StdEncoding.WithPadWidth(12)
+*/
+
+// return a copy of enc that left-pads encoded output with the zero digit
+// ('1' for bitcoin) to width characters, for fixed-column numeric IDs. The
+// payload is a number of PadSize() bytes, the most that always fits in
+// width digits, and decoding returns exactly that many bytes, so zero
+// bytes in front are kept. Shorter input encodes as its numeric value and
+// decodes zero-extended to PadSize() bytes. A value that needs more than
+// width digits overflows: it is encoded unpadded, and such a string, longer
+// than width and without a leading zero digit, decodes to the value's
+// bytes without leading zeros. Any other string whose length is not width
+// fails with ErrInvalidLength. A width of 0 disables padding.
+func (enc *Encoding) WithPadWidth(width int) *Encoding {
+	if width < 0 {
+		panic("base58: negative pad width")
+	}
+	e := *enc
+	e.padWidth = width
+	e.padSize = 0
+	if width > 0 {
+		// 256^n <= base^width for n up to floor(log2(base^width) / 8)
+		max := new(big.Int).Exp(big.NewInt(int64(enc.base)), big.NewInt(int64(width)), nil)
+		e.padSize = (max.BitLen() - 1) / 8
+	}
+	return &e
+}
+
+// return the pad width of enc, 0 if unpadded
+func (enc *Encoding) PadWidth() int {
+	return enc.padWidth
+}
+
+// return the decoded size in bytes of a padded encoding, 0 if unpadded
+func (enc *Encoding) PadSize() int {
+	return enc.padSize
+}
+
+// check the digit count of a padded encoding: the width, or more for an
+// overflowing value, which never has a leading zero digit
+func (enc *Encoding) checkPadCount(count int, leadingZero bool) error {
+	if count == enc.padWidth || count > enc.padWidth && !leadingZero {
+		return nil
+	}
+	return fmt.Errorf("%w: got %d characters, want %d", ErrInvalidLength, count, enc.padWidth)
+}
+
+// return decoded, the decode of a padded encoding with any leading zero
+// bytes, as exactly PadSize() bytes, or without leading zeros if the value
+// overflows that size. The result shares decoded if it fits, otherwise
+// decoded is released for a new buffer.
+func (enc *Encoding) padDecoded(decoded []byte) []byte {
+	value := trimLeadingZeros(decoded)
+	if len(value) >= enc.padSize {
+		return value
+	}
+	if len(decoded) >= enc.padSize {
+		return decoded[len(decoded)-enc.padSize:]
+	}
+	out := enc.get(enc.padSize)
+	n := copy(out[enc.padSize-len(value):], value)
+	clear(out[:enc.padSize-n])
+	enc.release(decoded)
+	return out
+}
+
+// return a copy of enc that inserts sep every groupSize characters on encode
+// (XXXX-XXXX-XXXX) and ignores sep on decode. A groupSize of 0 disables
+// grouping. Panics if sep is part of the alphabet.
+func (enc *Encoding) WithSeparator(sep byte, groupSize int) *Encoding {
+	if groupSize < 0 {
+		panic("base58: negative group size")
+	}
+	if groupSize > 0 && enc.reverse[sep] != -1 {
+		panic("base58: separator is part of the alphabet")
+	}
+	e := *enc
+	e.sep, e.groupSize = sep, groupSize
+	return &e
+}
+
+// insert the separator between groups of encoded characters
+func (enc *Encoding) group(encoded []byte) []byte {
+	if len(encoded) <= enc.groupSize {
+		return encoded
+	}
+	out := enc.get(len(encoded) + len(encoded)/enc.groupSize)[:0]
+	for i, c := range encoded {
+		if i > 0 && i%enc.groupSize == 0 {
+			out = append(out, enc.sep)
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+// return a copy of enc that silently skips the characters in chars on
+// decode, e.g. " \t\r\n-" for keys pasted from emails or wrapped terminal
+// output. Adds to any characters already ignored. Panics if a character is
+// part of the alphabet.
+func (enc *Encoding) WithIgnore(chars string) *Encoding {
+	e := *enc
+	for i := 0; i < len(chars); i++ {
+		if enc.reverse[chars[i]] != -1 {
+			panic("base58: ignored character is part of the alphabet")
+		}
+		e.ignore[chars[i]] = true
+		e.hasIgnore = true
+	}
+	return &e
+}
+
+// return a copy of enc that wraps encoded output with '\n' every lineLen
+// characters (PEM-style) and ignores CR and LF on decode. A lineLen of 0
+// disables wrapping.
+func (enc *Encoding) WithWrap(lineLen int) *Encoding {
+	if lineLen < 0 {
+		panic("base58: negative line length")
+	}
+	e := *enc
+	e.lineLen = lineLen
+	return &e
+}
+
+// insert a newline after every full line of encoded characters, without
+// a trailing newline
+func (enc *Encoding) wrap(encoded []byte) []byte {
+	if len(encoded) <= enc.lineLen {
+		return encoded
+	}
+	out := enc.get(len(encoded) + len(encoded)/enc.lineLen)[:0]
+	for len(encoded) > enc.lineLen {
+		out = append(out, encoded[:enc.lineLen]...)
+		out = append(out, '\n')
+		encoded = encoded[enc.lineLen:]
+	}
+	return append(out, encoded...)
+}
+
+// return a copy of enc that rejects any byte outside the alphabet on
+// decode, undoing Lenient and WithIgnore. Separators and line breaks from
+// WithSeparator and WithWrap are part of the format and still accepted.
+func (enc *Encoding) Strict() *Encoding {
+	e := *enc
+	e.lenient = false
+	e.ignore = [256]bool{}
+	e.hasIgnore = false
+	return &e
+}
+
+// return a copy of enc that skips ASCII whitespace on decode
+func (enc *Encoding) Lenient() *Encoding {
+	e := *enc
+	e.lenient = true
+	return &e
+}
+
+// decode-time recovery policy, called with each invalid character and its
+// input offset. It returns a replacement character from the alphabet, or
+// skip to drop the character, or an error to abort decoding.
+type InvalidCharFunc func(b byte, pos int) (replacement byte, skip bool, err error)
+
+// return a copy of enc that consults fn for characters outside the alphabet
+// instead of failing, e.g. to map look-alikes or drop noise bytes. A nil fn
+// restores the default of rejecting them.
+func (enc *Encoding) WithInvalidHandler(fn InvalidCharFunc) *Encoding {
+	e := *enc
+	e.onInvalid = fn
+	return &e
+}
+
+// apply the invalid character handler to src
+func (enc *Encoding) substituteInvalid(src []byte) ([]byte, error) {
+	var out []byte
+	for i, c := range src {
+		if enc.reverse[c] != -1 || enc.ignored(c) {
+			if out != nil {
+				out = append(out, c)
+			}
+			continue
+		}
+		if out == nil {
+			out = enc.get(len(src))[:i]
+			copy(out, src[:i])
+		}
+		repl, skip, err := enc.onInvalid(c, i)
+		if err != nil {
+			enc.release(out)
+			return nil, err
+		}
+		if !skip {
+			out = append(out, repl)
+		}
+	}
+	if out == nil {
+		return src, nil
+	}
+	return out, nil
+}
+
+// return a copy of enc that represents each leading zero byte with c
+// instead of the first alphabet character, as some nonstandard systems do.
+// c must be printable ASCII outside the alphabet, or the first alphabet
+// character to restore the default.
+func (enc *Encoding) WithZeroDigit(c byte) *Encoding {
+	if c == enc.encode[0] {
+		c = 0
+	} else if c <= ' ' || c > '~' || enc.reverse[c] != -1 || enc.ignored(c) {
+		panic("base58: zero digit must be printable ASCII outside the alphabet")
+	}
+	e := *enc
+	e.zero = c
+	return &e
+}
+
+// return the character representing a leading zero byte
+func (enc *Encoding) zeroDigit() byte {
+	if enc.zero != 0 {
+		return enc.zero
+	}
+	return enc.encode[0]
+}
+
+// report whether c is a zero digit in leading position
+func (enc *Encoding) isZeroDigit(c byte) bool {
+	return enc.reverse[c] == 0 || enc.zero != 0 && c == enc.zero
+}
+
+// report whether c is skipped on decode
+func (enc *Encoding) ignored(c byte) bool {
+	return enc.ignore[c] || (enc.groupSize > 0 && c == enc.sep) ||
+		(enc.lineLen > 0 && (c == '\r' || c == '\n')) ||
+		(enc.lenient && isSpace(c))
+}
+
+// report whether c is ASCII whitespace
+func isSpace(c byte) bool {
+	switch c {
+	case ' ', '\t', '\n', '\v', '\f', '\r':
+		return true
+	}
+	return false
+}
+
+// return src without separator and ignored characters
+func (enc *Encoding) stripIgnored(src []byte) []byte {
+	i := 0
+	for i < len(src) && !enc.ignored(src[i]) {
+		i++
+	}
+	if i == len(src) {
+		return src
+	}
+	out := enc.get(len(src))[:i]
+	copy(out, src[:i])
+	for _, c := range src[i:] {
+		if !enc.ignored(c) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
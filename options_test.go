@@ -0,0 +1,314 @@
+package base58_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestWithPadWidth(t *testing.T) {
+	enc := base58.StdEncoding.WithPadWidth(12)
+	testEqual(t, "PadWidth: got %d, want %d", 12, enc.PadWidth())
+	testEqual(t, "StdEncoding.PadWidth: got %d, want %d", 0, base58.StdEncoding.PadWidth())
+	if enc.Equal(base58.StdEncoding) {
+		t.Errorf("Equal: padded encoding compares equal to StdEncoding")
+	}
+	// 12 digits always hold 8 bytes
+	testEqual(t, "PadSize: got %d, want %d", 8, enc.PadSize())
+	tests := []testpair{
+		{"", "111111111111"},
+		{"sure.", "11111E2XFRyo"},
+		{"\x00\x00sure.", "11111E2XFRyo"},
+		{"leasure.", "1K8aUZhGUNaR"},
+		{"\x00\x00\x00sure.", "11111E2XFRyo"},
+		{"\xff\xff\xff\xff\xff\xff\xff\xff\xff", "4FzkJ37568tQv"},
+	}
+	for _, p := range tests {
+		got := enc.EncodeToString([]byte(p.decoded))
+		msg := fmt.Sprintf("padded EncodeToString(%q): got %%q, want %%q", p.decoded)
+		testEqual(t, msg, p.encoded, got)
+		res, err := enc.DecodeString(p.encoded)
+		if err != nil {
+			t.Errorf("padded DecodeString(%q) failed: %v", p.encoded, err)
+			continue
+		}
+		// values that fit come back as 8 bytes, overflowing ones whole
+		want := trimZeros([]byte(p.decoded))
+		if len(want) < 8 {
+			want = append(make([]byte, 8-len(want)), want...)
+		}
+		msg = fmt.Sprintf("padded DecodeString(%q): got %%q, want %%q", p.encoded)
+		testEqual(t, msg, string(want), string(res))
+	}
+	for _, s := range []string{"E2XFRyo", "111111E2XFRyo"} {
+		if _, err := enc.DecodeString(s); !errors.Is(err, base58.ErrInvalidLength) {
+			t.Errorf("padded DecodeString(%q): got error %v, want %v", s, err, base58.ErrInvalidLength)
+		}
+	}
+}
+
+func trimZeros(b []byte) []byte {
+	for len(b) > 0 && b[0] == 0 {
+		b = b[1:]
+	}
+	return b
+}
+
+func TestWithSeparator(t *testing.T) {
+	enc := base58.StdEncoding.WithSeparator('-', 4)
+	tests := []testpair{
+		{"", ""},
+		{"sur", "fnKT"},
+		{"sure.", "E2XF-Ryo"},
+		{"leasure.", "K8aU-ZhGU-NaR"},
+		{bigtest.decoded, "2ukV-BARx-4fMC-UZXa-HR1X-vNbb-3Hgz-mGYF-EETh-Da86-tN2q-8oU"},
+	}
+	for _, p := range tests {
+		got := enc.EncodeToString([]byte(p.decoded))
+		msg := fmt.Sprintf("grouped EncodeToString(%q): got %%q, want %%q", p.decoded)
+		testEqual(t, msg, p.encoded, got)
+		res, err := enc.DecodeString(p.encoded)
+		if err != nil {
+			t.Errorf("grouped DecodeString(%q) failed: %v", p.encoded, err)
+			continue
+		}
+		msg = fmt.Sprintf("grouped DecodeString(%q): got %%q, want %%q", p.encoded)
+		testEqual(t, msg, p.decoded, string(res))
+	}
+	// separators are ignored wherever they appear
+	res, err := enc.DecodeString("-E2-XFRy-o-")
+	if err != nil || string(res) != "sure." {
+		t.Errorf("grouped DecodeString(misplaced separators): got %q, %v", res, err)
+	}
+	if _, err := base58.StdEncoding.DecodeString("E2XF-Ryo"); err == nil {
+		t.Errorf("StdEncoding.DecodeString(%q): expected invalid character error", "E2XF-Ryo")
+	}
+	// grouping applies after padding
+	padded := base58.StdEncoding.WithPadWidth(8).WithSeparator('-', 4)
+	testEqual(t, "padded grouped EncodeToString: got %q, want %q", "1E2X-FRyo", padded.EncodeToString([]byte("sure.")))
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("WithSeparator('A') did not panic")
+		}
+	}()
+	base58.StdEncoding.WithSeparator('A', 4)
+}
+
+func TestWithIgnore(t *testing.T) {
+	enc := base58.StdEncoding.WithIgnore(" \t\r\n-")
+	tests := []string{
+		"2ukVBARx4fMCUZXaHR1XvNbb3HgzmGYFEEThDa86tN2q8oU",
+		" 2ukVBARx4fMCUZXa\nHR1XvNbb3HgzmGYF\r\nEEThDa86tN2q8oU\n",
+		"2ukV-BARx-4fMC UZXa\tHR1X-vNbb-3Hgz-mGYF-EETh-Da86-tN2q-8oU",
+	}
+	for _, s := range tests {
+		res, err := enc.DecodeString(s)
+		if err != nil {
+			t.Errorf("DecodeString(%q) failed: %v", s, err)
+			continue
+		}
+		msg := fmt.Sprintf("DecodeString(%q): got %%q, want %%q", s)
+		testEqual(t, msg, bigtest.decoded, string(res))
+	}
+	testEqual(t, "EncodeToString: got %q, want %q", bigtest.encoded, enc.EncodeToString([]byte(bigtest.decoded)))
+	if _, err := enc.DecodeString("2ukV_BARx"); err == nil {
+		t.Errorf("DecodeString(%q): expected invalid character error", "2ukV_BARx")
+	}
+	if !enc.Equal(base58.StdEncoding.WithIgnore("-\n\r\t ")) || enc.Equal(base58.StdEncoding) {
+		t.Errorf("Equal does not account for the ignore set")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("WithIgnore(\"z\") did not panic")
+		}
+	}()
+	base58.StdEncoding.WithIgnore("z")
+}
+
+func TestWithWrap(t *testing.T) {
+	enc := base58.StdEncoding.WithWrap(16)
+	want := "2ukVBARx4fMCUZXa\nHR1XvNbb3HgzmGYF\nEEThDa86tN2q8oU"
+	testEqual(t, "wrapped EncodeToString: got %q, want %q", want, enc.EncodeToString([]byte(bigtest.decoded)))
+	res, err := enc.DecodeString(strings.ReplaceAll(want, "\n", "\r\n"))
+	if err != nil || string(res) != bigtest.decoded {
+		t.Errorf("wrapped DecodeString(CRLF): got %q, %v", res, err)
+	}
+
+	bb := &strings.Builder{}
+	w := base58.NewEncoder(enc, bb)
+	w.Write([]byte(bigtest.decoded))
+	w.Close()
+	testEqual(t, "wrapped stream Encode: got %q, want %q", want, bb.String())
+
+	// the stream decoder skips CR/LF for any encoding
+	decoded, err := io.ReadAll(base58.NewDecoder(base58.StdEncoding, strings.NewReader(want+"\r\n")))
+	if err != nil || string(decoded) != bigtest.decoded {
+		t.Errorf("stream decoding of wrapped input: got %q, %v", decoded, err)
+	}
+	testEqual(t, "short input is not wrapped: got %q, want %q", "E2XFRyo", enc.EncodeToString([]byte("sure.")))
+}
+
+func TestStrictLenient(t *testing.T) {
+	spaced := " E2X\tFR\r\nyo\n"
+	if _, err := base58.StdEncoding.DecodeString(spaced); err == nil {
+		t.Errorf("StdEncoding.DecodeString(%q): expected error", spaced)
+	}
+	lenient := base58.StdEncoding.Lenient()
+	res, err := lenient.DecodeString(spaced)
+	if err != nil {
+		t.Fatalf("Lenient DecodeString(%q) failed: %v", spaced, err)
+	}
+	testEqual(t, "Lenient DecodeString: got %q, want %q", "sure.", string(res))
+	if _, err := lenient.DecodeString("E2X-FRyo"); err == nil {
+		t.Errorf("Lenient DecodeString: expected error for non-whitespace")
+	}
+
+	strict := lenient.WithIgnore("-").Strict()
+	for _, s := range []string{spaced, "E2X-FRyo"} {
+		if _, err := strict.DecodeString(s); err == nil {
+			t.Errorf("Strict DecodeString(%q): expected error", s)
+		}
+	}
+	if !strict.Equal(base58.StdEncoding) {
+		t.Errorf("Strict() of a lenient encoding should equal StdEncoding")
+	}
+	if lenient.Equal(base58.StdEncoding) {
+		t.Errorf("Lenient() should not equal StdEncoding")
+	}
+}
+
+func TestWithInvalidHandler(t *testing.T) {
+	var positions []int
+	enc := base58.StdEncoding.WithInvalidHandler(func(b byte, pos int) (byte, bool, error) {
+		positions = append(positions, pos)
+		switch b {
+		case '0':
+			return 'o', false, nil
+		case '~':
+			return 0, true, nil
+		}
+		return 0, false, fmt.Errorf("bad byte %q", b)
+	})
+	res, err := enc.DecodeString("E2XFRy0~")
+	if err != nil {
+		t.Fatalf("DecodeString failed: %v", err)
+	}
+	testEqual(t, "DecodeString with handler: got %q, want %q", "sure.", string(res))
+	testEqual(t, "handler positions: got %v, want %v", "[6 7]", fmt.Sprint(positions))
+
+	if _, err := enc.DecodeString("E2XFRy#"); err == nil || err.Error() != `bad byte '#'` {
+		t.Errorf("DecodeString(abort): got %v, want handler error", err)
+	}
+	// a replacement outside the alphabet still fails
+	bad := base58.StdEncoding.WithInvalidHandler(func(b byte, pos int) (byte, bool, error) {
+		return 'O', false, nil
+	})
+	if _, err := bad.DecodeString("E2XFRy0"); err == nil {
+		t.Errorf("DecodeString(bad replacement): expected invalid character error")
+	}
+	if !enc.Equal(enc) || enc.Equal(base58.StdEncoding) {
+		t.Errorf("Equal with handler: want only identity to compare equal")
+	}
+}
+
+func TestWithZeroDigit(t *testing.T) {
+	enc := base58.StdEncoding.WithZeroDigit('0')
+	tests := []testpair{
+		{"\x00\x00sure.", "00E2XFRyo"},
+		{"\x00\x00\x00", "000"},
+		{"\x00\x01", "02"},
+		{"sure.", "E2XFRyo"},
+	}
+	for _, p := range tests {
+		got := enc.EncodeToString([]byte(p.decoded))
+		msg := fmt.Sprintf("WithZeroDigit EncodeToString(%q): got %%q, want %%q", p.decoded)
+		testEqual(t, msg, p.encoded, got)
+		res, err := enc.DecodeString(p.encoded)
+		if err != nil {
+			t.Errorf("WithZeroDigit DecodeString(%q) failed: %v", p.encoded, err)
+			continue
+		}
+		msg = fmt.Sprintf("WithZeroDigit DecodeString(%q): got %%q, want %%q", p.encoded)
+		testEqual(t, msg, p.decoded, string(res))
+	}
+	// the zero digit is only valid as a leading character
+	if _, err := enc.DecodeString("E20XFRyo"); err == nil {
+		t.Errorf("WithZeroDigit DecodeString(%q): expected invalid character error", "E20XFRyo")
+	}
+	// padding uses the zero digit as well, on every encode path
+	padded := enc.WithPadWidth(12)
+	for _, in := range [][]byte{nil, {0, 0}, []byte("sure.")} {
+		want := padded.EncodeToString(in)
+		buf := make([]byte, padded.EncodedLen(len(in)))
+		copy(buf, in)
+		n, err := padded.EncodeInPlace(buf, len(in))
+		if err != nil || string(buf[:n]) != want {
+			t.Errorf("WithZeroDigit padded EncodeInPlace(%x) = %q, %v, want EncodeToString %q", in, buf[:n], err, want)
+		}
+	}
+	testEqual(t, "WithZeroDigit padded EncodeToString(nil): got %q, want %q", "000000000000", padded.EncodeToString(nil))
+	if !enc.WithZeroDigit('1').Equal(base58.StdEncoding) {
+		t.Errorf("WithZeroDigit(alphabet[0]) should restore the default")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("WithZeroDigit('A'): expected panic for alphabet character")
+		}
+	}()
+	base58.StdEncoding.WithZeroDigit('A')
+}
+
+func TestPadWidthRoundTrip(t *testing.T) {
+	// 5 digits always hold 3 bytes
+	enc := base58.StdEncoding.WithPadWidth(5)
+	testEqual(t, "PadSize: got %d, want %d", 3, enc.PadSize())
+	tests := []struct {
+		src     []byte
+		encoded string
+	}{
+		// zero bytes of a PadSize payload are kept
+		{[]byte{0, 0, 1}, "11112"},
+		{[]byte{0xff, 0xff, 0xff}, "2UzHL"},
+		// a value wider than the pad width overflows it unpadded
+		{[]byte{0xff, 0xff, 0xff, 0xff}, "7YXq9G"},
+	}
+	for _, tt := range tests {
+		s := enc.EncodeToString(tt.src)
+		testEqual(t, "WithPadWidth(5) EncodeToString = %q, want %q", tt.encoded, s)
+		decodes := map[string]func(s string) ([]byte, error){
+			"DecodeString": enc.DecodeString,
+			"DecodeInto": func(s string) ([]byte, error) {
+				dst := make([]byte, 8)
+				n, err := enc.DecodeInto(dst, []byte(s))
+				return dst[:n], err
+			},
+			"DecodeInPlace": func(s string) ([]byte, error) {
+				b := []byte(s)
+				n, err := enc.DecodeInPlace(b)
+				return b[:n], err
+			},
+			"NewDecoder": func(s string) ([]byte, error) {
+				return io.ReadAll(base58.NewDecoder(enc, strings.NewReader(s)))
+			},
+		}
+		for name, decode := range decodes {
+			if got, err := decode(s); err != nil || !bytes.Equal(got, tt.src) {
+				t.Errorf("WithPadWidth(5) %s(%q) = %x, %v, want %x", name, s, got, err, tt.src)
+			}
+		}
+		if err := enc.Validate(s); err != nil {
+			t.Errorf("WithPadWidth(5) Validate(%q): %v", s, err)
+		}
+	}
+	// longer strings with a leading zero digit are not overflow results
+	if _, err := enc.DecodeString("111112"); !errors.Is(err, base58.ErrInvalidLength) {
+		t.Errorf("WithPadWidth(5) DecodeString(%q): got error %v, want %v", "111112", err, base58.ErrInvalidLength)
+	}
+}
@@ -0,0 +1,113 @@
+package base58
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+/*
+Multi-Part Payloads
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+SplitParts encodes a payload as N Base58Check strings for QR code and
+air-gapped transfer, where each code holds only a few hundred characters.
+Every part is independently verifiable and carries a small header:
+
+	version (1) | index (2) | total (2) | payload id (4) | chunk
+
+all big-endian, the payload id being the first 4 bytes of SHA256(payload).
+JoinParts accepts the parts in any order, ignores duplicates and verifies
+the reassembled payload against the id.
+*/
+
+const (
+	partVersion   = 1
+	partHeaderLen = 9
+	// maximum number of parts of a payload
+	MaxParts = 1<<16 - 1
+)
+
+var (
+	// part is not a valid multi-part string
+	ErrInvalidPart = errors.New("base58: invalid part")
+	// parts belong to different payloads
+	ErrPartMismatch = errors.New("base58: parts belong to different payloads")
+	// not every part of the payload was given
+	ErrMissingParts = errors.New("base58: missing parts")
+)
+
+// split payload into n checked parts of near-equal size, 1 <= n <= MaxParts
+// and n <= len(payload) unless payload is empty and n is 1
+func SplitParts(payload []byte, n int) ([]string, error) {
+	if n < 1 || n > MaxParts || (n > len(payload) && n > 1) {
+		return nil, fmt.Errorf("%w: cannot split %d bytes into %d parts", ErrInvalidPart, len(payload), n)
+	}
+	sum := sha256.Sum256(payload)
+	parts := make([]string, n)
+	for i := 0; i < n; i++ {
+		chunk := payload[i*len(payload)/n : (i+1)*len(payload)/n]
+		b := make([]byte, partHeaderLen, partHeaderLen+len(chunk))
+		b[0] = partVersion
+		binary.BigEndian.PutUint16(b[1:], uint16(i))
+		binary.BigEndian.PutUint16(b[3:], uint16(n))
+		copy(b[5:], sum[:4])
+		parts[i] = CheckEncode(append(b, chunk...))
+	}
+	return parts, nil
+}
+
+// reassemble a payload from its parts, given in any order
+func JoinParts(parts []string) ([]byte, error) {
+	var (
+		chunks [][]byte
+		id     []byte
+		have   int
+	)
+	for _, s := range parts {
+		b, err := CheckDecode(s)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidPart, err)
+		}
+		if len(b) < partHeaderLen || b[0] != partVersion {
+			return nil, fmt.Errorf("%w: bad header", ErrInvalidPart)
+		}
+		index := int(binary.BigEndian.Uint16(b[1:]))
+		total := int(binary.BigEndian.Uint16(b[3:]))
+		if total == 0 || index >= total {
+			return nil, fmt.Errorf("%w: part %d of %d", ErrInvalidPart, index, total)
+		}
+		if chunks == nil {
+			chunks, id = make([][]byte, total), b[5:9]
+		} else if total != len(chunks) || string(b[5:9]) != string(id) {
+			return nil, ErrPartMismatch
+		}
+		if chunks[index] == nil {
+			chunks[index] = b[partHeaderLen:]
+			have++
+		}
+	}
+	if chunks == nil {
+		return nil, fmt.Errorf("%w: no parts", ErrMissingParts)
+	}
+	if have < len(chunks) {
+		var missing []int
+		for i, c := range chunks {
+			if c == nil {
+				missing = append(missing, i)
+			}
+		}
+		return nil, fmt.Errorf("%w: %v of %d", ErrMissingParts, missing, len(chunks))
+	}
+	var payload []byte
+	for _, c := range chunks {
+		payload = append(payload, c...)
+	}
+	if sum := sha256.Sum256(payload); string(sum[:4]) != string(id) {
+		return nil, ErrChecksumMismatch
+	}
+	return payload, nil
+}
@@ -0,0 +1,51 @@
+package base58_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestSplitJoinParts(t *testing.T) {
+	payload := []byte(bigtest.decoded + bigtest.decoded)
+	for _, n := range []int{1, 2, 7, len(payload)} {
+		parts, err := base58.SplitParts(payload, n)
+		if err != nil {
+			t.Fatalf("SplitParts(%d) failed: %v", n, err)
+		}
+		testEqual(t, "SplitParts count: got %d, want %d", n, len(parts))
+
+		// reversed order with a duplicate
+		shuffled := []string{parts[0]}
+		for i := len(parts) - 1; i >= 0; i-- {
+			shuffled = append(shuffled, parts[i])
+		}
+		got, err := base58.JoinParts(shuffled)
+		if err != nil {
+			t.Fatalf("JoinParts(%d) failed: %v", n, err)
+		}
+		testEqual(t, "JoinParts: got %q, want %q", string(payload), string(got))
+	}
+
+	parts, _ := base58.SplitParts(payload, 3)
+	other, _ := base58.SplitParts([]byte("another payload"), 3)
+	tests := []struct {
+		parts []string
+		want  error
+	}{
+		{parts[:2], base58.ErrMissingParts},
+		{nil, base58.ErrMissingParts},
+		{[]string{parts[0], other[1], parts[2]}, base58.ErrPartMismatch},
+		{[]string{parts[0], parts[1], parts[2][:len(parts[2])-1] + "1"}, base58.ErrInvalidPart},
+		{[]string{base58.CheckEncode([]byte("not a part"))}, base58.ErrInvalidPart},
+	}
+	for i, tt := range tests {
+		if _, err := base58.JoinParts(tt.parts); !errors.Is(err, tt.want) {
+			t.Errorf("JoinParts case %d: got %v, want %v", i, err, tt.want)
+		}
+	}
+	if _, err := base58.SplitParts([]byte("ab"), 3); !errors.Is(err, base58.ErrInvalidPart) {
+		t.Errorf("SplitParts(too many parts): got %v, want %v", err, base58.ErrInvalidPart)
+	}
+}
@@ -0,0 +1,95 @@
+package base58
+
+import (
+	"io"
+	"os"
+)
+
+/*
+Progress Reporting
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Long conversions of multi-GB payloads can report how far they are, so a
+GUI or CLI can show a progress bar without wrapping readers itself:
+
+	enc := base58.StdEncoding.WithProgress(func(done, total int64) {
+		fmt.Fprintf(os.Stderr, "\r%d / %d bytes", done, total)
+	})
+	err := base58.EncodeFile(enc, "snapshot.b58", "snapshot.bin")
+
+The callback gets the input bytes processed so far and the total input
+size, or -1 while it is unknown. EncodeFile and DecodeFile know the size
+of the source file, EncodeStream, DecodeStream, EncodeReader and the stream
+decoder take it from an *os.File or a reader with a Len() int method
+such as bytes.Reader. The stream encoder reports the bytes written to it.
+A last call once the input is complete always has done == total. The
+callback is called synchronously from the reading or writing goroutine
+after every chunk, so it should be cheap.
+*/
+
+// progress callback, given the input bytes processed so far and the total
+// input size or -1 if unknown
+type ProgressFunc func(done, total int64)
+
+// return a copy of enc that reports the progress of its stream
+// conversions to fn, nil disables reporting. Progress does not change the
+// encoding, so Equal ignores it and it is not serialized.
+func (enc *Encoding) WithProgress(fn ProgressFunc) *Encoding {
+	e := *enc
+	e.progress = fn
+	return &e
+}
+
+// return the size of the data r yields, -1 if unknown
+func readerSize(r io.Reader) int64 {
+	switch r := r.(type) {
+	case *os.File:
+		if info, err := r.Stat(); err == nil && info.Mode().IsRegular() {
+			if off, err := r.Seek(0, io.SeekCurrent); err == nil {
+				return info.Size() - off
+			}
+		}
+	case interface{ Len() int }:
+		// bytes.Reader, strings.Reader and bytes.Buffer report what is
+		// left to read
+		return int64(r.Len())
+	}
+	return -1
+}
+
+// reader reporting the bytes read through it to a ProgressFunc
+type progressReader struct {
+	r     io.Reader
+	fn    ProgressFunc
+	done  int64
+	total int64
+	eof   bool // final call made
+}
+
+// return r reporting its progress to the callback of enc with the size of
+// r as the total, or r itself if enc has none
+func (enc *Encoding) withProgress(r io.Reader) io.Reader {
+	if enc.progress == nil {
+		return r
+	}
+	return &progressReader{r: r, fn: enc.progress, total: readerSize(r)}
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if n > 0 {
+		p.done += int64(n)
+		p.fn(p.done, p.total)
+	}
+	if err == io.EOF && !p.eof {
+		p.eof = true
+		// the total is known now, report it unless the last call did
+		if p.done == 0 || p.total != p.done {
+			p.total = p.done
+			p.fn(p.done, p.total)
+		}
+	}
+	return n, err
+}
@@ -0,0 +1,126 @@
+package base58_test
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+// progress callback recording its calls and checking they never go
+// backwards
+type progressLog struct {
+	t     *testing.T
+	calls [][2]int64
+}
+
+func (p *progressLog) report(done, total int64) {
+	if n := len(p.calls); n > 0 && done < p.calls[n-1][0] {
+		p.t.Errorf("progress went back from %d to %d", p.calls[n-1][0], done)
+	}
+	p.calls = append(p.calls, [2]int64{done, total})
+}
+
+// check the last call reports done == total == want and return the totals
+// reported before it
+func (p *progressLog) check(name string, want int64) []int64 {
+	p.t.Helper()
+	if len(p.calls) == 0 {
+		p.t.Errorf("%s: no progress reported", name)
+		return nil
+	}
+	last := p.calls[len(p.calls)-1]
+	if last != [2]int64{want, want} {
+		p.t.Errorf("%s: last progress = %v, want [%d %d]", name, last, want, want)
+	}
+	var totals []int64
+	for _, c := range p.calls[:len(p.calls)-1] {
+		totals = append(totals, c[1])
+	}
+	p.calls = nil
+	return totals
+}
+
+func TestProgressStreams(t *testing.T) {
+	p := &progressLog{t: t}
+	enc := base58.StdEncoding.WithProgress(p.report)
+	data := []byte(bigtest.decoded)
+
+	// sized readers report their total throughout
+	var block bytes.Buffer
+	if _, err := base58.EncodeStream(enc, &block, bytes.NewReader(data)); err != nil {
+		t.Fatalf("EncodeStream: %v", err)
+	}
+	for _, total := range p.check("EncodeStream", int64(len(data))) {
+		testEqual(t, "EncodeStream total = %v, want %v", int64(len(data)), total)
+	}
+	text := block.String()
+	if _, err := base58.DecodeStream(enc, io.Discard, strings.NewReader(text)); err != nil {
+		t.Fatalf("DecodeStream: %v", err)
+	}
+	p.check("DecodeStream", int64(len(text)))
+
+	// unsized ones report -1 until the end
+	encoded := base58.StdEncoding.EncodeToString(data)
+	got, err := base58.DecodeReader(enc, io.MultiReader(strings.NewReader(encoded)))
+	if err != nil || !bytes.Equal(got, data) {
+		t.Fatalf("DecodeReader: got %d bytes, %v", len(got), err)
+	}
+	for _, total := range p.check("DecodeReader", int64(len(encoded))) {
+		testEqual(t, "DecodeReader total = %v, want %v", int64(-1), total)
+	}
+	if s, err := base58.EncodeReader(enc, bytes.NewReader(data)); err != nil || s != encoded {
+		t.Fatalf("EncodeReader: %v", err)
+	}
+	p.check("EncodeReader", int64(len(data)))
+
+	w := base58.NewEncoder(enc, io.Discard)
+	w.Write(data[:10])
+	io.WriteString(w, string(data[10:20]))
+	w.(io.ByteWriter).WriteByte(data[20])
+	testEqual(t, "NewEncoder progress before Close = %v, want %v", [2]int64{21, -1}, p.calls[len(p.calls)-1])
+	w.Close()
+	p.check("NewEncoder", 21)
+
+	// empty input still gets a final call
+	base58.EncodeStream(enc, io.Discard, strings.NewReader(""))
+	p.check("EncodeStream(empty)", 0)
+
+	// no callback, no wrapping
+	if _, err := base58.EncodeStream(base58.StdEncoding, io.Discard, bytes.NewReader(data)); err != nil || len(p.calls) != 0 {
+		t.Errorf("EncodeStream without progress: %v, %d calls", err, len(p.calls))
+	}
+}
+
+func TestProgressFiles(t *testing.T) {
+	dir := t.TempDir()
+	p := &progressLog{t: t}
+	enc := base58.StdEncoding.WithProgress(p.report)
+	for _, n := range []int{5000, 1<<20 + 1} {
+		src := filepath.Join(dir, "snapshot.bin")
+		os.WriteFile(src, make([]byte, n), 0o600)
+		armored := filepath.Join(dir, "snapshot.b58")
+		if err := base58.EncodeFile(enc, armored, src); err != nil {
+			t.Fatalf("EncodeFile(%d bytes): %v", n, err)
+		}
+		for _, total := range p.check("EncodeFile", int64(n)) {
+			testEqual(t, "EncodeFile total = %v, want %v", int64(n), total)
+		}
+
+		info, _ := os.Stat(armored)
+		if err := base58.DecodeFile(enc, filepath.Join(dir, "restored.bin"), armored); err != nil {
+			t.Fatalf("DecodeFile(%d bytes): %v", n, err)
+		}
+		for _, total := range p.check("DecodeFile", info.Size()) {
+			testEqual(t, "DecodeFile total = %v, want %v", info.Size(), total)
+		}
+	}
+
+	if !enc.Equal(base58.StdEncoding) {
+		t.Error("Equal: WithProgress changed the encoding")
+	}
+}
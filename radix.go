@@ -0,0 +1,138 @@
+package base58
+
+import (
+	"context"
+	"math"
+)
+
+/*
+Radix Conversion
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+The digit conversion engine behind every Encoding, exported for callers that
+need the numeric conversion with their own character mapping layer.
+
+Encodings of the same radix differ only in their alphabet, so Translate
+remaps between them with a table lookup and no conversion at all.
+*/
+
+// convert the most-significant-first digit values in src from fromBase to
+// toBase (both 2 to 256). Each leading zero digit of src becomes one leading
+// zero digit of the result, the same convention base58 applies to leading
+// zero bytes. Panics if a base is out of range or a digit is not below
+// fromBase.
+func ConvertRadix(src []byte, fromBase, toBase int) []byte {
+	if fromBase < 2 || fromBase > 256 || toBase < 2 || toBase > 256 {
+		panic("base58: ConvertRadix bases must be between 2 and 256")
+	}
+	for _, d := range src {
+		if int(d) >= fromBase {
+			panic("base58: ConvertRadix digit out of range for base")
+		}
+	}
+	return convertRadix(src, fromBase, toBase)
+}
+
+// repeated division conversion, src is not modified. Buffers come from
+// make, so small conversions stay off the heap.
+func convertRadix(src []byte, fromBase, toBase int) []byte {
+	zeros := leadingZeros(src)
+	number := make([]byte, len(src)-zeros)
+	copy(number, src[zeros:])
+	out := make([]byte, 0, radixLen(zeros, len(number), fromBase, toBase))
+	out, _ = divideOut(nil, out, number, zeros, fromBase, toBase)
+	return out
+}
+
+// output digits produced between cancellation checks
+const cancelCheckDigits = 64
+
+// convertRadix that gives up with ctx.Err() once ctx is done, taking its
+// buffers from alloc if not nil
+func convertRadixContext(ctx context.Context, alloc Allocator, src []byte, fromBase, toBase int) ([]byte, error) {
+	zeros := leadingZeros(src)
+	// the number is divided in place down to zero, so the scratch copy
+	// holds nothing of src afterwards
+	number := getBuffer(alloc, len(src)-zeros)
+	copy(number, src[zeros:])
+	defer putBuffer(alloc, number)
+	out := getBuffer(alloc, radixLen(zeros, len(number), fromBase, toBase))[:0]
+	out, ok := divideOut(ctx.Done(), out, number, zeros, fromBase, toBase)
+	if !ok {
+		putBuffer(alloc, out)
+		return nil, ctx.Err()
+	}
+	return out, nil
+}
+
+// number of leading zero digits of src
+func leadingZeros(src []byte) int {
+	zeros := 0
+	for zeros < len(src) && src[zeros] == 0 {
+		zeros++
+	}
+	return zeros
+}
+
+// room for the largest number of n digits after zeros leading zero digits,
+// so the output never reallocates and leaves partial copies behind
+func radixLen(zeros, n, fromBase, toBase int) int {
+	return zeros + int(float64(n)*math.Log2(float64(fromBase))/math.Log2(float64(toBase))) + 1
+}
+
+// divide number down to zero, appending the digits to out most significant
+// first followed by zeros zero digits. Reports false, with both buffers
+// cleared, if done is closed first.
+func divideOut(done <-chan struct{}, out, number []byte, zeros, fromBase, toBase int) ([]byte, bool) {
+	for start := 0; start < len(number); {
+		if done != nil && len(out)%cancelCheckDigits == 0 {
+			select {
+			case <-done:
+				clear(number)
+				clear(out)
+				return out, false
+			default:
+			}
+		}
+		out = append(out, byte(divmod(number[start:], fromBase, toBase)))
+		for start < len(number) && number[start] == 0 {
+			start++
+		}
+	}
+	for i := 0; i < zeros; i++ {
+		out = append(out, 0)
+	}
+	reverseBytes(out)
+	return out, true
+}
+
+// remap s from srcEnc's alphabet to dstEnc's positionally, equivalent to
+// dstEnc.EncodeToString(srcEnc.DecodeString(s)) without the numeric round
+// trip. Characters srcEnc skips on decode are dropped and dstEnc's
+// formatting options are not applied. Panics if the radixes differ.
+func Translate(dstEnc, srcEnc *Encoding, s string) (string, error) {
+	if dstEnc.base != srcEnc.base {
+		panic("base58: Translate requires encodings of the same radix")
+	}
+	out := make([]byte, 0, len(s))
+	leading := true
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		val := srcEnc.reverse[c]
+		if leading && srcEnc.zero != 0 && c == srcEnc.zero {
+			val = 0
+		}
+		switch {
+		case val == 0 && leading:
+			out = append(out, dstEnc.zeroDigit())
+		case val != -1:
+			leading = false
+			out = append(out, dstEnc.encode[val])
+		case !srcEnc.ignored(c):
+			return "", CorruptInputError(i)
+		}
+	}
+	return string(out), nil
+}
@@ -0,0 +1,198 @@
+package base58_test
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+const (
+	base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	base36Alphabet = "0123456789abcdefghijklmnopqrstuvwxyz"
+	base45Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ$%*+-./:"
+)
+
+func TestRadixEncoding(t *testing.T) {
+	for _, alphabet := range []string{"01", base36Alphabet, base45Alphabet, base62Alphabet, base58.BitcoinAlphabet} {
+		enc := base58.NewRadixEncoding(alphabet)
+		testEqual(t, "Radix(): got %d, want %d", len(alphabet), enc.Radix())
+		testEqual(t, "Alphabet(): got %q, want %q", alphabet, enc.Alphabet())
+		for _, p := range append(pairs, bigtest) {
+			encoded := enc.EncodeToString([]byte(p.decoded))
+			// without leading zero bytes the encoding is the number in the radix
+			if n := new(big.Int).SetBytes([]byte(p.decoded)); n.Sign() > 0 && p.decoded[0] != 0 {
+				want := n.Text(len(alphabet))
+				if len(alphabet) <= 36 {
+					msg := fmt.Sprintf("radix %d EncodeToString(%q): got %%q, want %%q", len(alphabet), p.decoded)
+					testEqual(t, msg, want, strings.ToLower(encoded))
+				}
+			}
+			decoded, err := enc.DecodeString(encoded)
+			if err != nil {
+				t.Errorf("radix %d DecodeString(%q) failed: %v", len(alphabet), encoded, err)
+				continue
+			}
+			msg := fmt.Sprintf("radix %d round trip of %q: got %%q, want %%q", len(alphabet), p.decoded)
+			testEqual(t, msg, p.decoded, string(decoded))
+		}
+	}
+	if !base58.NewRadixEncoding(base58.BitcoinAlphabet).Equal(base58.StdEncoding) {
+		t.Errorf("Equal: radix-58 bitcoin alphabet differs from StdEncoding")
+	}
+	if base58.NewRadixEncoding(base62Alphabet).Equal(base58.StdEncoding) {
+		t.Errorf("Equal: base62 compares equal to StdEncoding")
+	}
+	testEqual(t, "String(): got %q, want %q", "base36(custom:"+base36Alphabet+")", base58.NewRadixEncoding(base36Alphabet).String())
+}
+
+func TestRadixEncodingMachinery(t *testing.T) {
+	enc := base58.NewRadixEncoding(base62Alphabet)
+	bb := &strings.Builder{}
+	w := base58.NewEncoder(enc, bb)
+	w.Write([]byte(bigtest.decoded))
+	w.Close()
+	decoded, err := io.ReadAll(base58.NewDecoder(enc, strings.NewReader(bb.String())))
+	if err != nil {
+		t.Fatalf("base62 stream decode failed: %v", err)
+	}
+	testEqual(t, "base62 stream round trip: got %q, want %q", bigtest.decoded, string(decoded))
+
+	u, err := enc.DecodeUint64(enc.EncodeUint64(1 << 63))
+	if err != nil || u != 1<<63 {
+		t.Errorf("base62 Uint64 round trip: got %d, %v", u, err)
+	}
+	binary := base58.NewRadixEncoding("01")
+	testEqual(t, "base2 EncodeUint64: got %q, want %q", strings.Repeat("1", 64), binary.EncodeUint64(1<<64-1))
+}
+
+func TestNewRadixEncodingPanics(t *testing.T) {
+	for _, alphabet := range []string{"0", "0123456789ab cdef", "00", "\x00\x01", strings.Repeat("x", 95)} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewRadixEncoding(%q) did not panic", alphabet)
+				}
+			}()
+			base58.NewRadixEncoding(alphabet)
+		}()
+	}
+}
+
+func TestNewEncodingTables(t *testing.T) {
+	var decode [256]int8
+	for i := range decode {
+		decode[i] = -1
+	}
+	for i := 0; i < len(base58.BitcoinAlphabet); i++ {
+		decode[base58.BitcoinAlphabet[i]] = int8(i)
+	}
+	enc := base58.NewEncodingTables("tables", base58.BitcoinAlphabet, &decode)
+	testEqual(t, "Equal(StdEncoding) = %v, want %v", true, enc.Equal(base58.StdEncoding))
+	testEqual(t, "Name() = %q, want %q", "tables", enc.Name())
+	testEqual(t, "EncodeToString = %q, want %q", "E2XFRyo", enc.EncodeToString([]byte("sure.")))
+
+	bad := []func(d *[256]int8) string{
+		func(d *[256]int8) string { d['1'] = 1; return base58.BitcoinAlphabet },
+		func(d *[256]int8) string { d['0'] = 5; return base58.BitcoinAlphabet },
+		func(d *[256]int8) string { d['1'] = -1; return base58.BitcoinAlphabet },
+		func(d *[256]int8) string { return base58.BitcoinAlphabet[:57] },
+		func(d *[256]int8) string { return "x" },
+	}
+	for i, corrupt := range bad {
+		d := decode
+		alphabet := corrupt(&d)
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewEncodingTables with bad table %d did not panic", i)
+				}
+			}()
+			base58.NewEncodingTables("bad", alphabet, &d)
+		}()
+	}
+}
+
+func TestConvertRadix(t *testing.T) {
+	tests := []struct {
+		src              []byte
+		fromBase, toBase int
+		want             []byte
+	}{
+		{nil, 256, 58, nil},
+		{[]byte{0, 0}, 256, 58, []byte{0, 0}},
+		{[]byte{1, 0}, 256, 10, []byte{2, 5, 6}},
+		{[]byte{2, 5, 6}, 10, 256, []byte{1, 0}},
+		{[]byte{0, 2, 5, 5}, 10, 2, []byte{0, 1, 1, 1, 1, 1, 1, 1, 1}},
+		{[]byte{57, 57}, 58, 256, []byte{0x0d, 0x23}},
+	}
+	for _, tt := range tests {
+		got := base58.ConvertRadix(tt.src, tt.fromBase, tt.toBase)
+		if string(got) != string(tt.want) {
+			t.Errorf("ConvertRadix(%v, %d, %d): got %v, want %v", tt.src, tt.fromBase, tt.toBase, got, tt.want)
+		}
+	}
+	// agrees with the alphabet layer
+	digits := base58.ConvertRadix([]byte(bigtest.decoded), 256, 58)
+	encoded := make([]byte, len(digits))
+	for i, d := range digits {
+		encoded[i] = base58.BitcoinAlphabet[d]
+	}
+	testEqual(t, "ConvertRadix vs EncodeToString: got %q, want %q", bigtest.encoded, string(encoded))
+}
+
+func TestConvertRadixPanics(t *testing.T) {
+	tests := []struct {
+		src              []byte
+		fromBase, toBase int
+	}{
+		{nil, 1, 58},
+		{nil, 58, 257},
+		{[]byte{58}, 58, 256},
+	}
+	for _, tt := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("ConvertRadix(%v, %d, %d) did not panic", tt.src, tt.fromBase, tt.toBase)
+				}
+			}()
+			base58.ConvertRadix(tt.src, tt.fromBase, tt.toBase)
+		}()
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	for _, p := range append(pairs, bigtest) {
+		want := base58.RippleEncoding.EncodeToString([]byte(p.decoded))
+		got, err := base58.Translate(base58.RippleEncoding, base58.StdEncoding, p.encoded)
+		if err != nil {
+			t.Errorf("Translate(%q) failed: %v", p.encoded, err)
+			continue
+		}
+		msg := fmt.Sprintf("Translate(ripple, bitcoin, %q): got %%q, want %%q", p.encoded)
+		testEqual(t, msg, want, got)
+
+		back, _ := base58.Translate(base58.StdEncoding, base58.RippleEncoding, got)
+		msg = fmt.Sprintf("Translate(bitcoin, ripple, %q): got %%q, want %%q", got)
+		testEqual(t, msg, p.encoded, back)
+	}
+
+	zero := base58.StdEncoding.WithZeroDigit('0')
+	got, _ := base58.Translate(base58.FlickrEncoding, zero, "00E2XFRyo")
+	testEqual(t, "Translate(zero digit): got %q, want %q", base58.FlickrEncoding.EncodeToString([]byte("\x00\x00sure.")), got)
+
+	if _, err := base58.Translate(base58.RippleEncoding, base58.StdEncoding, "E2X0"); err != base58.CorruptInputError(3) {
+		t.Errorf("Translate(invalid): got %v, want %v", err, base58.CorruptInputError(3))
+	}
+}
+
+func BenchmarkTranslate(b *testing.B) {
+	s := base58.StdEncoding.EncodeToString(make([]byte, 32))
+	for i := 0; i < b.N; i++ {
+		base58.Translate(base58.RippleEncoding, base58.StdEncoding, s)
+	}
+}
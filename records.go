@@ -0,0 +1,206 @@
+package base58
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+/*
+Base58Check Record Streams
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Log formats and export files often pack many Base58Check strings into one
+stream, either separated by a delimiter byte or each preceded by its length.
+CheckRecordReader splits such a stream into records, verifies each checksum
+and returns the payloads one at a time:
+
+	rr := base58.NewCheckRecordReader(base58.StdCheckEncoding, f, '\n')
+	for {
+		payload, err := rr.Next()
+		if err == io.EOF {
+			break
+		}
+		var re *base58.RecordError
+		if errors.As(err, &re) {
+			log.Println(re) // bad record, the stream continues
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		...
+	}
+
+A record that fails its checksum or does not decode is reported as a
+*RecordError and the next call continues with the following record, since
+the framing does not depend on the record contents. Read errors are final.
+*/
+
+// longest record a CheckRecordReader accepts, in encoded characters
+const MaxRecordLen = 64 * 1024
+
+var (
+	// record is longer than MaxRecordLen
+	ErrRecordTooLong = errors.New("base58: record too long")
+	// length prefix is too large to skip the record, which ends the stream
+	ErrRecordLength = errors.New("base58: record length out of range")
+)
+
+// record of a CheckRecordReader that failed to decode or verify
+type RecordError struct {
+	Record int   // index of the record, counting from 0
+	Offset int64 // byte offset of the record in the stream
+	Err    error // ErrChecksumMismatch, ErrRecordTooLong or a decode error
+}
+
+func (e *RecordError) Error() string {
+	return fmt.Sprintf("base58: record %d at offset %d: %v", e.Record, e.Offset, e.Err)
+}
+
+func (e *RecordError) Unwrap() error {
+	return e.Err
+}
+
+// reader of Base58Check records from a delimited or length-prefixed stream
+type CheckRecordReader struct {
+	ce       *CheckEncoding
+	r        *bufio.Reader
+	delim    byte
+	prefixed bool
+	record   int
+	off      int64 // bytes consumed
+	start    int64 // offset of the current record
+	err      error
+}
+
+// return a reader of records separated by delim. Surrounding whitespace
+// is trimmed from each record and empty records are skipped, so '\n' also
+// reads CRLF-terminated lines.
+func NewCheckRecordReader(ce *CheckEncoding, r io.Reader, delim byte) *CheckRecordReader {
+	return &CheckRecordReader{ce: ce, r: bufio.NewReaderSize(r, MaxRecordLen+1), delim: delim}
+}
+
+// return a reader of records each preceded by its length in characters as
+// an unsigned varint, as written by binary.AppendUvarint
+func NewCheckRecordReaderPrefixed(ce *CheckEncoding, r io.Reader) *CheckRecordReader {
+	return &CheckRecordReader{ce: ce, r: bufio.NewReader(r), prefixed: true}
+}
+
+// return the payload of the next record, io.EOF at the end of the stream
+// or a *RecordError for a record that fails to decode or verify
+func (rr *CheckRecordReader) Next() ([]byte, error) {
+	if rr.err != nil {
+		return nil, rr.err
+	}
+	var text []byte
+	var err error
+	if rr.prefixed {
+		text, err = rr.readPrefixed()
+	} else {
+		text, err = rr.readDelimited()
+	}
+	if err != nil {
+		if !errors.Is(err, ErrRecordTooLong) {
+			rr.err = err
+			return nil, err
+		}
+		rr.record++
+		return nil, &RecordError{Record: rr.record - 1, Offset: rr.start, Err: err}
+	}
+	rr.record++
+	payload, err := rr.ce.DecodeString(string(text))
+	if err != nil {
+		return nil, &RecordError{Record: rr.record - 1, Offset: rr.start, Err: err}
+	}
+	return payload, nil
+}
+
+// read the next non-empty delimited record
+func (rr *CheckRecordReader) readDelimited() ([]byte, error) {
+	for {
+		rr.start = rr.off
+		line, err := rr.r.ReadSlice(rr.delim)
+		rr.off += int64(len(line))
+		if err == bufio.ErrBufferFull {
+			// discard the rest of the oversized record
+			for err == bufio.ErrBufferFull {
+				line, err = rr.r.ReadSlice(rr.delim)
+				rr.off += int64(len(line))
+			}
+			if err != nil && err != io.EOF {
+				return nil, err
+			}
+			return nil, ErrRecordTooLong
+		}
+		if err != nil && (err != io.EOF || len(line) == 0) {
+			return nil, err
+		}
+		text := bytes.TrimSpace(bytes.TrimSuffix(line, []byte{rr.delim}))
+		if len(text) > 0 {
+			return text, nil
+		}
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+	}
+}
+
+// read the next length-prefixed record
+func (rr *CheckRecordReader) readPrefixed() ([]byte, error) {
+	rr.start = rr.off
+	cr := &countingByteReader{r: rr.r}
+	n, err := binary.ReadUvarint(cr)
+	rr.off += cr.n
+	if err != nil {
+		if err == io.EOF && cr.n > 0 {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	if n > math.MaxInt64 {
+		// too long to skip, the records after it cannot be found
+		return nil, fmt.Errorf("%w: length prefix %d", ErrRecordLength, n)
+	}
+	if n > MaxRecordLen {
+		skipped, err := io.CopyN(io.Discard, rr.r, int64(n))
+		rr.off += skipped
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		return nil, ErrRecordTooLong
+	}
+	text := make([]byte, n)
+	read, err := io.ReadFull(rr.r, text)
+	rr.off += int64(read)
+	if err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return text, nil
+}
+
+// io.ByteReader counting the bytes read
+type countingByteReader struct {
+	r io.ByteReader
+	n int64
+}
+
+func (c *countingByteReader) ReadByte() (byte, error) {
+	b, err := c.r.ReadByte()
+	if err == nil {
+		c.n++
+	}
+	return b, err
+}
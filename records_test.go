@@ -0,0 +1,132 @@
+package base58_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+// read all records, returning payloads as strings and record errors
+func readRecords(t *testing.T, rr *base58.CheckRecordReader) ([]string, []*base58.RecordError, error) {
+	t.Helper()
+	var payloads []string
+	var rerrs []*base58.RecordError
+	for {
+		payload, err := rr.Next()
+		var re *base58.RecordError
+		switch {
+		case err == nil:
+			payloads = append(payloads, string(payload))
+		case errors.As(err, &re):
+			rerrs = append(rerrs, re)
+		default:
+			if err == io.EOF {
+				err = nil
+			}
+			return payloads, rerrs, err
+		}
+	}
+}
+
+func TestCheckRecordReaderDelimited(t *testing.T) {
+	a := base58.CheckEncode([]byte("first"))
+	b := base58.CheckEncode([]byte("second"))
+	bad := base58.CheckEncode([]byte("third"))
+	bad = bad[:len(bad)-1] + "z"
+	input := a + "\r\n\n" + bad + "\n  " + b + "  \n0OIl\n" + a
+	rr := base58.NewCheckRecordReader(base58.StdCheckEncoding, strings.NewReader(input), '\n')
+	payloads, rerrs, err := readRecords(t, rr)
+	if err != nil {
+		t.Fatalf("Next: %v", err)
+	}
+	testEqual(t, "payloads = %q, want %q", "first second first", strings.Join(payloads, " "))
+	if len(rerrs) != 2 {
+		t.Fatalf("record errors = %v, want 2", rerrs)
+	}
+	if !errors.Is(rerrs[0], base58.ErrChecksumMismatch) || rerrs[0].Record != 1 || rerrs[0].Offset != int64(len(a)+3) {
+		t.Errorf("record error = %+v, want checksum mismatch of record 1 at offset %d", rerrs[0], len(a)+3)
+	}
+	testEqual(t, "second error record = %d, want %d", 3, rerrs[1].Record)
+
+	rr = base58.NewCheckRecordReader(base58.StdCheckEncoding, strings.NewReader(a+","+b+","), ',')
+	payloads, _, err = readRecords(t, rr)
+	if err != nil || strings.Join(payloads, " ") != "first second" {
+		t.Errorf("comma delimited: got %q, %v", payloads, err)
+	}
+}
+
+func TestCheckRecordReaderPrefixed(t *testing.T) {
+	var stream []byte
+	for _, s := range []string{"alpha", "beta", "gamma"} {
+		enc := base58.CheckEncode([]byte(s))
+		if s == "beta" {
+			enc = "1" + enc
+		}
+		stream = binary.AppendUvarint(stream, uint64(len(enc)))
+		stream = append(stream, enc...)
+	}
+	rr := base58.NewCheckRecordReaderPrefixed(base58.StdCheckEncoding, strings.NewReader(string(stream)))
+	payloads, rerrs, err := readRecords(t, rr)
+	if err != nil {
+		t.Fatalf("Next: %v", err)
+	}
+	testEqual(t, "payloads = %q, want %q", "alpha gamma", strings.Join(payloads, " "))
+	if len(rerrs) != 1 || rerrs[0].Record != 1 || !errors.Is(rerrs[0], base58.ErrChecksumMismatch) {
+		t.Errorf("record errors = %v, want a checksum mismatch of record 1", rerrs)
+	}
+
+	// oversized records are skipped, truncated ones end the stream
+	long := binary.AppendUvarint(nil, base58.MaxRecordLen+1)
+	long = append(long, strings.Repeat("x", base58.MaxRecordLen+1)...)
+	long = binary.AppendUvarint(long, 10)
+	long = append(long, "short"...)
+	rr = base58.NewCheckRecordReaderPrefixed(base58.StdCheckEncoding, strings.NewReader(string(long)))
+	if _, err := rr.Next(); !errors.Is(err, base58.ErrRecordTooLong) {
+		t.Errorf("oversized record: got %v, want %v", err, base58.ErrRecordTooLong)
+	}
+	for range 2 {
+		if _, err := rr.Next(); err != io.ErrUnexpectedEOF {
+			t.Errorf("truncated record: got %v, want %v", err, io.ErrUnexpectedEOF)
+		}
+	}
+
+	// a length that cannot be skipped ends the stream instead of reading
+	// the following records from the wrong offset
+	huge := binary.AppendUvarint(nil, 1<<63)
+	huge = binary.AppendUvarint(huge, uint64(len(base58.CheckEncode([]byte("alpha")))))
+	huge = append(huge, base58.CheckEncode([]byte("alpha"))...)
+	rr = base58.NewCheckRecordReaderPrefixed(base58.StdCheckEncoding, strings.NewReader(string(huge)))
+	_, err = rr.Next()
+	var rerr *base58.RecordError
+	if !errors.Is(err, base58.ErrRecordLength) || errors.As(err, &rerr) {
+		t.Errorf("length prefix 1<<63: got %v, want %v", err, base58.ErrRecordLength)
+	}
+	if payload, err2 := rr.Next(); err2 != err {
+		t.Errorf("after length prefix 1<<63: got %q, %v, want %v", payload, err2, err)
+	}
+
+	// reader errors while skipping an oversized record are returned as is
+	skip := binary.AppendUvarint(nil, base58.MaxRecordLen+1)
+	rr = base58.NewCheckRecordReaderPrefixed(base58.StdCheckEncoding, io.MultiReader(bytes.NewReader(skip), iotestErrReader{}))
+	if _, err := rr.Next(); !errors.Is(err, errTestRead) {
+		t.Errorf("read error while skipping: got %v, want %v", err, errTestRead)
+	}
+}
+
+func TestCheckRecordReaderTooLong(t *testing.T) {
+	a := base58.CheckEncode([]byte("ok"))
+	input := strings.Repeat("2", base58.MaxRecordLen+10) + "\n" + a + "\n"
+	rr := base58.NewCheckRecordReader(base58.StdCheckEncoding, strings.NewReader(input), '\n')
+	payloads, rerrs, err := readRecords(t, rr)
+	if err != nil || len(payloads) != 1 || payloads[0] != "ok" {
+		t.Errorf("after oversized record: got %q, %v", payloads, err)
+	}
+	if len(rerrs) != 1 || !errors.Is(rerrs[0], base58.ErrRecordTooLong) {
+		t.Errorf("record errors = %v, want %v", rerrs, base58.ErrRecordTooLong)
+	}
+}
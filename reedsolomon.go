@@ -0,0 +1,374 @@
+package base58
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+/*
+Reed-Solomon Error Correction
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+A checksum can only tell that a paper backup or QR scan was damaged. RSEncoding
+appends Reed-Solomon parity so that damaged characters can be repaired.
+
+Parity is computed on the characters rather than the bytes, since one wrong
+character changes every decoded byte. Digit values are taken as elements of
+GF(59), the smallest field holding all 58 digits, and the text is split into
+blocks of at most 58 characters, the longest Reed-Solomon code over GF(59):
+
+	[adjust][data ... ][parity ... ]
+
+A parity value can be 58, which has no character. Each block therefore
+starts with an adjustment character, picked so that every parity value is a
+digit. The code is MDS, so each parity value depends on the adjustment
+character with a nonzero coefficient. Each parity value then rules out one
+adjustment value, and one of the 58 is always left over. With 2n parity
+characters per block, up to n substituted characters per block are
+corrected. Characters outside the alphabet count as substitutions.
+Insertions and deletions shift the blocks and cannot be repaired.
+
+Beyond its capacity a block may be "corrected" to different data rather
+than rejected, especially with little parity, so protect checked data
+(e.g. a Base58Check payload) when a wrong result must be detected.
+*/
+
+// damage exceeds what the parity can correct
+var ErrUncorrectable = errors.New("base58: too many errors to correct")
+
+const (
+	rsField = 59 // prime field size
+	rsBlock = 58 // longest code over GF(59)
+	rsAlpha = 2  // primitive element of GF(59)
+)
+
+// exponent and logarithm tables of GF(59), exp is doubled to skip a modulo
+var rsExp, rsLog = func() (exp [2 * rsBlock]int, log [rsField]int) {
+	x := 1
+	for i := range exp {
+		exp[i] = x
+		if i < rsBlock {
+			log[x] = i
+		}
+		x = x * rsAlpha % rsField
+	}
+	return exp, log
+}()
+
+func rsMul(a, b int) int {
+	return a * b % rsField
+}
+
+func rsInv(a int) int {
+	return rsExp[rsBlock-rsLog[a]]
+}
+
+// Reed-Solomon protected encoding over a base58 encoding
+type RSEncoding struct {
+	enc         *Encoding
+	corrections int
+	generator   []int // generator polynomial, highest degree first
+}
+
+// protected encoding over enc that corrects up to corrections substituted
+// characters per block of 58, using twice as many parity characters.
+// corrections must be between 1 and 28. Panics unless enc has radix 58 and
+// no pad width or zero digit, which the block layout cannot carry.
+func NewRSEncoding(enc *Encoding, corrections int) *RSEncoding {
+	if enc == nil || enc.base != 58 {
+		panic("base58: Reed-Solomon encoding requires a radix 58 encoding")
+	}
+	if enc.padWidth > 0 || enc.zero != 0 {
+		panic("base58: Reed-Solomon encoding does not support pad width or zero digit")
+	}
+	if corrections < 1 || 2*corrections > rsBlock-2 {
+		panic("base58: Reed-Solomon corrections must be between 1 and 28")
+	}
+	// g(x) = (x - a^1)(x - a^2)...(x - a^2n)
+	g := []int{1}
+	for j := 1; j <= 2*corrections; j++ {
+		next := make([]int, len(g)+1)
+		for i, c := range g {
+			next[i] = (next[i] + c) % rsField
+			next[i+1] = (next[i+1] + rsField - rsMul(c, rsExp[j])) % rsField
+		}
+		g = next
+	}
+	return &RSEncoding{enc: enc, corrections: corrections, generator: g}
+}
+
+// return the underlying base58 encoding
+func (re *RSEncoding) Encoding() *Encoding {
+	return re.enc
+}
+
+// return the number of correctable characters per block
+func (re *RSEncoding) Corrections() int {
+	return re.corrections
+}
+
+// return the number of parity characters per block
+func (re *RSEncoding) parity() int {
+	return 2 * re.corrections
+}
+
+// encode src and append parity to every block of the encoded digits.
+// Separators and line wrapping of the encoding apply to the whole output.
+func (re *RSEncoding) EncodeToString(src []byte) string {
+	enc := re.enc
+	digits := enc.encodeDigits(src)
+	defer enc.release(digits)
+	r := re.parity()
+	var out []byte
+	for len(digits) > 0 {
+		k := min(len(digits), rsBlock-1-r)
+		block := make([]int, 1+k+r)
+		for i, c := range digits[:k] {
+			block[1+i] = int(enc.reverse[c])
+		}
+		digits = digits[k:]
+		re.protect(block)
+		for _, v := range block {
+			out = append(out, enc.encode[v])
+		}
+	}
+	if enc.groupSize > 0 {
+		out = enc.group(out)
+	}
+	if enc.lineLen > 0 {
+		out = enc.wrap(out)
+	}
+	return string(out)
+}
+
+// fill in the adjustment digit and parity of block
+func (re *RSEncoding) protect(block []int) {
+	r := re.parity()
+	rem := re.remainder(block)
+	// remainder of the block holding only a 1 in the adjustment position,
+	// the parity is linear in the adjustment digit
+	unit := make([]int, len(block))
+	unit[0] = 1
+	col := re.remainder(unit)
+	for adjust := 0; adjust < 58; adjust++ {
+		ok := true
+		for j := 0; j < r && ok; j++ {
+			ok = (2*rsField-rem[j]-rsMul(adjust, col[j]))%rsField < 58
+		}
+		if ok {
+			block[0] = adjust
+			for j := 0; j < r; j++ {
+				block[len(block)-r+j] = (2*rsField - rem[j] - rsMul(adjust, col[j])) % rsField
+			}
+			return
+		}
+	}
+	panic("base58: no Reed-Solomon adjustment digit found")
+}
+
+// remainder of the message part of block, times x^r, divided by the
+// generator
+func (re *RSEncoding) remainder(block []int) []int {
+	r := re.parity()
+	work := append([]int(nil), block[:len(block)-r]...)
+	work = append(work, make([]int, r)...)
+	for i := 0; i < len(block)-r; i++ {
+		coef := work[i]
+		if coef == 0 {
+			continue
+		}
+		for j := 1; j <= r; j++ {
+			work[i+j] = (work[i+j] + rsField - rsMul(coef, re.generator[j])) % rsField
+		}
+	}
+	return work[len(block)-r:]
+}
+
+// correct s and decode its data characters
+func (re *RSEncoding) DecodeString(s string) ([]byte, error) {
+	data, _, err := re.correct(s)
+	if err != nil {
+		return nil, err
+	}
+	defer re.enc.release(data)
+	return re.enc.decodeDigits(context.Background(), data)
+}
+
+// return s with damaged characters repaired and their byte offsets in s.
+// Separators and line breaks of the encoding are kept as they are.
+func (re *RSEncoding) Correct(s string) (corrected string, positions []int, err error) {
+	out := []byte(s)
+	_, fixes, err := re.correct(s)
+	if err != nil {
+		return "", nil, err
+	}
+	for _, f := range fixes {
+		out[f.pos] = f.char
+		positions = append(positions, f.pos)
+	}
+	return string(out), positions, nil
+}
+
+// repaired character at an input offset
+type rsFix struct {
+	pos  int
+	char byte
+}
+
+// correct every block of s, returning the data characters and the fixes
+func (re *RSEncoding) correct(s string) (data []byte, fixes []rsFix, err error) {
+	enc := re.enc
+	r := re.parity()
+	// offsets of the block characters in s
+	var offsets []int
+	for i := 0; i < len(s); i++ {
+		if enc.reverse[s[i]] != -1 || !enc.ignored(s[i]) {
+			offsets = append(offsets, i)
+		}
+	}
+	for b := 0; len(offsets) > 0; b++ {
+		n := min(len(offsets), rsBlock)
+		if n < r+2 {
+			return nil, nil, fmt.Errorf("%w: final block of %d characters is shorter than its parity", ErrInvalidLength, n)
+		}
+		block := make([]int, n)
+		for i, off := range offsets[:n] {
+			// characters outside the alphabet are substitutions too
+			block[i] = max(int(enc.reverse[s[off]]), 0)
+		}
+		errs, ok := rsDecode(block, r)
+		if !ok {
+			return nil, nil, fmt.Errorf("%w: block %d", ErrUncorrectable, b)
+		}
+		for _, i := range errs {
+			fixes = append(fixes, rsFix{offsets[i], enc.encode[block[i]]})
+		}
+		for i, off := range offsets[:n] {
+			if enc.reverse[s[off]] == -1 && !containsInt(errs, i) {
+				// an invalid character decoding to the digit zero went unnoticed
+				fixes = append(fixes, rsFix{off, enc.encode[block[i]]})
+			}
+		}
+		for _, v := range block[1 : n-r] {
+			data = append(data, enc.encode[v])
+		}
+		offsets = offsets[n:]
+	}
+	return data, fixes, nil
+}
+
+func containsInt(s []int, v int) bool {
+	for _, x := range s {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+// correct block in place given r parity digits, returning the corrected
+// positions. Syndromes, Berlekamp-Massey for the error locator, a search
+// for its roots and Forney's formula for the error values.
+func rsDecode(block []int, r int) (positions []int, ok bool) {
+	n := len(block)
+	syndromes := make([]int, r)
+	clean := true
+	for j := range syndromes {
+		s := 0
+		for _, c := range block {
+			s = (rsMul(s, rsExp[j+1]) + c) % rsField
+		}
+		syndromes[j] = s
+		clean = clean && s == 0
+	}
+	if clean {
+		return nil, true
+	}
+
+	// Berlekamp-Massey, polynomials lowest degree first
+	locator, prev := []int{1}, []int{1}
+	l, shift, prevDisc := 0, 1, 1
+	for k := 0; k < r; k++ {
+		d := syndromes[k]
+		for i := 1; i <= l && i < len(locator); i++ {
+			d = (d + rsMul(locator[i], syndromes[k-i])) % rsField
+		}
+		if d == 0 {
+			shift++
+			continue
+		}
+		coef := rsMul(d, rsInv(prevDisc))
+		next := append([]int(nil), locator...)
+		for len(next) < len(prev)+shift {
+			next = append(next, 0)
+		}
+		for i, c := range prev {
+			next[i+shift] = (next[i+shift] + rsField - rsMul(coef, c)) % rsField
+		}
+		if 2*l <= k {
+			prev, l, prevDisc, shift = locator, k+1-l, d, 1
+		} else {
+			shift++
+		}
+		locator = next
+	}
+	if 2*l > r {
+		return nil, false
+	}
+
+	// error evaluator: syndromes(x) * locator(x) mod x^r
+	evaluator := make([]int, r)
+	for i, c := range locator {
+		for j := 0; i+j < r; j++ {
+			evaluator[i+j] = (evaluator[i+j] + rsMul(c, syndromes[j])) % rsField
+		}
+	}
+	for i := 0; i < n; i++ {
+		// position i holds the coefficient of x^(n-1-i), a root of the
+		// locator at its inverse marks an error
+		xInv := rsExp[rsBlock-(n-1-i)%rsBlock]
+		if rsEval(locator, xInv) != 0 {
+			continue
+		}
+		deriv := 0
+		for k := 1; k < len(locator); k++ {
+			deriv = (deriv + rsMul(rsMul(k%rsField, locator[k]), rsPow(xInv, k-1))) % rsField
+		}
+		if deriv == 0 {
+			return nil, false
+		}
+		e := rsMul(rsEval(evaluator, xInv), rsInv(deriv))
+		// received = codeword + error value, where error value = -e
+		block[i] = (block[i] + e) % rsField
+		positions = append(positions, i)
+	}
+	if len(positions) != l {
+		return nil, false
+	}
+	for _, v := range block {
+		if v >= 58 {
+			return nil, false
+		}
+	}
+	return positions, true
+}
+
+// evaluate the polynomial p, lowest degree first, at x
+func rsEval(p []int, x int) int {
+	v := 0
+	for i := len(p) - 1; i >= 0; i-- {
+		v = (rsMul(v, x) + p[i]) % rsField
+	}
+	return v
+}
+
+func rsPow(x, k int) int {
+	v := 1
+	for ; k > 0; k-- {
+		v = rsMul(v, x)
+	}
+	return v
+}
@@ -0,0 +1,116 @@
+package base58_test
+
+import (
+	"bytes"
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestRSEncodingRoundTrip(t *testing.T) {
+	rs := base58.NewRSEncoding(base58.StdEncoding, 2)
+	r := rand.New(rand.NewPCG(1, 2))
+	for _, size := range []int{0, 1, 2, 16, 32, 38, 39, 100, 256} {
+		src := make([]byte, size)
+		for i := range src {
+			src[i] = byte(r.Uint32())
+		}
+		if size > 2 {
+			src[0] = 0
+		}
+		s := rs.EncodeToString(src)
+		if err := base58.StdEncoding.Validate(s); err != nil {
+			t.Fatalf("EncodeToString(%d bytes): %q is not base58: %v", size, s, err)
+		}
+		got, err := rs.DecodeString(s)
+		if err != nil || !bytes.Equal(got, src) {
+			t.Errorf("DecodeString(%q): got %x, %v, want %x", s, got, err, src)
+		}
+	}
+}
+
+func TestRSEncodingCorrects(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	alphabet := base58.StdEncoding.Alphabet()
+	for _, n := range []int{1, 2, 3, 8, 28} {
+		rs := base58.NewRSEncoding(base58.StdEncoding, n)
+		for trial := 0; trial < 50; trial++ {
+			src := make([]byte, 1+r.IntN(120))
+			for i := range src {
+				src[i] = byte(r.Uint32())
+			}
+			s := rs.EncodeToString(src)
+			damaged := []byte(s)
+			// up to n errors in every block of 58
+			var want []int
+			for start := 0; start < len(s); start += 58 {
+				end := min(start+58, len(s))
+				for _, i := range r.Perm(end - start)[:r.IntN(n+1)] {
+					c := alphabet[r.IntN(58)]
+					if r.IntN(4) == 0 {
+						c = '0'
+					}
+					if c != s[start+i] {
+						damaged[start+i] = c
+						want = append(want, start+i)
+					}
+				}
+			}
+			slices.Sort(want)
+			corrected, positions, err := rs.Correct(string(damaged))
+			slices.Sort(positions)
+			if err != nil || corrected != s || !slices.Equal(positions, want) {
+				t.Fatalf("corrections %d: Correct(%q): got %q, %v, %v, want %q, %v", n, damaged, corrected, positions, err, s, want)
+			}
+			got, err := rs.DecodeString(string(damaged))
+			if err != nil || !bytes.Equal(got, src) {
+				t.Fatalf("corrections %d: DecodeString(%q): got %x, %v, want %x", n, damaged, got, err, src)
+			}
+		}
+	}
+}
+
+func TestRSEncodingFormatting(t *testing.T) {
+	rs := base58.NewRSEncoding(base58.StdEncoding.WithSeparator('-', 5).WithWrap(24), 1)
+	src := []byte("paper backup of a wallet seed")
+	s := rs.EncodeToString(src)
+	if !strings.Contains(s, "-") || !strings.Contains(s, "\n") {
+		t.Fatalf("EncodeToString: got %q, want separators and line breaks", s)
+	}
+	damaged := []byte(s)
+	damaged[2] = 'O'
+	corrected, positions, err := rs.Correct(string(damaged))
+	if err != nil || corrected != s || !slices.Equal(positions, []int{2}) {
+		t.Errorf("Correct: got %q, %v, %v", corrected, positions, err)
+	}
+	if got, err := rs.DecodeString(string(damaged)); err != nil || !bytes.Equal(got, src) {
+		t.Errorf("DecodeString: got %q, %v", got, err)
+	}
+}
+
+func TestRSEncodingErrors(t *testing.T) {
+	rs := base58.NewRSEncoding(base58.StdEncoding, 4)
+	s := rs.EncodeToString([]byte("hello world"))
+	damaged := []byte(s)
+	for i := 0; i < 12; i++ {
+		damaged[i] = 'z'
+	}
+	if _, err := rs.DecodeString(string(damaged)); !errors.Is(err, base58.ErrUncorrectable) {
+		t.Errorf("DecodeString with 12 errors: got %v, want ErrUncorrectable", err)
+	}
+	if _, err := rs.DecodeString("2z"); !errors.Is(err, base58.ErrInvalidLength) {
+		t.Errorf("DecodeString(2z): got %v, want ErrInvalidLength", err)
+	}
+	testEqual(t, "Corrections() = %v, want %v", 4, rs.Corrections())
+
+	defer func() {
+		if recover() == nil {
+			t.Error("NewRSEncoding(29): no panic")
+		}
+	}()
+	base58.NewRSEncoding(base58.StdEncoding, 29)
+}
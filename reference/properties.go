@@ -0,0 +1,81 @@
+package reference
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cyclone-github/base58"
+)
+
+/*
+Property Harness
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Invariants every plain encoding must satisfy, bundled for fuzz targets and
+downstream property tests:
+
+	func FuzzMine(f *testing.F) {
+		f.Fuzz(func(t *testing.T, data []byte) {
+			if err := reference.CheckBytes(myEncoding, data); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+*/
+
+// check that src round-trips through enc, agrees with the reference and
+// encodes the same under a permuted alphabet up to the permutation
+func CheckBytes(enc *base58.Encoding, src []byte) error {
+	s := enc.EncodeToString(src)
+	decoded, err := enc.DecodeString(s)
+	if err != nil || !bytes.Equal(decoded, src) {
+		return fmt.Errorf("reference: round trip of %x through %q: got %x, %v", src, s, decoded, err)
+	}
+	if err := CrossCheck(enc, src); err != nil {
+		return err
+	}
+	// digit values, not characters, carry the data: under a permuted
+	// alphabet the output is the same digits spelled differently
+	permuted := base58.NewRadixEncoding(reverse(enc.Alphabet()))
+	if got, want := permuted.EncodeToString(src), permute(s, enc.Alphabet(), permuted.Alphabet()); got != want {
+		return fmt.Errorf("reference: encoding %x under permuted alphabet: got %q, want %q", src, got, want)
+	}
+	return nil
+}
+
+// check that enc and the reference agree on s and that a valid s is the
+// one canonical encoding of its bytes
+func CheckString(enc *base58.Encoding, s string) error {
+	if err := CrossCheckString(enc, s); err != nil {
+		return err
+	}
+	decoded, err := enc.DecodeString(s)
+	if err != nil {
+		return nil
+	}
+	if again := enc.EncodeToString(decoded); again != s {
+		return fmt.Errorf("reference: %q decodes to %x, which encodes as %q", s, decoded, again)
+	}
+	return nil
+}
+
+// return s reversed
+func reverse(s string) string {
+	b := []byte(s)
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+	return string(b)
+}
+
+// map each character of s from its position in from to the same position
+// in to
+func permute(s, from, to string) string {
+	b := []byte(s)
+	for i, c := range b {
+		b[i] = to[bytes.IndexByte([]byte(from), c)]
+	}
+	return string(b)
+}
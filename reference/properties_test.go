@@ -0,0 +1,35 @@
+package reference_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+	"github.com/cyclone-github/base58/reference"
+)
+
+func TestCheckBytes(t *testing.T) {
+	for _, enc := range []*base58.Encoding{base58.StdEncoding, base58.FlickrEncoding, base58.GMPEncoding} {
+		for _, src := range []string{"", "\x00", "\x00\x00\xff", "hello world", strings.Repeat("\xff", 40)} {
+			if err := reference.CheckBytes(enc, []byte(src)); err != nil {
+				t.Error(err)
+			}
+		}
+	}
+	// the zero digit substitution is not a plain encoding
+	if err := reference.CheckBytes(base58.StdEncoding.WithZeroDigit('_'), []byte{0, 1}); err == nil {
+		t.Error("CheckBytes with zero digit: no error")
+	}
+}
+
+func TestCheckString(t *testing.T) {
+	for _, s := range []string{"", "1", "11z", "0", "zz!", "3yxU3u1igY8WkgtjK92fbJQCd4BZiiT1v25f"} {
+		if err := reference.CheckString(base58.StdEncoding, s); err != nil {
+			t.Error(err)
+		}
+	}
+	// a lenient encoding accepts spaces that do not survive re-encoding
+	if err := reference.CheckString(base58.StdEncoding.Lenient(), "2 z"); err == nil {
+		t.Error("CheckString with lenient encoding: no error")
+	}
+}
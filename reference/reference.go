@@ -0,0 +1,142 @@
+package reference
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/cyclone-github/base58"
+)
+
+/*
+Reference Implementation
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+A deliberately simple math/big encoder and decoder, the textbook algorithm
+with no shared code beyond the alphabet, kept as an oracle to diff the
+optimized core against in security reviews and property tests:
+
+	if err := reference.CrossCheck(base58.StdEncoding, data); err != nil {
+		t.Fatal(err)
+	}
+
+It is slow and not constant time; do not use it in production paths.
+Encoding options other than the alphabet (padding, separators, wrapping,
+ignored characters, zero digit, handlers) are not modeled, so cross-check
+plain encodings only.
+*/
+
+// character is not part of the alphabet
+var ErrInvalidCharacter = errors.New("reference: invalid character")
+
+// encode src with alphabet, whose length is the radix (2 to 256). Each
+// leading zero byte becomes one leading alphabet[0].
+func Encode(alphabet string, src []byte) string {
+	checkAlphabet(alphabet)
+	radix := big.NewInt(int64(len(alphabet)))
+	x := new(big.Int).SetBytes(src)
+	mod := new(big.Int)
+	var digits []byte
+	for x.Sign() > 0 {
+		x.DivMod(x, radix, mod)
+		digits = append(digits, alphabet[mod.Int64()])
+	}
+	for _, b := range src {
+		if b != 0 {
+			break
+		}
+		digits = append(digits, alphabet[0])
+	}
+	for i, j := 0, len(digits)-1; i < j; i, j = i+1, j-1 {
+		digits[i], digits[j] = digits[j], digits[i]
+	}
+	return string(digits)
+}
+
+// decode s with alphabet, whose length is the radix (2 to 256). Each
+// leading alphabet[0] becomes one leading zero byte.
+func Decode(alphabet string, s string) ([]byte, error) {
+	checkAlphabet(alphabet)
+	radix := big.NewInt(int64(len(alphabet)))
+	x := new(big.Int)
+	zeros := 0
+	leading := true
+	for i := 0; i < len(s); i++ {
+		d := bytes.IndexByte([]byte(alphabet), s[i])
+		if d < 0 {
+			return nil, fmt.Errorf("%w %q at offset %d", ErrInvalidCharacter, s[i], i)
+		}
+		if leading && d == 0 {
+			zeros++
+			continue
+		}
+		leading = false
+		x.Mul(x, radix)
+		x.Add(x, big.NewInt(int64(d)))
+	}
+	return append(make([]byte, zeros), x.Bytes()...), nil
+}
+
+// panics unless alphabet has 2 to 256 distinct characters
+func checkAlphabet(alphabet string) {
+	if len(alphabet) < 2 || len(alphabet) > 256 {
+		panic("reference: alphabet must have 2 to 256 characters")
+	}
+	var seen [256]bool
+	for i := 0; i < len(alphabet); i++ {
+		if seen[alphabet[i]] {
+			panic("reference: alphabet has duplicate characters")
+		}
+		seen[alphabet[i]] = true
+	}
+}
+
+// result of the optimized core differs from the reference
+type MismatchError struct {
+	Op    string // "encode" or "decode"
+	Input string // input, hex for encode
+	Got   string // core result or error
+	Want  string // reference result or error
+}
+
+func (e *MismatchError) Error() string {
+	return fmt.Sprintf("reference: %s(%s): core returned %s, reference %s", e.Op, e.Input, e.Got, e.Want)
+}
+
+// encode src with enc and with the reference, then decode the result with
+// both, returning a *MismatchError at the first disagreement
+func CrossCheck(enc *base58.Encoding, src []byte) error {
+	got := enc.EncodeToString(src)
+	want := Encode(enc.Alphabet(), src)
+	if got != want {
+		return &MismatchError{Op: "encode", Input: fmt.Sprintf("%x", src), Got: fmt.Sprintf("%q", got), Want: fmt.Sprintf("%q", want)}
+	}
+	return CrossCheckString(enc, want)
+}
+
+// decode s with enc and with the reference, returning a *MismatchError if
+// they disagree on the bytes or on whether s is valid
+func CrossCheckString(enc *base58.Encoding, s string) error {
+	got, gotErr := enc.DecodeString(s)
+	want, wantErr := Decode(enc.Alphabet(), s)
+	switch {
+	case gotErr != nil && wantErr != nil:
+		return nil
+	case gotErr != nil || wantErr != nil:
+		return &MismatchError{Op: "decode", Input: fmt.Sprintf("%q", s), Got: result(got, gotErr), Want: result(want, wantErr)}
+	case !bytes.Equal(got, want):
+		return &MismatchError{Op: "decode", Input: fmt.Sprintf("%q", s), Got: result(got, nil), Want: result(want, nil)}
+	}
+	return nil
+}
+
+// describe a decode result for a MismatchError
+func result(b []byte, err error) string {
+	if err != nil {
+		return "error " + err.Error()
+	}
+	return fmt.Sprintf("%x", b)
+}
@@ -0,0 +1,75 @@
+package reference_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+	"github.com/cyclone-github/base58/reference"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	alphabet := base58.StdEncoding.Alphabet()
+	for _, tt := range []struct {
+		in  string
+		out string
+	}{
+		{"", ""},
+		{"\x00", "1"},
+		{"\x00\x00\x01", "112"},
+		{"abc", "ZiCa"},
+		{"abcdefghijklmnopqrstuvwxyz", "3yxU3u1igY8WkgtjK92fbJQCd4BZiiT1v25f"},
+	} {
+		if got := reference.Encode(alphabet, []byte(tt.in)); got != tt.out {
+			t.Errorf("Encode(%q): got %q, want %q", tt.in, got, tt.out)
+		}
+		got, err := reference.Decode(alphabet, tt.out)
+		if err != nil || string(got) != tt.in {
+			t.Errorf("Decode(%q): got %q, %v, want %q", tt.out, got, err, tt.in)
+		}
+	}
+	if _, err := reference.Decode(alphabet, "abc0"); !errors.Is(err, reference.ErrInvalidCharacter) {
+		t.Errorf("Decode(abc0): got %v, want ErrInvalidCharacter", err)
+	}
+}
+
+func TestCrossCheck(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	encodings := []*base58.Encoding{base58.StdEncoding, base58.FlickrEncoding, base58.RippleEncoding,
+		base58.NewRadixEncoding("0123456789abcdefghijklmnopqrstuvwxyz")}
+	for _, enc := range encodings {
+		for i := 0; i < 200; i++ {
+			src := make([]byte, r.IntN(64))
+			for j := range src {
+				if r.IntN(4) > 0 {
+					src[j] = byte(r.Uint32())
+				}
+			}
+			if err := reference.CrossCheck(enc, src); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for _, s := range []string{"", "1", "11z", "0", "zz!", strings.Repeat("z", 40)} {
+			if err := reference.CrossCheckString(enc, s); err != nil {
+				t.Error(err)
+			}
+		}
+	}
+}
+
+func TestCrossCheckMismatch(t *testing.T) {
+	// a zero digit substitution is not modeled by the reference
+	enc := base58.StdEncoding.WithZeroDigit('_')
+	var merr *reference.MismatchError
+	if err := reference.CrossCheck(enc, []byte{0, 1}); !errors.As(err, &merr) || merr.Op != "encode" {
+		t.Errorf("CrossCheck: got %v, want encode mismatch", err)
+	}
+	if err := reference.CrossCheckString(enc, "_2"); !errors.As(err, &merr) || merr.Op != "decode" {
+		t.Errorf("CrossCheckString: got %v, want decode mismatch", err)
+	}
+	if !strings.Contains(merr.Error(), "reference: decode") {
+		t.Errorf("MismatchError: got %q", merr.Error())
+	}
+}
@@ -0,0 +1,114 @@
+package base58
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+/*
+Rune Alphabets
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Encoding works on single-byte ASCII alphabets. RuneEncoding accepts 58
+arbitrary Unicode code points instead, for emoji or CJK vanity alphabets:
+
+	emoji := base58.NewRuneEncoding("😀😁😂🤣😃😄😅😆😉😊😋😎😍😘🥰😗😙🥲😚🙂🤗🤩🤔🫡🤨😐😑😶🫥🙄😏😣😥😮🤐😯😪😫🥱😴😌😛😜😝🤤😒😓😔😕🙃🫠🤑😲🙁😖😞😟😤")
+	s := emoji.EncodeToString([]byte("sure."))
+
+Digits are looked up in a map on decode. When all 58 code points are ASCII,
+the byte tables of an Encoding are used instead. Each code point is one
+digit, so characters built from several code points, such as flags or emoji
+with variation selectors, cannot be alphabet entries.
+*/
+
+// base58 encoding over an alphabet of Unicode code points
+type RuneEncoding struct {
+	ascii   *Encoding // byte table fast path for ASCII alphabets, else nil
+	runes   []rune
+	reverse map[rune]byte
+	maxLen  int // longest UTF-8 encoding of an alphabet rune
+}
+
+// encode with an alphabet of 58 unique printable, non-space code points
+func NewRuneEncoding(alphabet string) *RuneEncoding {
+	if !utf8.ValidString(alphabet) {
+		panic("base58: rune alphabet is not valid UTF-8")
+	}
+	runes := []rune(alphabet)
+	if len(runes) != 58 {
+		panic("base58: rune alphabet must be 58 code points")
+	}
+	re := &RuneEncoding{runes: runes, reverse: make(map[rune]byte, len(runes))}
+	for i, r := range runes {
+		if !unicode.IsGraphic(r) || unicode.IsSpace(r) {
+			panic("base58: rune alphabet must be printable non-space code points")
+		}
+		if _, dup := re.reverse[r]; dup {
+			panic("base58: rune alphabet contains duplicate code points")
+		}
+		re.reverse[r] = byte(i)
+		re.maxLen = max(re.maxLen, utf8.RuneLen(r))
+	}
+	if re.maxLen == 1 {
+		re.ascii = NewEncoding(alphabet)
+	}
+	return re
+}
+
+// return the alphabet backing re
+func (re *RuneEncoding) Alphabet() string {
+	return string(re.runes)
+}
+
+// return the equivalent byte Encoding of an ASCII alphabet, or nil
+func (re *RuneEncoding) Encoding() *Encoding {
+	return re.ascii
+}
+
+// return the base58 encoding of src
+func (re *RuneEncoding) EncodeToString(src []byte) string {
+	if re.ascii != nil {
+		return re.ascii.EncodeToString(src)
+	}
+	digits := convertRadix(src, 256, 58)
+	var b strings.Builder
+	b.Grow(len(digits) * re.maxLen)
+	for _, d := range digits {
+		b.WriteRune(re.runes[d])
+	}
+	return b.String()
+}
+
+// decode s, an invalid code point or UTF-8 sequence is reported as a
+// CorruptInputError holding its byte offset
+func (re *RuneEncoding) DecodeString(s string) ([]byte, error) {
+	if re.ascii != nil {
+		return re.ascii.DecodeString(s)
+	}
+	digits := make([]byte, 0, len(s)/re.maxLen+1)
+	for i, r := range s {
+		d, ok := re.reverse[r]
+		if !ok {
+			// also covers utf8.RuneError for invalid UTF-8
+			return nil, CorruptInputError(i)
+		}
+		digits = append(digits, d)
+	}
+	return convertRadix(digits, 58, 256), nil
+}
+
+// return the maximum length in bytes of the encoding of n bytes
+func (re *RuneEncoding) EncodedLen(n int) int {
+	if re.ascii != nil {
+		return re.ascii.EncodedLen(n)
+	}
+	return StdEncoding.EncodedLen(n) * re.maxLen
+}
+
+// return the maximum decoded length of n bytes of encoded text
+func (re *RuneEncoding) DecodedLen(n int) int {
+	return max(n, 0)
+}
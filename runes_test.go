@@ -0,0 +1,95 @@
+package base58_test
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/cyclone-github/base58"
+)
+
+const emojiAlphabet = "😀😁😂🤣😃😄😅😆😉😊😋😎😍😘🥰😗😙🥲😚🙂🤗🤩🤔🫡🤨😐😑😶🫥🙄😏😣😥😮🤐😯😪😫🥱😴😌😛😜😝🤤😒😓😔😕🙃🫠🤑😲🙁😖😞😟😤"
+
+// 58 consecutive CJK ideographs
+func cjkAlphabet() string {
+	var b strings.Builder
+	for r := rune(0x4e00); r < 0x4e00+58; r++ {
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func TestRuneEncoding(t *testing.T) {
+	emoji := base58.NewRuneEncoding(emojiAlphabet)
+	cjk := base58.NewRuneEncoding(cjkAlphabet())
+	inputs := [][]byte{nil, {0}, {0, 0, 1}, []byte("sure."), []byte("Hello World!"), {0xff, 0xff, 0xff, 0xff}}
+	for _, re := range []*base58.RuneEncoding{emoji, cjk} {
+		testEqual(t, "Encoding() = %v, want %v", (*base58.Encoding)(nil), re.Encoding())
+		runes := []rune(re.Alphabet())
+		for _, in := range inputs {
+			s := re.EncodeToString(in)
+			// digit for digit the same as StdEncoding
+			std := base58.StdEncoding.EncodeToString(in)
+			testEqual(t, "digits = %d, want %d", len(std), utf8.RuneCountInString(s))
+			for i, r := range []rune(s) {
+				if r != runes[strings.IndexByte(base58.BitcoinAlphabet, std[i])] {
+					t.Fatalf("EncodeToString(%x) = %q, differs from %q at digit %d", in, s, std, i)
+				}
+			}
+			if len(s) > re.EncodedLen(len(in)) {
+				t.Errorf("EncodeToString(%x) has %d bytes, EncodedLen %d", in, len(s), re.EncodedLen(len(in)))
+			}
+			decoded, err := re.DecodeString(s)
+			if err != nil || string(decoded) != string(in) {
+				t.Errorf("DecodeString(%q) = %x, %v, want %x", s, decoded, err, in)
+			}
+			if len(decoded) > re.DecodedLen(len(s)) {
+				t.Errorf("DecodeString(%q) has %d bytes, DecodedLen %d", s, len(decoded), re.DecodedLen(len(s)))
+			}
+		}
+	}
+
+	tests := []struct {
+		in   string
+		want base58.CorruptInputError
+	}{
+		{"😀😁x", 8},
+		{"😀\xff😁", 4},
+		{"😀😁\U0001F47D", 8},
+	}
+	for _, tt := range tests {
+		if _, err := emoji.DecodeString(tt.in); err != tt.want {
+			t.Errorf("DecodeString(%q): got error %v, want %v", tt.in, err, tt.want)
+		}
+	}
+}
+
+func TestRuneEncodingASCII(t *testing.T) {
+	re := base58.NewRuneEncoding(base58.BitcoinAlphabet)
+	if re.Encoding() == nil || !re.Encoding().Equal(base58.StdEncoding) {
+		t.Fatalf("Encoding() = %v, want the StdEncoding byte tables", re.Encoding())
+	}
+	testEqual(t, "EncodeToString = %q, want %q", "E2XFRyo", re.EncodeToString([]byte("sure.")))
+	decoded, err := re.DecodeString("E2XFRyo")
+	if err != nil || string(decoded) != "sure." {
+		t.Errorf("DecodeString(E2XFRyo) = %q, %v", decoded, err)
+	}
+}
+
+func TestNewRuneEncodingPanics(t *testing.T) {
+	for _, alphabet := range []string{
+		emojiAlphabet[:len(emojiAlphabet)-4],
+		emojiAlphabet[:len(emojiAlphabet)-4] + "😀",
+		emojiAlphabet[:len(emojiAlphabet)-4] + " ",
+		emojiAlphabet[:len(emojiAlphabet)-4] + "\xff",
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewRuneEncoding(%q) did not panic", alphabet)
+				}
+			}()
+			base58.NewRuneEncoding(alphabet)
+		}()
+	}
+}
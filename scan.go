@@ -0,0 +1,248 @@
+package base58
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+)
+
+/*
+Binary Address Scanner
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+AddressScanner searches raw binary streams (disk images, process dumps) for
+byte sequences that form valid Base58Check strings with a registered network
+version, reporting stream offsets and decoded payloads. Unlike
+ExtractBase58, candidates need not be delimited: every window of an address
+or WIF length inside a run of alphabet bytes is checked, so an address
+glued to stray printable bytes is still found. Memory use is bounded
+regardless of run length.
+
+Checking a dozen windows at every offset is dominated by decoding, so
+windows are first decoded to the fixed sizes of the common payloads, 25
+bytes for addresses and 37 or 38 bytes for WIF keys, carrying the number
+in 32-bit words as the solana package does. Only a window whose value has
+another size goes through the general CheckDecodeNetwork, e.g. a Zcash
+address with its two version bytes.
+
+	s := base58.NewAddressScanner(f)
+	for s.Scan() {
+		m := s.Match()
+		fmt.Println(m.Offset, m.Network.Name, m.Kind, m.Text)
+	}
+	if err := s.Err(); err != nil {
+		...
+	}
+*/
+
+// candidate window lengths: addresses are 25 to 35 characters, WIF 50 to 52
+var scanWindows = []int{52, 51, 50, 35, 34, 33, 32, 31, 30, 29, 28, 27, 26, 25}
+
+const (
+	maxScanWindow = 52
+	// alphabet bytes buffered before windows are checked and dropped
+	maxScanRun = 4096
+	// largest fixed decoded size, in 32-bit words
+	maxScanWords = 10
+)
+
+// fixed decoded sizes: a version byte, a 20-byte hash and a checksum for
+// addresses, a version byte, a 32-byte key, an optional compression flag
+// and a checksum for WIF
+var (
+	scanAddressSizes = []int{25}
+	scanKeySizes     = []int{38, 37}
+)
+
+// checksummed string found by AddressScanner
+type AddressMatch struct {
+	Offset  int64       // byte offset of Text in the stream
+	Text    string      // the Base58Check string
+	Network *Network    // registered network of the version prefix
+	Kind    VersionKind // address or key kind of the version prefix
+	Payload []byte      // decoded payload without version and checksum
+}
+
+// streaming scanner for Base58Check strings in binary data
+type AddressScanner struct {
+	r       *bufio.Reader
+	run     []byte // current run of alphabet bytes
+	runOff  int64  // stream offset of run[0]
+	off     int64  // bytes read so far
+	pending []AddressMatch
+	match   AddressMatch
+	err     error
+	done    bool
+	scratch [4 * maxScanWords]byte // fixed-size decode of a window
+}
+
+// return a scanner reading from r
+func NewAddressScanner(r io.Reader) *AddressScanner {
+	return &AddressScanner{r: bufio.NewReader(r)}
+}
+
+// advance to the next match, returning false at the end of the stream or
+// on a read error
+func (s *AddressScanner) Scan() bool {
+	for len(s.pending) == 0 {
+		if s.done {
+			return false
+		}
+		c, err := s.r.ReadByte()
+		if err != nil {
+			if err != io.EOF {
+				s.err = err
+			}
+			s.done = true
+			s.flush(true)
+			continue
+		}
+		s.off++
+		if StdEncoding.reverse[c] == -1 {
+			s.flush(true)
+			s.runOff = s.off
+			continue
+		}
+		s.run = append(s.run, c)
+		if len(s.run) >= maxScanRun {
+			s.flush(false)
+		}
+	}
+	s.match, s.pending = s.pending[0], s.pending[1:]
+	return true
+}
+
+// return the most recent match
+func (s *AddressScanner) Match() AddressMatch {
+	return s.match
+}
+
+// return the first non-EOF read error
+func (s *AddressScanner) Err() error {
+	return s.err
+}
+
+// check windows of the buffered run; unless final, windows that may extend
+// past the buffered bytes are kept for the next flush
+func (s *AddressScanner) flush(final bool) {
+	p := 0
+	for p < len(s.run) {
+		if !final && p+maxScanWindow > len(s.run) {
+			break
+		}
+		if n := s.matchAt(p); n > 0 {
+			p += n
+		} else {
+			p++
+		}
+	}
+	if final {
+		s.run = s.run[:0]
+		return
+	}
+	s.run = append(s.run[:0], s.run[p:]...)
+	s.runOff += int64(p)
+}
+
+// record a match at run[p:] and return its length, or 0
+func (s *AddressScanner) matchAt(p int) int {
+	for _, n := range scanWindows {
+		if p+n > len(s.run) {
+			continue
+		}
+		window := s.run[p : p+n]
+		var (
+			net     *Network
+			kind    VersionKind
+			payload []byte
+			err     error
+		)
+		if decoded := s.decodeFixed(window); decoded != nil {
+			net, kind, payload, err = checkFixed(decoded)
+		} else {
+			net, kind, payload, err = CheckDecodeNetwork(string(window))
+		}
+		if err != nil {
+			continue
+		}
+		s.pending = append(s.pending, AddressMatch{
+			Offset:  s.runOff + int64(p),
+			Text:    string(window),
+			Network: net,
+			Kind:    kind,
+			Payload: payload,
+		})
+		return n
+	}
+	return 0
+}
+
+// decode window into the scratch buffer if it has one of the fixed decoded
+// sizes of its length, or return nil
+func (s *AddressScanner) decodeFixed(window []byte) []byte {
+	sizes := scanAddressSizes
+	if len(window) > 35 {
+		sizes = scanKeySizes
+	}
+	// leading zero digits are leading zero bytes
+	ones := 0
+	for ones < len(window) && window[ones] == StdEncoding.encode[0] {
+		ones++
+	}
+	for _, size := range sizes {
+		if decoded := s.scratch[:size]; decodeWords(decoded, window) && len(decoded)-len(trimLeadingZeros(decoded)) == ones {
+			return decoded
+		}
+	}
+	return nil
+}
+
+// decode src, Bitcoin alphabet digits, into dst as a number of exactly
+// len(dst) bytes, at most 4*maxScanWords, reporting whether it fits
+func decodeWords(dst, src []byte) bool {
+	// little-endian 32-bit words of the number
+	var words [maxScanWords]uint32
+	nw := (len(dst) + 3) / 4
+	for _, c := range src {
+		carry := uint64(StdEncoding.reverse[c])
+		for j := 0; j < nw; j++ {
+			v := uint64(words[j])*58 + carry
+			words[j] = uint32(v)
+			carry = v >> 32
+		}
+		if carry != 0 {
+			return false
+		}
+	}
+	// the top word holds the remaining len(dst)%4 bytes, if any
+	if r := len(dst) % 4; r != 0 && words[nw-1]>>(8*r) != 0 {
+		return false
+	}
+	var buf [4 * maxScanWords]byte
+	for j := 0; j < nw; j++ {
+		binary.BigEndian.PutUint32(buf[4*j:], words[nw-1-j])
+	}
+	copy(dst, buf[4*nw-len(dst):4*nw])
+	return true
+}
+
+// verify the checksum of decoded, a fixed-size window decode, and identify
+// the network of its version, as CheckDecodeNetwork does. The payload is
+// copied out of decoded.
+func checkFixed(decoded []byte) (net *Network, kind VersionKind, payload []byte, err error) {
+	ce := StdCheckEncoding
+	body := decoded[:len(decoded)-ce.checksumLen]
+	if string(ce.checksum(body)) != string(decoded[len(body):]) {
+		return nil, 0, nil, ErrChecksumMismatch
+	}
+	if err := ce.enc.runValidators(body); err != nil {
+		return nil, 0, nil, err
+	}
+	net, kind, versionLen := lookupVersion(body)
+	if net == nil {
+		return nil, 0, nil, ErrUnknownNetwork
+	}
+	return net, kind, append([]byte(nil), body[versionLen:]...), nil
+}
@@ -0,0 +1,66 @@
+package base58_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestAddressScanner(t *testing.T) {
+	const (
+		addr = "1BoatSLRHtKNngkdXEeobR76b53LETtpyT"
+		wif  = "5HueCGU8rMjxEXxiPuD5BDku4MkFqeZyd4dZ1jvhTVqvbTLvyTJ"
+	)
+	var dump bytes.Buffer
+	dump.Write([]byte{0x00, 0xff, 0x10})
+	dump.WriteString("zz" + addr + "Qx") // glued to stray alphabet bytes
+	dump.Write([]byte{0x00})
+	dump.WriteString(strings.Repeat("a", 5000)) // long run forcing partial flushes
+	dump.WriteString(wif)
+	dump.Write([]byte{0xde, 0xad})
+	dump.WriteString(addr[:30]) // truncated at EOF
+
+	s := base58.NewAddressScanner(&dump)
+	var got []base58.AddressMatch
+	for s.Scan() {
+		got = append(got, s.Match())
+	}
+	if err := s.Err(); err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("Scan: got %d matches %+v, want 2", len(got), got)
+	}
+
+	testEqual(t, "match 0 text: got %q, want %q", addr, got[0].Text)
+	testEqual(t, "match 0 offset: got %d, want %d", int64(5), got[0].Offset)
+	testEqual(t, "match 0 network: got %q, want %q", "btc", got[0].Network.Name)
+	testEqual(t, "match 0 kind: got %v, want %v", base58.PubKeyHash, got[0].Kind)
+	testEqual(t, "match 0 payload: got %d, want %d", 20, len(got[0].Payload))
+
+	testEqual(t, "match 1 text: got %q, want %q", wif, got[1].Text)
+	testEqual(t, "match 1 offset: got %d, want %d", int64(3+2+len(addr)+2+1+5000), got[1].Offset)
+	testEqual(t, "match 1 kind: got %v, want %v", base58.PrivateKey, got[1].Kind)
+}
+
+func TestAddressScannerSizes(t *testing.T) {
+	// compressed WIF decodes to 38 bytes, a Zcash address with its two
+	// version bytes to 26 and takes the general path
+	const wif = "KwdMAjGmerYanjeui5SHS7JkmpZvVipYvB2LJGU1ZxJwYvP98617"
+	zec := base58.CheckEncodeVersion(base58.ZcashMainNet.PubKeyHashAddrID, bytes.Repeat([]byte{0x42}, 20))
+	s := base58.NewAddressScanner(strings.NewReader(wif + " " + zec))
+	var got []base58.AddressMatch
+	for s.Scan() {
+		got = append(got, s.Match())
+	}
+	if len(got) != 2 {
+		t.Fatalf("Scan: got %d matches %+v, want 2", len(got), got)
+	}
+	testEqual(t, "WIF text: got %q, want %q", wif, got[0].Text)
+	testEqual(t, "WIF payload: got %d, want %d", 33, len(got[0].Payload))
+	testEqual(t, "Zcash text: got %q, want %q", zec, got[1].Text)
+	testEqual(t, "Zcash network: got %q, want %q", "zec", got[1].Network.Name)
+	testEqual(t, "Zcash payload: got %x, want %x", strings.Repeat("\x42", 20), string(got[1].Payload))
+}
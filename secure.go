@@ -0,0 +1,60 @@
+package base58
+
+/*
+Scratch Buffer Zeroization
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Encoding and decoding copy the data through several intermediate slices
+(input copies, digit arrays, pre-formatting output) that would otherwise
+linger on the heap until the garbage collector reuses them. A Secure
+encoding clears every such buffer before returning, for wallet and key
+handling code:
+
+	keys := base58.StdEncoding.Secure()
+	wif := keys.EncodeToString(privateKey)
+
+This covers Encode, EncodeToBytes, EncodeToString, Decode, DecodeToBytes,
+DecodeString and DecodePrefix. The caller's input and the returned result
+are left to the caller, and a string argument to DecodeString cannot be
+cleared at all, so pass secrets as []byte to DecodeToBytes. Go may still
+copy memory behind the program's back (stack growth, GC moves of large
+objects), so this narrows the exposure rather than eliminating it.
+*/
+
+// return a copy of enc that clears its scratch buffers before returning
+func (enc *Encoding) Secure() *Encoding {
+	e := *enc
+	e.secure = true
+	return &e
+}
+
+// report whether enc clears its scratch buffers
+func (enc *Encoding) IsSecure() bool {
+	return enc.secure
+}
+
+// give up scratch buffer b: clear it if enc is Secure and return it to
+// the allocator, if any
+func (enc *Encoding) release(b []byte) {
+	if enc.secure {
+		clear(b)
+	}
+	if enc.allocator != nil && cap(b) > 0 {
+		enc.allocator.Put(b)
+	}
+}
+
+// release scratch buffer old once replaced by next, unless they are the
+// same buffer
+func (enc *Encoding) retire(old, next []byte) {
+	if !sameBuffer(old, next) {
+		enc.release(old)
+	}
+}
+
+// report whether a and b start at the same element
+func sameBuffer(a, b []byte) bool {
+	return cap(a) > 0 && cap(b) > 0 && &a[:1][0] == &b[:1][0]
+}
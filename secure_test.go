@@ -0,0 +1,69 @@
+package base58_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestSecure(t *testing.T) {
+	skipDash := func(c byte, pos int) (byte, bool, error) { return 0, c == '_', nil }
+	encodings := []*base58.Encoding{
+		base58.StdEncoding,
+		base58.StdEncoding.WithPadWidth(50),
+		base58.StdEncoding.WithSeparator('-', 4).WithWrap(16),
+		base58.RippleEncoding.Lenient().WithIgnore("_"),
+		base58.StdEncoding.WithZeroDigit('_'),
+	}
+	key := append([]byte{0, 0}, bytes.Repeat([]byte{0xa5}, 32)...)
+	for _, plain := range encodings {
+		enc := plain.Secure()
+		if !enc.IsSecure() || plain.IsSecure() || enc.Equal(plain) {
+			t.Errorf("%v: Secure did not return a distinct secure copy", plain)
+		}
+		src := bytes.Clone(key)
+		want := plain.EncodeToString(src)
+		if got := enc.EncodeToString(src); got != want {
+			t.Errorf("%v: EncodeToString: got %q, want %q", plain, got, want)
+		}
+		dst := make([]byte, 128)
+		if n := enc.Encode(dst, src); string(dst[:n]) != want {
+			t.Errorf("%v: Encode: got %q, want %q", plain, dst[:n], want)
+		}
+		if !bytes.Equal(src, key) {
+			t.Fatalf("%v: encode modified its input", plain)
+		}
+
+		in := []byte(want + " ")
+		wantDecoded, wantErr := plain.DecodeToBytes(in)
+		decoded, err := enc.DecodeToBytes(in)
+		if !bytes.Equal(decoded, wantDecoded) || (err == nil) != (wantErr == nil) {
+			t.Errorf("%v: DecodeToBytes(%q): got %x, %v, want %x, %v", plain, in, decoded, err, wantDecoded, wantErr)
+		}
+		if string(in) != want+" " {
+			t.Fatalf("%v: DecodeToBytes modified its input: %q", plain, in)
+		}
+		// padded encodings decode to their PadSize
+		wantKey := key
+		if size := plain.PadSize(); size > 0 {
+			wantKey = append(make([]byte, size-len(key)), key...)
+		}
+		decoded, err = enc.DecodeString(want)
+		if err != nil || !bytes.Equal(decoded, wantKey) {
+			t.Errorf("%v: DecodeString(%q): got %x, %v", plain, want, decoded, err)
+		}
+		if n, err := enc.Decode(dst, []byte(want)); err != nil || !bytes.Equal(dst[:n], decoded) {
+			t.Errorf("%v: Decode: got %x, %v", plain, dst[:n], err)
+		}
+	}
+
+	enc := base58.StdEncoding.WithInvalidHandler(skipDash).Secure()
+	in := []byte("E2X_FRyo")
+	if decoded, err := enc.DecodeToBytes(in); err != nil || string(decoded) != "sure." || string(in) != "E2X_FRyo" {
+		t.Errorf("Secure with handler: got %q, %v, input %q", decoded, err, in)
+	}
+	if decoded, consumed, err := base58.StdEncoding.Secure().DecodePrefix([]byte("E2XFRyo!")); string(decoded) != "sure." || consumed != 7 || err == nil {
+		t.Errorf("Secure DecodePrefix: got %q, %d, %v", decoded, consumed, err)
+	}
+}
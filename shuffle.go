@@ -0,0 +1,69 @@
+package base58
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+/*
+Seeded Alphabet Shuffle
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Deterministically permute an alphabet from a seed (hashids-style) so that
+sequential database IDs do not produce visually sequential tokens. Anyone
+holding the seed can rebuild the encoding and decode the tokens. This is
+obfuscation, not encryption.
+*/
+
+// return a copy of enc whose alphabet is permuted by a Fisher-Yates shuffle
+// driven by SHA-256(seed || counter)
+func (enc *Encoding) Shuffled(seed []byte) *Encoding {
+	alphabet := []byte(enc.Alphabet())
+	rng := shuffleSource{seed: seed}
+	for i := len(alphabet) - 1; i > 0; i-- {
+		j := rng.intn(i + 1)
+		alphabet[i], alphabet[j] = alphabet[j], alphabet[i]
+	}
+	e := *enc
+	shuffled := newEncoding(string(alphabet))
+	e.encode, e.reverse, e.name = shuffled.encode, shuffled.reverse, ""
+	return &e
+}
+
+// return StdEncoding with its alphabet shuffled by seed
+func NewShuffledEncoding(seed []byte) *Encoding {
+	return StdEncoding.Shuffled(seed)
+}
+
+// deterministic byte stream of SHA-256(seed || counter) blocks
+type shuffleSource struct {
+	seed    []byte
+	counter uint64
+	block   []byte
+}
+
+func (s *shuffleSource) uint32() uint32 {
+	if len(s.block) < 4 {
+		h := sha256.New()
+		h.Write(s.seed)
+		binary.Write(h, binary.BigEndian, s.counter)
+		s.counter++
+		s.block = h.Sum(nil)
+	}
+	v := binary.BigEndian.Uint32(s.block)
+	s.block = s.block[4:]
+	return v
+}
+
+// uniform integer in [0, n) by rejection sampling
+func (s *shuffleSource) intn(n int) int {
+	limit := ^uint32(0) - ^uint32(0)%uint32(n)
+	for {
+		v := s.uint32()
+		if v < limit {
+			return int(v % uint32(n))
+		}
+	}
+}
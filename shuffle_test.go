@@ -0,0 +1,40 @@
+package base58_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestShuffledEncoding(t *testing.T) {
+	a := base58.NewShuffledEncoding([]byte("my secret salt"))
+	b := base58.NewShuffledEncoding([]byte("my secret salt"))
+	c := base58.NewShuffledEncoding([]byte("another salt"))
+	if !a.Equal(b) {
+		t.Errorf("NewShuffledEncoding is not deterministic: %q vs %q", a.Alphabet(), b.Alphabet())
+	}
+	if a.Equal(c) || a.Equal(base58.StdEncoding) {
+		t.Errorf("NewShuffledEncoding: different seeds produced the same alphabet %q", a.Alphabet())
+	}
+	// a permutation of the bitcoin alphabet
+	got, want := []byte(a.Alphabet()), []byte(base58.BitcoinAlphabet)
+	sort.Slice(got, func(i, j int) bool { return got[i] < got[j] })
+	testEqual(t, "sorted shuffled alphabet: got %q, want %q", string(want), string(got))
+
+	for id := uint64(1000); id < 1010; id++ {
+		s := a.EncodeUint64(id)
+		u, err := b.DecodeUint64(s)
+		if err != nil || u != id {
+			t.Errorf("shuffled Uint64 round trip of %d: got %d, %v", id, u, err)
+		}
+	}
+	for _, p := range pairs {
+		decoded, err := b.DecodeString(a.EncodeToString([]byte(p.decoded)))
+		if err != nil || string(decoded) != p.decoded {
+			t.Errorf("shuffled round trip of %q: got %q, %v", p.decoded, decoded, err)
+		}
+	}
+	padded := base58.StdEncoding.WithPadWidth(8).Shuffled([]byte("x"))
+	testEqual(t, "Shuffled keeps options: got %d, want %d", 8, padded.PadWidth())
+}
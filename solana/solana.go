@@ -0,0 +1,279 @@
+package solana
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cyclone-github/base58"
+)
+
+/*
+Solana Public Keys and Signatures
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Solana accounts, program IDs and mints are 32-byte ed25519 public keys and
+transactions are identified by their first 64-byte signature, both written
+as plain base58 with the Bitcoin alphabet. The sizes are fixed, so instead
+of the general byte-at-a-time conversion the number is carried in 32-bit
+words on the binary side and in base 58^5 limbs on the text side, which
+takes a few dozen 64-bit divisions per key and allocates nothing but the
+result string.
+
+Validation only checks the alphabet and the decoded length. Program derived
+addresses are deliberately off the ed25519 curve, so a key is not required
+to be a curve point.
+*/
+
+// decoded sizes in bytes
+const (
+	PublicKeySize = 32
+	SignatureSize = 64
+)
+
+// longest base58 encodings, of all-0xff values
+const (
+	MaxPublicKeyLen = 44
+	MaxSignatureLen = 88
+)
+
+// string does not decode to exactly the required number of bytes
+var ErrInvalidLength = fmt.Errorf("%w: solana", base58.ErrInvalidLength)
+
+// ed25519 public key, printed and marshaled as base58 text
+type PublicKey [PublicKeySize]byte
+
+// ed25519 signature, printed and marshaled as base58 text
+type Signature [SignatureSize]byte
+
+// decode a base58 public key
+func ParsePublicKey(s string) (PublicKey, error) {
+	var k PublicKey
+	err := decode(k[:], s, MaxPublicKeyLen)
+	return k, err
+}
+
+// like ParsePublicKey but panics on error, for program ID variables
+func MustParsePublicKey(s string) PublicKey {
+	k, err := ParsePublicKey(s)
+	if err != nil {
+		panic(err)
+	}
+	return k
+}
+
+// report whether s is a base58 string of exactly 32 bytes
+func IsPublicKey(s string) bool {
+	_, err := ParsePublicKey(s)
+	return err == nil
+}
+
+// return the base58 encoding of k
+func (k PublicKey) String() string {
+	var buf [MaxPublicKeyLen]byte
+	return string(appendEncode(buf[:0], k[:], MaxPublicKeyLen))
+}
+
+// append the base58 encoding of k to b, implementing encoding.TextAppender
+func (k PublicKey) AppendText(b []byte) ([]byte, error) {
+	return appendEncode(b, k[:], MaxPublicKeyLen), nil
+}
+
+// encode k, implementing encoding.TextMarshaler
+func (k PublicKey) MarshalText() ([]byte, error) {
+	return k.AppendText(nil)
+}
+
+// decode text into k, implementing encoding.TextUnmarshaler
+func (k *PublicKey) UnmarshalText(text []byte) error {
+	var tmp PublicKey
+	if err := decode(tmp[:], text, MaxPublicKeyLen); err != nil {
+		return err
+	}
+	*k = tmp
+	return nil
+}
+
+// decode a base58 signature
+func ParseSignature(s string) (Signature, error) {
+	var sig Signature
+	err := decode(sig[:], s, MaxSignatureLen)
+	return sig, err
+}
+
+// report whether s is a base58 string of exactly 64 bytes
+func IsSignature(s string) bool {
+	_, err := ParseSignature(s)
+	return err == nil
+}
+
+// return the base58 encoding of sig
+func (sig Signature) String() string {
+	var buf [MaxSignatureLen]byte
+	return string(appendEncode(buf[:0], sig[:], MaxSignatureLen))
+}
+
+// append the base58 encoding of sig to b, implementing encoding.TextAppender
+func (sig Signature) AppendText(b []byte) ([]byte, error) {
+	return appendEncode(b, sig[:], MaxSignatureLen), nil
+}
+
+// encode sig, implementing encoding.TextMarshaler
+func (sig Signature) MarshalText() ([]byte, error) {
+	return sig.AppendText(nil)
+}
+
+// decode text into sig, implementing encoding.TextUnmarshaler
+func (sig *Signature) UnmarshalText(text []byte) error {
+	var tmp Signature
+	if err := decode(tmp[:], text, MaxSignatureLen); err != nil {
+		return err
+	}
+	*sig = tmp
+	return nil
+}
+
+// encode 32 bytes as base58
+func Encode32(src [32]byte) string {
+	var buf [MaxPublicKeyLen]byte
+	return string(appendEncode(buf[:0], src[:], MaxPublicKeyLen))
+}
+
+// decode a base58 string of exactly 32 bytes
+func Decode32(s string) ([32]byte, error) {
+	var out [32]byte
+	err := decode(out[:], s, MaxPublicKeyLen)
+	return out, err
+}
+
+// encode 64 bytes as base58
+func Encode64(src [64]byte) string {
+	var buf [MaxSignatureLen]byte
+	return string(appendEncode(buf[:0], src[:], MaxSignatureLen))
+}
+
+// decode a base58 string of exactly 64 bytes
+func Decode64(s string) ([64]byte, error) {
+	var out [64]byte
+	err := decode(out[:], s, MaxSignatureLen)
+	return out, err
+}
+
+// 58^5, the largest power of 58 below 2^30
+const limbBase = 58 * 58 * 58 * 58 * 58
+
+// powers of 58 for partial limbs
+var pow58 = [6]uint64{1, 58, 58 * 58, 58 * 58 * 58, 58 * 58 * 58 * 58, limbBase}
+
+// digit values of the Bitcoin alphabet, 0xff for other bytes
+var reverse = func() (t [256]byte) {
+	for i := range t {
+		t[i] = 0xff
+	}
+	for i := 0; i < len(base58.BitcoinAlphabet); i++ {
+		t[base58.BitcoinAlphabet[i]] = byte(i)
+	}
+	return t
+}()
+
+// append the base58 encoding of src, a multiple of 4 bytes that encodes to
+// at most maxLen characters, to dst
+func appendEncode(dst, src []byte, maxLen int) []byte {
+	// little-endian base 58^5 limbs, used limbs long
+	var limbs [(MaxSignatureLen + 4) / 5]uint64
+	used := 0
+	for i := 0; i < len(src); i += 4 {
+		carry := uint64(binary.BigEndian.Uint32(src[i:]))
+		for j := 0; j < used; j++ {
+			v := limbs[j]<<32 | carry
+			limbs[j] = v % limbBase
+			carry = v / limbBase
+		}
+		for carry > 0 {
+			limbs[used] = carry % limbBase
+			carry /= limbBase
+			used++
+		}
+	}
+	var digits [MaxSignatureLen + 4]byte
+	n := 0
+	for j := used - 1; j >= 0; j-- {
+		v := limbs[j]
+		for k := 4; k >= 0; k-- {
+			digits[n+k] = byte(v % 58)
+			v /= 58
+		}
+		n += 5
+	}
+	start := 0
+	for start < n && digits[start] == 0 {
+		start++
+	}
+	zeros := 0
+	for zeros < len(src) && src[zeros] == 0 {
+		zeros++
+	}
+	if dst == nil {
+		dst = make([]byte, 0, maxLen)
+	}
+	for i := 0; i < zeros; i++ {
+		dst = append(dst, base58.BitcoinAlphabet[0])
+	}
+	for _, d := range digits[start:n] {
+		dst = append(dst, base58.BitcoinAlphabet[d])
+	}
+	return dst
+}
+
+// decode s into dst, a multiple of 4 bytes whose encoding is at most maxLen
+// characters, returning ErrInvalidLength unless s decodes to exactly
+// len(dst) bytes
+func decode[T string | []byte](dst []byte, s T, maxLen int) error {
+	if len(s) > maxLen {
+		return fmt.Errorf("%w: got %d characters, want at most %d", ErrInvalidLength, len(s), maxLen)
+	}
+	// little-endian 32-bit words of the number
+	var words [SignatureSize / 4]uint32
+	nw := len(dst) / 4
+	group := len(s) % 5
+	if group == 0 {
+		group = 5
+	}
+	for i := 0; i < len(s); {
+		var g uint64
+		for end := i + group; i < end; i++ {
+			d := reverse[s[i]]
+			if d == 0xff {
+				return base58.CorruptInputError(i)
+			}
+			g = g*58 + uint64(d)
+		}
+		m := pow58[group]
+		for j := 0; j < nw; j++ {
+			v := uint64(words[j])*m + g
+			words[j] = uint32(v)
+			g = v >> 32
+		}
+		if g != 0 {
+			return fmt.Errorf("%w: value does not fit in %d bytes", ErrInvalidLength, len(dst))
+		}
+		group = 5
+	}
+	for j := 0; j < nw; j++ {
+		binary.BigEndian.PutUint32(dst[4*j:], words[nw-1-j])
+	}
+	// leading zero digits must match the leading zero bytes of the number
+	ones, zeros := 0, 0
+	for ones < len(s) && s[ones] == base58.BitcoinAlphabet[0] {
+		ones++
+	}
+	for zeros < len(dst) && dst[zeros] == 0 {
+		zeros++
+	}
+	if ones != zeros {
+		clear(dst)
+		return fmt.Errorf("%w: got %d bytes, want %d", ErrInvalidLength, ones+len(dst)-zeros, len(dst))
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+package solana_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+	"github.com/cyclone-github/base58/solana"
+)
+
+// well-known program IDs and mints
+var knownKeys = []string{
+	"11111111111111111111111111111111",             // system program
+	"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA",  // token program
+	"So11111111111111111111111111111111111111112",  // wrapped SOL
+	"EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", // USDC
+	"Vote111111111111111111111111111111111111111",  // vote program
+}
+
+func TestPublicKey(t *testing.T) {
+	for _, s := range knownKeys {
+		k, err := solana.ParsePublicKey(s)
+		if err != nil {
+			t.Fatalf("ParsePublicKey(%q) failed: %v", s, err)
+		}
+		want, _ := base58.StdEncoding.DecodeString(s)
+		if !bytes.Equal(k[:], want) {
+			t.Errorf("ParsePublicKey(%q): got %x, want %x", s, k, want)
+		}
+		if got := k.String(); got != s {
+			t.Errorf("String(): got %q, want %q", got, s)
+		}
+		if !solana.IsPublicKey(s) {
+			t.Errorf("IsPublicKey(%q): got false, want true", s)
+		}
+		if solana.IsSignature(s) {
+			t.Errorf("IsSignature(%q): got true, want false", s)
+		}
+	}
+	if got := solana.MustParsePublicKey(knownKeys[0]); got != (solana.PublicKey{}) {
+		t.Errorf("MustParsePublicKey(system program): got %x, want zero key", got)
+	}
+}
+
+func TestFixedMatchesEncoding(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	var patterns [][64]byte
+	var ff [64]byte
+	for i := range ff {
+		ff[i] = 0xff
+	}
+	patterns = append(patterns, [64]byte{}, ff)
+	for i := 0; i < 500; i++ {
+		var b [64]byte
+		rng.Read(b[:])
+		// vary the number of leading zero bytes
+		clear(b[:rng.Intn(len(b)+1)])
+		patterns = append(patterns, b)
+	}
+	for _, b := range patterns {
+		var b32 [32]byte
+		copy(b32[:], b[32:])
+		s32 := solana.Encode32(b32)
+		if want := base58.StdEncoding.EncodeToString(b32[:]); s32 != want {
+			t.Fatalf("Encode32(%x): got %q, want %q", b32, s32, want)
+		}
+		if len(s32) > solana.MaxPublicKeyLen {
+			t.Errorf("Encode32(%x): got %d characters, want at most %d", b32, len(s32), solana.MaxPublicKeyLen)
+		}
+		if got, err := solana.Decode32(s32); err != nil || got != b32 {
+			t.Fatalf("Decode32(%q): got %x, %v, want %x", s32, got, err, b32)
+		}
+
+		s64 := solana.Encode64(b)
+		if want := base58.StdEncoding.EncodeToString(b[:]); s64 != want {
+			t.Fatalf("Encode64(%x): got %q, want %q", b, s64, want)
+		}
+		if len(s64) > solana.MaxSignatureLen {
+			t.Errorf("Encode64(%x): got %d characters, want at most %d", b, len(s64), solana.MaxSignatureLen)
+		}
+		sig, err := solana.ParseSignature(s64)
+		if err != nil || sig != solana.Signature(b) {
+			t.Fatalf("ParseSignature(%q): got %x, %v, want %x", s64, sig, err, b)
+		}
+		if got := sig.String(); got != s64 {
+			t.Errorf("Signature.String(): got %q, want %q", got, s64)
+		}
+	}
+}
+
+func TestInvalid(t *testing.T) {
+	var ff [32]byte
+	for i := range ff {
+		ff[i] = 0xff
+	}
+	max := solana.Encode32(ff)
+	tests := []struct {
+		name string
+		s    string
+		want error
+	}{
+		{"empty", "", solana.ErrInvalidLength},
+		{"short", base58.StdEncoding.EncodeToString(make([]byte, 31)), solana.ErrInvalidLength},
+		{"short number", base58.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 31)), solana.ErrInvalidLength},
+		{"extra zero", "1" + knownKeys[1], solana.ErrInvalidLength},
+		{"long", base58.StdEncoding.EncodeToString(make([]byte, 33)), solana.ErrInvalidLength},
+		{"overflow", "z" + max[1:], solana.ErrInvalidLength},
+		{"too many characters", strings.Repeat("2", 45), solana.ErrInvalidLength},
+		{"invalid character", "0" + knownKeys[1][1:], base58.CorruptInputError(0)},
+		{"invalid character late", knownKeys[1][:40] + "l" + knownKeys[1][41:], base58.CorruptInputError(40)},
+	}
+	for _, tt := range tests {
+		k, err := solana.ParsePublicKey(tt.s)
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%s: ParsePublicKey(%q): got error %v, want %v", tt.name, tt.s, err, tt.want)
+		}
+		if k != (solana.PublicKey{}) {
+			t.Errorf("%s: ParsePublicKey(%q): got %x on error, want zero key", tt.name, tt.s, k)
+		}
+	}
+	if !errors.Is(solana.ErrInvalidLength, base58.ErrInvalidLength) {
+		t.Error("ErrInvalidLength does not match base58.ErrInvalidLength")
+	}
+	if _, err := solana.Decode64(strings.Repeat("z", 89)); !errors.Is(err, solana.ErrInvalidLength) {
+		t.Errorf("Decode64(89 characters): got error %v, want %v", err, solana.ErrInvalidLength)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParsePublicKey(invalid): did not panic")
+		}
+	}()
+	solana.MustParsePublicKey("invalid")
+}
+
+func TestMarshalText(t *testing.T) {
+	type transfer struct {
+		From      solana.PublicKey `json:"from"`
+		Signature solana.Signature `json:"signature"`
+	}
+	var sig solana.Signature
+	for i := range sig {
+		sig[i] = byte(i + 1)
+	}
+	in := transfer{From: solana.MustParsePublicKey(knownKeys[3]), Signature: sig}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("json.Marshal failed: %v", err)
+	}
+	want := `{"from":"` + knownKeys[3] + `","signature":"` + sig.String() + `"}`
+	if string(data) != want {
+		t.Errorf("json.Marshal: got %s, want %s", data, want)
+	}
+	var out transfer
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("json.Unmarshal failed: %v", err)
+	}
+	if out != in {
+		t.Errorf("json.Unmarshal: got %+v, want %+v", out, in)
+	}
+	out.From = in.From
+	if err := json.Unmarshal([]byte(`{"from":"`+knownKeys[3]+`1"}`), &out); !errors.Is(err, solana.ErrInvalidLength) {
+		t.Errorf("json.Unmarshal(bad key): got error %v, want %v", err, solana.ErrInvalidLength)
+	}
+	if out.From != in.From {
+		t.Errorf("json.Unmarshal(bad key): key changed to %v", out.From)
+	}
+	if b, _ := in.From.AppendText([]byte("key=")); string(b) != "key="+knownKeys[3] {
+		t.Errorf("AppendText: got %q, want %q", b, "key="+knownKeys[3])
+	}
+}
+
+func BenchmarkEncode32(b *testing.B) {
+	k := solana.MustParsePublicKey(knownKeys[3])
+	for i := 0; i < b.N; i++ {
+		_ = solana.Encode32(k)
+	}
+}
+
+func BenchmarkEncode32Generic(b *testing.B) {
+	k := solana.MustParsePublicKey(knownKeys[3])
+	for i := 0; i < b.N; i++ {
+		_ = base58.StdEncoding.EncodeToString(k[:])
+	}
+}
+
+func BenchmarkDecode32(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = solana.Decode32(knownKeys[3])
+	}
+}
+
+func BenchmarkDecode32Generic(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = base58.StdEncoding.DecodeString(knownKeys[3])
+	}
+}
@@ -0,0 +1,69 @@
+package base58
+
+import (
+	"bytes"
+	"fmt"
+)
+
+/*
+Order-Preserving Fixed-Width Encoding
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Inputs of a declared byte width are encoded as numbers left-padded with the
+zero digit to the encoded length of the largest input of that width. With an
+alphabet in ascending byte order (such as the bitcoin and flickr alphabets)
+the lexicographic order of the encoded strings then matches the byte order
+of the inputs, which makes base58 usable for range scans over sorted keys.
+*/
+
+// fixed-width, order-preserving encoding of width-byte inputs
+type SortableEncoding struct {
+	enc        *Encoding
+	width      int
+	encodedLen int
+}
+
+// sortable encoding of width-byte inputs over enc, panics unless the
+// alphabet of enc is in ascending byte order
+func NewSortableEncoding(enc *Encoding, width int) *SortableEncoding {
+	if width < 0 {
+		panic("base58: negative sortable width")
+	}
+	for i := 1; i < enc.base; i++ {
+		if enc.encode[i-1] >= enc.encode[i] {
+			panic("base58: sortable encoding requires an alphabet in ascending byte order")
+		}
+	}
+	// the raw digit count, without separators, wrapping or padding, which
+	// encodeWidth does not apply either
+	max := enc.encodeDigits(bytes.Repeat([]byte{0xff}, width))
+	return &SortableEncoding{enc: enc, width: width, encodedLen: len(max)}
+}
+
+// return the declared input width in bytes
+func (se *SortableEncoding) Width() int {
+	return se.width
+}
+
+// return the fixed length of every encoded string
+func (se *SortableEncoding) EncodedLen() int {
+	return se.encodedLen
+}
+
+// return the fixed-width encoding of src, which must be Width() bytes
+func (se *SortableEncoding) EncodeToString(src []byte) (string, error) {
+	if len(src) != se.width {
+		return "", fmt.Errorf("%w: got %d bytes, want %d", ErrInvalidLength, len(src), se.width)
+	}
+	return string(se.enc.encodeWidth(src, se.encodedLen)), nil
+}
+
+// decode a fixed-width string of EncodedLen() characters to Width() bytes
+func (se *SortableEncoding) DecodeString(s string) ([]byte, error) {
+	if len(s) != se.encodedLen {
+		return nil, fmt.Errorf("%w: got %d characters, want %d", ErrInvalidLength, len(s), se.encodedLen)
+	}
+	return se.enc.decodeWidth([]byte(s), se.width)
+}
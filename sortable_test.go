@@ -0,0 +1,97 @@
+package base58_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestSortableEncoding(t *testing.T) {
+	se := base58.NewSortableEncoding(base58.StdEncoding, 8)
+	testEqual(t, "EncodedLen: got %d, want %d", 11, se.EncodedLen())
+
+	rng := rand.New(rand.NewSource(1))
+	inputs := [][]byte{make([]byte, 8), bytes.Repeat([]byte{0xff}, 8)}
+	for i := 0; i < 500; i++ {
+		b := make([]byte, 8)
+		binary.BigEndian.PutUint64(b, rng.Uint64()>>uint(rng.Intn(64)))
+		inputs = append(inputs, b)
+	}
+	encoded := make([]string, len(inputs))
+	for i, in := range inputs {
+		s, err := se.EncodeToString(in)
+		if err != nil {
+			t.Fatalf("EncodeToString(%x) failed: %v", in, err)
+		}
+		if len(s) != se.EncodedLen() {
+			t.Fatalf("EncodeToString(%x): length %d, want %d", in, len(s), se.EncodedLen())
+		}
+		decoded, err := se.DecodeString(s)
+		if err != nil || !bytes.Equal(decoded, in) {
+			t.Fatalf("DecodeString(%q): got %x, %v, want %x", s, decoded, err, in)
+		}
+		encoded[i] = s
+	}
+	for i := range inputs {
+		for j := range inputs {
+			if c := bytes.Compare(inputs[i], inputs[j]); c != compareStrings(encoded[i], encoded[j]) {
+				t.Fatalf("order mismatch: %x vs %x encoded as %q vs %q", inputs[i], inputs[j], encoded[i], encoded[j])
+			}
+		}
+	}
+}
+
+func TestSortableEncodingFormatting(t *testing.T) {
+	// separators, wrapping and padding of the encoding do not count toward
+	// the width and are not applied
+	encs := []*base58.Encoding{
+		base58.StdEncoding.WithSeparator('-', 4),
+		base58.StdEncoding.WithWrap(5),
+		base58.StdEncoding.WithPadWidth(20),
+	}
+	in := []byte{0xff, 0, 0, 0, 0, 0, 0, 1}
+	for _, enc := range encs {
+		se := base58.NewSortableEncoding(enc, 8)
+		testEqual(t, "EncodedLen: got %d, want %d", 11, se.EncodedLen())
+		s, err := se.EncodeToString(in)
+		if err != nil || len(s) != 11 {
+			t.Fatalf("EncodeToString(%x) = %q, %v, want 11 characters", in, s, err)
+		}
+		if decoded, err := se.DecodeString(s); err != nil || !bytes.Equal(decoded, in) {
+			t.Errorf("DecodeString(%q) = %x, %v, want %x", s, decoded, err, in)
+		}
+	}
+}
+
+func compareStrings(a, b string) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func TestSortableEncodingErrors(t *testing.T) {
+	se := base58.NewSortableEncoding(base58.StdEncoding, 4)
+	if _, err := se.EncodeToString(make([]byte, 5)); !errors.Is(err, base58.ErrInvalidLength) {
+		t.Errorf("EncodeToString(5 bytes): got error %v, want %v", err, base58.ErrInvalidLength)
+	}
+	if _, err := se.DecodeString("1111"); !errors.Is(err, base58.ErrInvalidLength) {
+		t.Errorf("DecodeString(short): got error %v, want %v", err, base58.ErrInvalidLength)
+	}
+	if _, err := se.DecodeString("zzzzzz"); !errors.Is(err, base58.ErrInvalidLength) {
+		t.Errorf("DecodeString(overflow): got error %v, want %v", err, base58.ErrInvalidLength)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("NewSortableEncoding(RippleEncoding) did not panic")
+		}
+	}()
+	base58.NewSortableEncoding(base58.RippleEncoding, 4)
+}
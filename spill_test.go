@@ -0,0 +1,95 @@
+package base58_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestEncoderSpill(t *testing.T) {
+	tmp := t.TempDir()
+	t.Setenv("TMPDIR", tmp)
+	for _, threshold := range []int{0, 10, 1 << 20} {
+		bb := &strings.Builder{}
+		w := base58.NewEncoderSpill(base58.StdEncoding, bb, threshold, 1<<20)
+		for i := 0; i < len(bigtest.decoded); i += 7 {
+			io.WriteString(w, bigtest.decoded[i:min(i+7, len(bigtest.decoded))])
+		}
+		if threshold == 10 {
+			if files, _ := filepath.Glob(filepath.Join(tmp, "base58-spill-*")); len(files) != 1 {
+				t.Errorf("threshold %d: got %d spill files, want 1", threshold, len(files))
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("threshold %d: Close failed: %v", threshold, err)
+		}
+		testEqual(t, "spill encoder output: got %q, want %q", bigtest.encoded, bb.String())
+		if _, err := w.Write([]byte("x")); err != base58.ErrClosed {
+			t.Errorf("Write after Close: got %v, want %v", err, base58.ErrClosed)
+		}
+	}
+	if entries, _ := os.ReadDir(tmp); len(entries) != 0 {
+		t.Errorf("spill files left behind: %d", len(entries))
+	}
+}
+
+func TestEncoderSpillOptions(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir())
+	encodings := []*base58.Encoding{
+		base58.StdEncoding.LittleEndian(),
+		base58.StdEncoding.WithPadWidth(120).WithZeroDigit('0'),
+		base58.StdEncoding.WithSeparator('-', 4).WithWrap(20),
+		base36Encoding,
+	}
+	// larger than one chunk of the spill file, with leading and trailing
+	// zero bytes
+	payload := append([]byte{0, 0}, bytes.Repeat([]byte("spilled payload "), 260)...)
+	payload = append(payload, 0, 0)
+	inputs := [][]byte{{0, 0, 0}, []byte("sure.\x00"), payload}
+	for _, enc := range encodings {
+		for _, in := range inputs {
+			var out bytes.Buffer
+			w := base58.NewEncoderSpill(enc, &out, 1, 1<<20)
+			w.Write(in)
+			if err := w.Close(); err != nil {
+				t.Fatalf("%v spilled Close failed: %v", enc, err)
+			}
+			if want := enc.EncodeToString(in); out.String() != want {
+				t.Errorf("%v spilled encoding of %d bytes = %.40q, want %.40q", enc, len(in), out.String(), want)
+			}
+		}
+	}
+}
+
+func TestEncoderSpillLimit(t *testing.T) {
+	tmp := t.TempDir()
+	t.Setenv("TMPDIR", tmp)
+	var out strings.Builder
+	w := base58.NewEncoderSpill(base58.StdEncoding, &out, 4, 10)
+	if _, err := io.WriteString(w, "sure."); err != nil {
+		t.Fatalf("Write under limit: %v", err)
+	}
+	if _, err := io.WriteString(w, "sure.!"); !errors.Is(err, base58.ErrPayloadTooLarge) {
+		t.Errorf("Write over limit: got %v, want %v", err, base58.ErrPayloadTooLarge)
+	}
+	if err := w.Close(); !errors.Is(err, base58.ErrPayloadTooLarge) {
+		t.Errorf("Close over limit: got %v, want %v", err, base58.ErrPayloadTooLarge)
+	}
+	testEqual(t, "output over limit = %q, want %q", "", out.String())
+	if entries, _ := os.ReadDir(tmp); len(entries) != 0 {
+		t.Errorf("spill files left behind: %d", len(entries))
+	}
+
+	// exactly at the limit is fine
+	w = base58.NewEncoderSpill(base58.StdEncoding, &out, 4, 10)
+	io.WriteString(w, "leasure.!!")
+	if err := w.Close(); err != nil || out.String() != base58.StdEncoding.EncodeToString([]byte("leasure.!!")) {
+		t.Errorf("Close at limit: got %q, %v", out.String(), err)
+	}
+}
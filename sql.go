@@ -0,0 +1,105 @@
+package base58
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+/*
+SQL Scanning
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Two column mappings, picked by the Go type:
+
+  - Bytes, BytesWith, CheckBytes and CheckBytesWith hold raw bytes in Go
+    and store base58 text in the database, e.g. a VARCHAR address column.
+  - Encoded and EncodedWith hold a base58 string in Go and store the raw
+    decoded bytes in the database, e.g. a compact BYTEA or BLOB id column.
+
+All implement sql.Scanner and driver.Valuer; NULL maps to a nil slice or
+an empty string.
+*/
+
+// base58 string stored as its decoded bytes by database/sql, in the
+// encoding selected by S
+type EncodedWith[S EncodingSelector] string
+
+// StdEncoding string stored as its decoded bytes by database/sql
+type Encoded = EncodedWith[Bitcoin]
+
+// return the encoding selected by S
+func (e EncodedWith[S]) encoding() *Encoding {
+	var s S
+	return s.Encoding()
+}
+
+// decode e for storage, implementing driver.Valuer. The empty string is
+// stored as NULL.
+func (e EncodedWith[S]) Value() (driver.Value, error) {
+	if e == "" {
+		return nil, nil
+	}
+	return e.encoding().DecodeString(string(e))
+}
+
+// encode raw column bytes into e, implementing sql.Scanner
+func (e *EncodedWith[S]) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*e = ""
+	case []byte:
+		*e = EncodedWith[S](e.encoding().EncodeToString(v))
+	case string:
+		*e = EncodedWith[S](e.encoding().EncodeToString([]byte(v)))
+	default:
+		return fmt.Errorf("base58: cannot scan %T into %T", src, *e)
+	}
+	return nil
+}
+
+// encode b for storage as text, implementing driver.Valuer. A nil slice
+// is stored as NULL.
+func (b BytesWith[S]) Value() (driver.Value, error) {
+	if b == nil {
+		return nil, nil
+	}
+	return b.String(), nil
+}
+
+// decode a text column into b, implementing sql.Scanner
+func (b *BytesWith[S]) Scan(src any) error {
+	return scanText(src, b)
+}
+
+// encode b with its checksum for storage as text, implementing
+// driver.Valuer. A nil slice is stored as NULL.
+func (b CheckBytesWith[S]) Value() (driver.Value, error) {
+	if b == nil {
+		return nil, nil
+	}
+	return b.String(), nil
+}
+
+// verify and decode a text column into b, implementing sql.Scanner
+func (b *CheckBytesWith[S]) Scan(src any) error {
+	return scanText(src, b)
+}
+
+// decode a text or NULL column into the byte slice behind u
+func scanText[T ~[]byte, P interface {
+	*T
+	UnmarshalText([]byte) error
+}](src any, u P) error {
+	switch v := src.(type) {
+	case nil:
+		*u = nil
+		return nil
+	case []byte:
+		return u.UnmarshalText(v)
+	case string:
+		return u.UnmarshalText([]byte(v))
+	}
+	return fmt.Errorf("base58: cannot scan %T into %T", src, *u)
+}
@@ -0,0 +1,71 @@
+package base58_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+var (
+	_ sql.Scanner   = (*base58.Encoded)(nil)
+	_ driver.Valuer = base58.Encoded("")
+	_ sql.Scanner   = (*base58.Bytes)(nil)
+	_ driver.Valuer = base58.Bytes(nil)
+	_ sql.Scanner   = (*base58.CheckBytes)(nil)
+	_ driver.Valuer = base58.CheckBytes(nil)
+)
+
+func TestEncodedSQL(t *testing.T) {
+	v, err := base58.Encoded("E2XFRyo").Value()
+	if b, ok := v.([]byte); err != nil || !ok || string(b) != "sure." {
+		t.Errorf("Encoded.Value: got %#v, %v", v, err)
+	}
+	if v, err := base58.Encoded("").Value(); err != nil || v != nil {
+		t.Errorf("Encoded(\"\").Value: got %#v, %v", v, err)
+	}
+	if _, err := base58.Encoded("E2X0Ryo").Value(); err == nil {
+		t.Error("Encoded.Value invalid: got nil error")
+	}
+
+	var e base58.EncodedWith[base58.Ripple]
+	if err := e.Scan([]byte("sure.")); err != nil || e != "NpXERyo" {
+		t.Errorf("EncodedWith[Ripple].Scan: got %q, %v", e, err)
+	}
+	if err := e.Scan(nil); err != nil || e != "" {
+		t.Errorf("Encoded.Scan nil: got %q, %v", e, err)
+	}
+	if err := e.Scan(int64(1)); err == nil {
+		t.Error("Encoded.Scan int64: got nil error")
+	}
+}
+
+func TestBytesSQL(t *testing.T) {
+	v, err := base58.Bytes("sure.").Value()
+	if err != nil || v != "E2XFRyo" {
+		t.Errorf("Bytes.Value: got %#v, %v", v, err)
+	}
+	if v, err := base58.CheckBytes(nil).Value(); err != nil || v != nil {
+		t.Errorf("CheckBytes(nil).Value: got %#v, %v", v, err)
+	}
+
+	var b base58.Bytes
+	if err := b.Scan("E2XFRyo"); err != nil || string(b) != "sure." {
+		t.Errorf("Bytes.Scan string: got %q, %v", b, err)
+	}
+	if err := b.Scan([]byte("E2XFRyo")); err != nil || string(b) != "sure." {
+		t.Errorf("Bytes.Scan []byte: got %q, %v", b, err)
+	}
+	if err := b.Scan(nil); err != nil || b != nil {
+		t.Errorf("Bytes.Scan nil: got %q, %v", b, err)
+	}
+	var cb base58.CheckBytes
+	if err := cb.Scan("1BoatSLRHtKNngkdXEeobR76b53LETtpyU"); !errors.Is(err, base58.ErrChecksumMismatch) {
+		t.Errorf("CheckBytes.Scan bad checksum: got %v", err)
+	}
+	if err := cb.Scan(3.5); err == nil {
+		t.Error("CheckBytes.Scan float64: got nil error")
+	}
+}
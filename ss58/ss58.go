@@ -0,0 +1,139 @@
+package ss58
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/cyclone-github/base58"
+	"golang.org/x/crypto/blake2b"
+)
+
+/*
+Substrate SS58 Address Format
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+An SS58 address is the base58 encoding of a 1- or 2-byte network prefix, the
+payload (usually a 32-byte public key) and a 1- or 2-byte checksum taken from
+blake2b-512("SS58PRE" || prefix || payload).
+https://docs.substrate.io/reference/address-formats/
+*/
+
+// well-known network prefixes
+const (
+	Polkadot  uint16 = 0
+	Kusama    uint16 = 2
+	Substrate uint16 = 42
+)
+
+// largest network prefix representable in two bytes
+const MaxPrefix = 1<<14 - 1
+
+var checksumPrefix = []byte("SS58PRE")
+
+var (
+	// network prefix is out of range or reserved
+	ErrInvalidPrefix = errors.New("ss58: invalid network prefix")
+	// payload length has no defined checksum length
+	ErrInvalidLength = errors.New("ss58: invalid payload length")
+	// decoded checksum does not match the prefix and payload
+	ErrChecksumMismatch = fmt.Errorf("%w: ss58", base58.ErrChecksumMismatch)
+)
+
+// return the checksum length for a payload of n bytes
+func checksumLen(n int) (int, error) {
+	switch n {
+	case 1, 2, 4, 8:
+		return 1, nil
+	case 32, 33:
+		return 2, nil
+	}
+	return 0, ErrInvalidLength
+}
+
+// blake2b-512 based SS58 checksum over data
+func checksum(data []byte) []byte {
+	h, _ := blake2b.New512(nil)
+	h.Write(checksumPrefix)
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+// append the 1- or 2-byte encoding of prefix to b
+func appendPrefix(b []byte, prefix uint16) ([]byte, error) {
+	switch {
+	case prefix > MaxPrefix || prefix == 46 || prefix == 47:
+		return nil, ErrInvalidPrefix
+	case prefix < 64:
+		return append(b, byte(prefix)), nil
+	}
+	first := byte((prefix&0x00fc)>>2) | 0x40
+	second := byte(prefix>>8) | byte(prefix&0x0003)<<6
+	return append(b, first, second), nil
+}
+
+// split the network prefix from decoded data
+func parsePrefix(data []byte) (prefix uint16, n int, err error) {
+	if len(data) == 0 {
+		return 0, 0, ErrInvalidLength
+	}
+	switch {
+	case data[0] < 64:
+		return uint16(data[0]), 1, nil
+	case data[0] < 128:
+		if len(data) < 2 {
+			return 0, 0, ErrInvalidLength
+		}
+		lower := data[0]<<2 | data[1]>>6
+		upper := data[1] & 0x3f
+		return uint16(lower) | uint16(upper)<<8, 2, nil
+	}
+	return 0, 0, ErrInvalidPrefix
+}
+
+// encode payload as an SS58 address for network prefix
+func Encode(prefix uint16, payload []byte) (string, error) {
+	ckLen, err := checksumLen(len(payload))
+	if err != nil {
+		return "", err
+	}
+	b, err := appendPrefix(make([]byte, 0, 2+len(payload)+ckLen), prefix)
+	if err != nil {
+		return "", err
+	}
+	b = append(b, payload...)
+	b = append(b, checksum(b)[:ckLen]...)
+	return base58.StdEncoding.EncodeToString(b), nil
+}
+
+// decode and checksum-verify an SS58 address
+func Decode(s string) (prefix uint16, payload []byte, err error) {
+	data, err := base58.StdEncoding.DecodeString(s)
+	if err != nil {
+		return 0, nil, err
+	}
+	prefix, n, err := parsePrefix(data)
+	if err != nil {
+		return 0, nil, err
+	}
+	rest := len(data) - n
+	for _, ckLen := range []int{2, 1} {
+		if l, err := checksumLen(rest - ckLen); err != nil || l != ckLen {
+			continue
+		}
+		body := data[:len(data)-ckLen]
+		if !bytes.Equal(checksum(body)[:ckLen], data[len(body):]) {
+			return 0, nil, ErrChecksumMismatch
+		}
+		return prefix, body[n:], nil
+	}
+	return 0, nil, ErrInvalidLength
+}
+
+// return the network prefix of an SS58 address after verifying it
+func NetworkID(s string) (uint16, error) {
+	prefix, _, err := Decode(s)
+	return prefix, err
+}
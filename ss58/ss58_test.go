@@ -0,0 +1,61 @@
+package ss58_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+	"github.com/cyclone-github/base58/ss58"
+)
+
+// public key of the well-known //Alice development account
+const alice = "d43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d"
+
+func TestSS58(t *testing.T) {
+	pub, _ := hex.DecodeString(alice)
+	tests := []struct {
+		prefix  uint16
+		payload []byte
+		encoded string
+	}{
+		{ss58.Polkadot, pub, "15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5"},
+		{ss58.Kusama, pub, "HNZata7iMYWmk5RvZRTiAsSDhV8366zq2YGb3tLH5Upf74F"},
+		{ss58.Substrate, pub, "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY"},
+		{255, pub, "yGHXkYLYqxijLKKfd9Q2CB9shRVu8rPNBS53wvwGTutYg4zTg"},
+		{1284, pub, "VdvKmYJfD4VXA9fzz1SbmCo2eYHSzUFbaDCZSuaNKJAe8YNg6"},
+		{ss58.Substrate, []byte{1, 2, 3, 4}, "MvAtmUea"},
+	}
+	for _, tt := range tests {
+		got, err := ss58.Encode(tt.prefix, tt.payload)
+		if err != nil {
+			t.Fatalf("Encode(%d) failed: %v", tt.prefix, err)
+		}
+		if got != tt.encoded {
+			t.Errorf("Encode(%d): got %q, want %q", tt.prefix, got, tt.encoded)
+		}
+		prefix, payload, err := ss58.Decode(tt.encoded)
+		if err != nil {
+			t.Fatalf("Decode(%q) failed: %v", tt.encoded, err)
+		}
+		if prefix != tt.prefix || string(payload) != string(tt.payload) {
+			t.Errorf("Decode(%q): got %d/%x, want %d/%x", tt.encoded, prefix, payload, tt.prefix, tt.payload)
+		}
+		if id, err := ss58.NetworkID(tt.encoded); err != nil || id != tt.prefix {
+			t.Errorf("NetworkID(%q): got %d, %v, want %d", tt.encoded, id, err, tt.prefix)
+		}
+	}
+}
+
+func TestSS58Errors(t *testing.T) {
+	if _, err := ss58.Encode(ss58.MaxPrefix+1, make([]byte, 32)); !errors.Is(err, ss58.ErrInvalidPrefix) {
+		t.Errorf("Encode(MaxPrefix+1): got error %v, want %v", err, ss58.ErrInvalidPrefix)
+	}
+	if _, err := ss58.Encode(ss58.Polkadot, make([]byte, 31)); !errors.Is(err, ss58.ErrInvalidLength) {
+		t.Errorf("Encode(31-byte payload): got error %v, want %v", err, ss58.ErrInvalidLength)
+	}
+	_, _, err := ss58.Decode("5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQZ")
+	if !errors.Is(err, ss58.ErrChecksumMismatch) || !errors.Is(err, base58.ErrChecksumMismatch) {
+		t.Errorf("Decode(corrupted): got error %v, want %v", err, ss58.ErrChecksumMismatch)
+	}
+}
@@ -0,0 +1,79 @@
+package base58
+
+import (
+	"bytes"
+	"io"
+)
+
+/*
+Stream Helpers
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+One-call helpers for the common "encode this file or response body" case,
+built on the stream encoder and decoder. EncodeStream and DecodeStream use
+the bounded-memory block format instead, for copy loops over streams of any
+size.
+*/
+
+// read r to EOF and return its base58 encoding
+func EncodeReader(enc *Encoding, r io.Reader) (string, error) {
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(enc.withProgress(r)); err != nil {
+		return "", err
+	}
+	return enc.EncodeToString(buf.Bytes()), nil
+}
+
+// read base58 text from r to EOF and return the decoded bytes, the text
+// is decoded as it is read rather than buffered
+func DecodeReader(enc *Encoding, r io.Reader) ([]byte, error) {
+	d := NewDecoder(enc, r)
+	defer d.Close()
+	return io.ReadAll(d)
+}
+
+// encode src to dst in the block format with DefaultBlockSize blocks,
+// returning the number of bytes written to dst
+func EncodeStream(enc *Encoding, dst io.Writer, src io.Reader) (written int64, err error) {
+	return encodeStream(enc, dst, enc.withProgress(src))
+}
+
+func encodeStream(enc *Encoding, dst io.Writer, src io.Reader) (written int64, err error) {
+	cw := &countWriter{w: dst}
+	w := NewBlockEncoder(enc, cw)
+	if _, err := io.Copy(w, src); err != nil {
+		return cw.n, err
+	}
+	err = w.Close()
+	return cw.n, err
+}
+
+// decode block format src to dst, returning the number of bytes written
+// to dst
+func DecodeStream(enc *Encoding, dst io.Writer, src io.Reader) (written int64, err error) {
+	return decodeStream(enc, dst, enc.withProgress(src))
+}
+
+func decodeStream(enc *Encoding, dst io.Writer, src io.Reader) (written int64, err error) {
+	return io.Copy(dst, NewBlockDecoder(enc, src))
+}
+
+type countWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// base58 stream decoder that also writes every decoded byte to w, e.g. a
+// hash.Hash, so large payloads can be verified without a second pass. A
+// write error to w is returned from Read.
+func NewTeeDecoder(enc *Encoding, r io.Reader, w io.Writer) io.Reader {
+	return io.TeeReader(NewDecoder(enc, r), w)
+}
@@ -0,0 +1,100 @@
+package base58_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestEncodeDecodeReader(t *testing.T) {
+	for _, p := range append(pairs, bigtest) {
+		got, err := base58.EncodeReader(base58.StdEncoding, strings.NewReader(p.decoded))
+		if err != nil {
+			t.Errorf("EncodeReader(%q) failed: %v", p.decoded, err)
+		}
+		msg := fmt.Sprintf("EncodeReader(%q): got %%q, want %%q", p.decoded)
+		testEqual(t, msg, p.encoded, got)
+
+		res, err := base58.DecodeReader(base58.StdEncoding, strings.NewReader(p.encoded+"\n"))
+		if err != nil {
+			t.Errorf("DecodeReader(%q) failed: %v", p.encoded, err)
+		}
+		msg = fmt.Sprintf("DecodeReader(%q): got %%q, want %%q", p.encoded)
+		testEqual(t, msg, p.decoded, string(res))
+	}
+
+	wantErr := errors.New("read failed")
+	if _, err := base58.EncodeReader(base58.StdEncoding, io.MultiReader(strings.NewReader("sure."), errReader{wantErr})); err != wantErr {
+		t.Errorf("EncodeReader(failing reader): got %v, want %v", err, wantErr)
+	}
+	if _, err := base58.DecodeReader(base58.StdEncoding, strings.NewReader("0OIl")); err == nil {
+		t.Errorf("DecodeReader(%q): expected invalid character error", "0OIl")
+	}
+}
+
+func TestEncodeDecodeStream(t *testing.T) {
+	data := strings.Repeat(bigtest.decoded, 100)
+	var encoded strings.Builder
+	n, err := base58.EncodeStream(base58.StdEncoding, &encoded, strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("EncodeStream failed: %v", err)
+	}
+	testEqual(t, "EncodeStream written: got %d, want %d", int64(encoded.Len()), n)
+	testEqual(t, "EncodeStream frames: got %d, want %d", (len(data)+base58.DefaultBlockSize-1)/base58.DefaultBlockSize, strings.Count(encoded.String(), "\n"))
+
+	var decoded strings.Builder
+	n, err = base58.DecodeStream(base58.StdEncoding, &decoded, strings.NewReader(encoded.String()))
+	if err != nil {
+		t.Fatalf("DecodeStream failed: %v", err)
+	}
+	testEqual(t, "DecodeStream written: got %d, want %d", int64(len(data)), n)
+	if decoded.String() != data {
+		t.Errorf("DecodeStream round trip failed")
+	}
+
+	wantErr := errors.New("read failed")
+	if _, err := base58.EncodeStream(base58.StdEncoding, io.Discard, errReader{wantErr}); err != wantErr {
+		t.Errorf("EncodeStream(failing reader): got %v, want %v", err, wantErr)
+	}
+	if _, err := base58.DecodeStream(base58.StdEncoding, io.Discard, strings.NewReader(bigtest.encoded+"\n")); !errors.Is(err, base58.ErrChecksumMismatch) {
+		t.Errorf("DecodeStream(corrupt): got %v, want %v", err, base58.ErrBlockChecksum)
+	}
+}
+
+func TestTeeDecoder(t *testing.T) {
+	h := sha256.New()
+	decoded, err := io.ReadAll(base58.NewTeeDecoder(base58.StdEncoding, strings.NewReader(bigtest.encoded), h))
+	if err != nil {
+		t.Fatalf("TeeDecoder failed: %v", err)
+	}
+	testEqual(t, "TeeDecoder output: got %q, want %q", bigtest.decoded, string(decoded))
+	want := sha256.Sum256([]byte(bigtest.decoded))
+	testEqual(t, "TeeDecoder hash: got %x, want %x", hex.EncodeToString(want[:]), hex.EncodeToString(h.Sum(nil)))
+
+	wantErr := errors.New("write failed")
+	if _, err := io.ReadAll(base58.NewTeeDecoder(base58.StdEncoding, strings.NewReader(bigtest.encoded), errWriter{wantErr})); err != wantErr {
+		t.Errorf("TeeDecoder(failing writer): got %v, want %v", err, wantErr)
+	}
+}
+
+type errWriter struct {
+	err error
+}
+
+func (w errWriter) Write(p []byte) (int, error) {
+	return 0, w.err
+}
+
+type errReader struct {
+	err error
+}
+
+func (r errReader) Read(p []byte) (int, error) {
+	return 0, r.err
+}
@@ -0,0 +1,107 @@
+package base58
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+/*
+Tezos Base58Check Prefixes
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Tezos uses Base58Check with multi-byte version prefixes chosen so that every
+encoded string of a given kind starts with a recognizable text prefix such as
+tz1, KT1, edpk or edsig.
+https://gitlab.com/tezos/tezos/-/blob/master/src/lib_crypto/base58.ml
+*/
+
+var (
+	// prefix name is not in the tezos prefix table
+	ErrUnknownTezosPrefix = errors.New("base58: unknown tezos prefix")
+	// payload length does not match the tezos prefix
+	ErrInvalidTezosLength = errors.New("base58: invalid tezos payload length")
+)
+
+// tezos version prefix and the payload length it encodes
+type TezosPrefix struct {
+	Version    []byte
+	PayloadLen int
+}
+
+// well-known tezos prefixes keyed by the text prefix of their encoding
+var TezosPrefixes = map[string]TezosPrefix{
+	// addresses
+	"tz1": {[]byte{6, 161, 159}, 20},
+	"tz2": {[]byte{6, 161, 161}, 20},
+	"tz3": {[]byte{6, 161, 164}, 20},
+	"tz4": {[]byte{6, 161, 166}, 20},
+	"KT1": {[]byte{2, 90, 121}, 20},
+	// public keys
+	"edpk": {[]byte{13, 15, 37, 217}, 32},
+	"sppk": {[]byte{3, 254, 226, 86}, 33},
+	"p2pk": {[]byte{3, 178, 139, 127}, 33},
+	// secret keys, edsk2 is the 32-byte seed form that also encodes as "edsk"
+	"edsk":  {[]byte{43, 246, 78, 7}, 64},
+	"edsk2": {[]byte{13, 15, 58, 7}, 32},
+	"spsk":  {[]byte{17, 162, 224, 201}, 32},
+	"p2sk":  {[]byte{16, 81, 238, 189}, 32},
+	// signatures
+	"edsig":  {[]byte{9, 245, 205, 134, 18}, 64},
+	"spsig1": {[]byte{13, 115, 101, 19, 63}, 64},
+	"p2sig":  {[]byte{54, 240, 44, 52}, 64},
+	"sig":    {[]byte{4, 130, 43}, 64},
+	// hashes
+	"B":    {[]byte{1, 52}, 32},
+	"o":    {[]byte{5, 116}, 32},
+	"Lo":   {[]byte{133, 233}, 32},
+	"LLo":  {[]byte{29, 159, 109}, 32},
+	"P":    {[]byte{2, 170}, 32},
+	"Co":   {[]byte{79, 199}, 32},
+	"expr": {[]byte{13, 44, 64, 27}, 32},
+	"id":   {[]byte{153, 103}, 16},
+	"Net":  {[]byte{87, 82, 0}, 4},
+}
+
+// base58check encode payload with the named tezos prefix
+func EncodeTezos(prefix string, payload []byte) (string, error) {
+	p, ok := TezosPrefixes[prefix]
+	if !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownTezosPrefix, prefix)
+	}
+	if len(payload) != p.PayloadLen {
+		return "", fmt.Errorf("%w: %s payload is %d bytes, want %d", ErrInvalidTezosLength, prefix, len(payload), p.PayloadLen)
+	}
+	return CheckEncodeVersion(p.Version, payload), nil
+}
+
+// decode and checksum-verify s, returning the name of the matching tezos
+// prefix and the payload
+func DecodeTezos(s string) (prefix string, payload []byte, err error) {
+	decoded, err := CheckDecode(s)
+	if err != nil {
+		return "", nil, err
+	}
+	names := make([]string, 0, len(TezosPrefixes))
+	for name := range TezosPrefixes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		p := TezosPrefixes[name]
+		if bytes.HasPrefix(decoded, p.Version) {
+			if len(decoded)-len(p.Version) != p.PayloadLen {
+				err = fmt.Errorf("%w: %s payload is %d bytes, want %d", ErrInvalidTezosLength, name, len(decoded)-len(p.Version), p.PayloadLen)
+				continue
+			}
+			return name, decoded[len(p.Version):], nil
+		}
+	}
+	if err != nil {
+		return "", nil, err
+	}
+	return "", nil, ErrUnknownTezosPrefix
+}
@@ -0,0 +1,54 @@
+package base58_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestTezos(t *testing.T) {
+	got, err := base58.EncodeTezos("tz1", make([]byte, 20))
+	if err != nil {
+		t.Fatalf("EncodeTezos(tz1) failed: %v", err)
+	}
+	testEqual(t, "EncodeTezos(tz1): got %q, want %q", "tz1Ke2h7sDdakHJQh8WX4Z372du1KChsksyU", got)
+
+	for name, p := range base58.TezosPrefixes {
+		payload := make([]byte, p.PayloadLen)
+		for i := range payload {
+			payload[i] = byte(i * 7)
+		}
+		encoded, err := base58.EncodeTezos(name, payload)
+		if err != nil {
+			t.Fatalf("EncodeTezos(%s) failed: %v", name, err)
+		}
+		// every encoding starts with its text prefix, edsk2 seeds also encode as edsk
+		if text := strings.TrimSuffix(name, "2"); !strings.HasPrefix(encoded, text) {
+			t.Errorf("EncodeTezos(%s): %q does not start with %q", name, encoded, text)
+		}
+		prefix, decoded, err := base58.DecodeTezos(encoded)
+		if err != nil {
+			t.Fatalf("DecodeTezos(%q) failed: %v", encoded, err)
+		}
+		testEqual(t, "DecodeTezos prefix: got %q, want %q", name, prefix)
+		testEqual(t, "DecodeTezos payload: got %x, want %x", string(payload), string(decoded))
+	}
+}
+
+func TestTezosErrors(t *testing.T) {
+	if _, err := base58.EncodeTezos("xyz", nil); !errors.Is(err, base58.ErrUnknownTezosPrefix) {
+		t.Errorf("EncodeTezos(xyz): got error %v, want %v", err, base58.ErrUnknownTezosPrefix)
+	}
+	if _, err := base58.EncodeTezos("tz1", make([]byte, 21)); !errors.Is(err, base58.ErrInvalidTezosLength) {
+		t.Errorf("EncodeTezos(tz1, 21 bytes): got error %v, want %v", err, base58.ErrInvalidTezosLength)
+	}
+	short := base58.CheckEncodeVersion([]byte{6, 161, 159}, make([]byte, 19))
+	if _, _, err := base58.DecodeTezos(short); !errors.Is(err, base58.ErrInvalidTezosLength) {
+		t.Errorf("DecodeTezos(short tz1): got error %v, want %v", err, base58.ErrInvalidTezosLength)
+	}
+	if _, _, err := base58.DecodeTezos(base58.CheckEncode([]byte{0xff, 0xff})); !errors.Is(err, base58.ErrUnknownTezosPrefix) {
+		t.Errorf("DecodeTezos(unknown): got error %v, want %v", err, base58.ErrUnknownTezosPrefix)
+	}
+}
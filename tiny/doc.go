@@ -0,0 +1,4 @@
+// Package tiny is an allocation-free base58 codec for TinyGo and other
+// constrained targets. It is built under TinyGo, which sets the tinygo
+// build tag, or with -tags base58tiny elsewhere.
+package tiny
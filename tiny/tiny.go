@@ -0,0 +1,211 @@
+//go:build tinygo || base58tiny
+
+package tiny
+
+import (
+	"crypto/sha256"
+	"errors"
+)
+
+/*
+Allocation-Free Base58
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+For microcontroller firmware encoding device IDs and keys. The main package
+builds on bytes.Buffer, math/big, reflect and growing slices. This one
+works only in caller-provided buffers: the number is converted in place
+inside dst, so nothing is allocated after package initialization. Invalid
+input never panics and is reported through the returned error.
+
+	var buf [tiny.MaxEncodedLen32]byte
+	n, err := tiny.Encode(buf[:], deviceID[:], &tiny.Bitcoin)
+
+Built under TinyGo, which sets the tinygo build tag, or with
+-tags base58tiny for testing with the standard toolchain.
+*/
+
+var (
+	// destination buffer cannot hold the result
+	ErrShortBuffer = errors.New("tiny: destination buffer too small")
+	// input has a character outside the alphabet
+	ErrInvalidCharacter = errors.New("tiny: invalid character")
+	// alphabet is not 58 distinct ASCII characters
+	ErrInvalidAlphabet = errors.New("tiny: alphabet must be 58 distinct ASCII characters")
+	// Base58Check input is too short or its checksum does not match
+	ErrChecksum = errors.New("tiny: checksum mismatch")
+)
+
+// encoded length of a 32-byte key, for sizing stack buffers
+const MaxEncodedLen32 = 44
+
+// base58 alphabet with its reverse table
+type Alphabet struct {
+	encode [58]byte
+	decode [128]int8
+}
+
+// predefined alphabets
+var (
+	Bitcoin, _ = MakeAlphabet("123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz")
+	Flickr, _  = MakeAlphabet("123456789abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ")
+	Ripple, _  = MakeAlphabet("rpshnaf39wBUDNEGHJKLM4PQRST7VWXYZ2bcdeCg65jkm8oFqi1tuvAxyz")
+)
+
+// build an alphabet from 58 distinct ASCII characters
+func MakeAlphabet(s string) (Alphabet, error) {
+	var a Alphabet
+	if len(s) != 58 {
+		return a, ErrInvalidAlphabet
+	}
+	for i := range a.decode {
+		a.decode[i] = -1
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= 128 || a.decode[c] != -1 {
+			return Alphabet{}, ErrInvalidAlphabet
+		}
+		a.encode[i] = c
+		a.decode[c] = int8(i)
+	}
+	return a, nil
+}
+
+// return the maximum encoded length of n bytes
+func EncodedLen(n int) int {
+	// log(256)/log(58) < 1.37
+	return n*137/100 + 1
+}
+
+// return the maximum decoded length of n characters
+func DecodedLen(n int) int {
+	return n
+}
+
+// encode src into dst, returning the number of characters written
+func Encode(dst, src []byte, a *Alphabet) (int, error) {
+	return encodeParts(dst, a, nil, src, nil)
+}
+
+// encode the concatenation of head, body and tail into dst without
+// joining them
+func encodeParts(dst []byte, a *Alphabet, head, body, tail []byte) (int, error) {
+	zeros, leading := 0, true
+	// base58 digits grow leftwards from the end of dst, used long
+	used := 0
+	for _, part := range [3][]byte{head, body, tail} {
+		for _, b := range part {
+			if leading && b == 0 {
+				zeros++
+				continue
+			}
+			leading = false
+			carry := int(b)
+			for j := len(dst) - 1; j >= len(dst)-used; j-- {
+				carry += int(dst[j]) << 8
+				dst[j] = byte(carry % 58)
+				carry /= 58
+			}
+			for carry > 0 {
+				if zeros+used >= len(dst) {
+					clear(dst)
+					return 0, ErrShortBuffer
+				}
+				used++
+				dst[len(dst)-used] = byte(carry % 58)
+				carry /= 58
+			}
+		}
+	}
+	if zeros+used > len(dst) {
+		clear(dst)
+		return 0, ErrShortBuffer
+	}
+	copy(dst[zeros:], dst[len(dst)-used:])
+	for i := 0; i < zeros; i++ {
+		dst[i] = a.encode[0]
+	}
+	for i := zeros; i < zeros+used; i++ {
+		dst[i] = a.encode[dst[i]]
+	}
+	return zeros + used, nil
+}
+
+// decode src into dst, returning the number of bytes written. dst is
+// cleared on failure.
+func Decode(dst, src []byte, a *Alphabet) (int, error) {
+	zeros, used := 0, 0
+	leading := true
+	for _, c := range src {
+		if c >= 128 || a.decode[c] == -1 {
+			clear(dst)
+			return 0, ErrInvalidCharacter
+		}
+		val := int(a.decode[c])
+		if leading && val == 0 {
+			zeros++
+			continue
+		}
+		leading = false
+		carry := val
+		for j := len(dst) - 1; j >= len(dst)-used; j-- {
+			carry += int(dst[j]) * 58
+			dst[j] = byte(carry)
+			carry >>= 8
+		}
+		for carry > 0 {
+			if zeros+used >= len(dst) {
+				clear(dst)
+				return 0, ErrShortBuffer
+			}
+			used++
+			dst[len(dst)-used] = byte(carry)
+			carry >>= 8
+		}
+	}
+	if zeros+used > len(dst) {
+		clear(dst)
+		return 0, ErrShortBuffer
+	}
+	copy(dst[zeros:], dst[len(dst)-used:])
+	clear(dst[:zeros])
+	return zeros + used, nil
+}
+
+// Base58Check encode version and payload into dst with a 4-byte
+// double-SHA256 checksum, returning the number of characters written
+func CheckEncode(dst []byte, version byte, payload []byte, a *Alphabet) (int, error) {
+	h := sha256.New()
+	h.Write([]byte{version})
+	h.Write(payload)
+	var sum [sha256.Size]byte
+	h.Sum(sum[:0])
+	sum = sha256.Sum256(sum[:])
+	return encodeParts(dst, a, []byte{version}, payload, sum[:4])
+}
+
+// Base58Check decode src into dst, returning the version byte and the
+// payload length. The payload is left at the start of dst, which needs
+// room for the version byte and checksum as well.
+func CheckDecode(dst, src []byte, a *Alphabet) (version byte, n int, err error) {
+	n, err = Decode(dst, src, a)
+	if err != nil {
+		return 0, 0, err
+	}
+	if n < 5 {
+		clear(dst)
+		return 0, 0, ErrChecksum
+	}
+	sum := sha256.Sum256(dst[:n-4])
+	sum = sha256.Sum256(sum[:])
+	if [4]byte(sum[:4]) != [4]byte(dst[n-4:n]) {
+		clear(dst)
+		return 0, 0, ErrChecksum
+	}
+	version = dst[0]
+	copy(dst, dst[1:n-4])
+	clear(dst[n-5:])
+	return version, n - 5, nil
+}
@@ -0,0 +1,93 @@
+//go:build tinygo || base58tiny
+
+package tiny_test
+
+import (
+	"bytes"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+	"github.com/cyclone-github/base58/tiny"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	r := rand.New(rand.NewPCG(7, 8))
+	for i := 0; i < 500; i++ {
+		src := make([]byte, r.IntN(70))
+		for j := range src {
+			if r.IntN(3) > 0 {
+				src[j] = byte(r.Uint32())
+			}
+		}
+		dst := make([]byte, tiny.EncodedLen(len(src)))
+		n, err := tiny.Encode(dst, src, &tiny.Bitcoin)
+		want := base58.StdEncoding.EncodeToString(src)
+		if err != nil || string(dst[:n]) != want {
+			t.Fatalf("Encode(%x): got %q, %v, want %q", src, dst[:n], err, want)
+		}
+		out := make([]byte, tiny.DecodedLen(n))
+		m, err := tiny.Decode(out, dst[:n], &tiny.Bitcoin)
+		if err != nil || !bytes.Equal(out[:m], src) {
+			t.Fatalf("Decode(%q): got %x, %v, want %x", dst[:n], out[:m], err, src)
+		}
+	}
+	var buf [tiny.MaxEncodedLen32]byte
+	if _, err := tiny.Encode(buf[:], bytes.Repeat([]byte{0xff}, 32), &tiny.Bitcoin); err != nil {
+		t.Errorf("Encode of 32 bytes into MaxEncodedLen32: %v", err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	dst := make([]byte, 4)
+	if _, err := tiny.Encode(dst, []byte("hello"), &tiny.Bitcoin); err != tiny.ErrShortBuffer {
+		t.Errorf("Encode short: got %v", err)
+	}
+	if _, err := tiny.Decode(dst, []byte("StV1DL6CwTryKyV"), &tiny.Bitcoin); err != tiny.ErrShortBuffer {
+		t.Errorf("Decode short: got %v", err)
+	}
+	if _, err := tiny.Decode(dst, []byte("0"), &tiny.Bitcoin); err != tiny.ErrInvalidCharacter {
+		t.Errorf("Decode(0): got %v", err)
+	}
+	if _, err := tiny.Decode(dst, []byte("\xff"), &tiny.Bitcoin); err != tiny.ErrInvalidCharacter {
+		t.Errorf("Decode(\\xff): got %v", err)
+	}
+	if _, err := tiny.MakeAlphabet("abc"); err != tiny.ErrInvalidAlphabet {
+		t.Errorf("MakeAlphabet(abc): got %v", err)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	payload := bytes.Repeat([]byte{0x42}, 20)
+	var buf [64]byte
+	n, err := tiny.CheckEncode(buf[:], 0x00, payload, &tiny.Bitcoin)
+	want := base58.StdCheckEncoding.EncodeVersion([]byte{0x00}, payload)
+	if err != nil || string(buf[:n]) != want {
+		t.Fatalf("CheckEncode: got %q, %v, want %q", buf[:n], err, want)
+	}
+	out := make([]byte, 25)
+	version, m, err := tiny.CheckDecode(out, buf[:n], &tiny.Bitcoin)
+	if err != nil || version != 0 || !bytes.Equal(out[:m], payload) {
+		t.Errorf("CheckDecode: got %x, %x, %v", version, out[:m], err)
+	}
+	buf[n-1] ^= 1
+	if _, _, err := tiny.CheckDecode(out, buf[:n], &tiny.Bitcoin); err == nil {
+		t.Error("CheckDecode of damaged input: no error")
+	}
+}
+
+func TestAllocs(t *testing.T) {
+	key := bytes.Repeat([]byte{0xa5}, 32)
+	var enc [tiny.MaxEncodedLen32]byte
+	var dec [32]byte
+	var check [64]byte
+	allocs := testing.AllocsPerRun(100, func() {
+		n, _ := tiny.Encode(enc[:], key, &tiny.Bitcoin)
+		tiny.Decode(dec[:], enc[:n], &tiny.Bitcoin)
+		n, _ = tiny.CheckEncode(check[:], 0x80, key, &tiny.Bitcoin)
+		tiny.CheckDecode(check[:], check[:n], &tiny.Bitcoin)
+	})
+	if allocs != 0 {
+		t.Errorf("%v allocations, want 0", allocs)
+	}
+}
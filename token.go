@@ -0,0 +1,95 @@
+package base58
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math"
+)
+
+/*
+Random Tokens
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+GenerateToken returns a base58 string of fresh crypto/rand bytes for API
+keys, session IDs and invite codes, with options for the common variations:
+
+	key, err := base58.GenerateToken(24, base58.WithTokenPrefix("sk_"), base58.WithTokenCheckDigit())
+*/
+
+// random byte count is not positive
+var errTokenSize = errors.New("base58: token must have at least one random byte")
+
+// option for GenerateToken
+type TokenOption func(*tokenConfig)
+
+type tokenConfig struct {
+	enc        *Encoding
+	width      int
+	checkDigit bool
+	prefix     string
+}
+
+// encode tokens with enc instead of StdEncoding
+func WithTokenEncoding(enc *Encoding) TokenOption {
+	return func(c *tokenConfig) { c.enc = enc }
+}
+
+// left-pad the encoded random part with the zero digit to exactly width
+// characters, so every token has the same length. GenerateToken fails if
+// width cannot hold every value of the requested size.
+func WithTokenWidth(width int) TokenOption {
+	return func(c *tokenConfig) { c.width = width }
+}
+
+// append a Luhn mod N check character over the encoded random part, see
+// EncodeCheckDigit
+func WithTokenCheckDigit() TokenOption {
+	return func(c *tokenConfig) { c.checkDigit = true }
+}
+
+// start tokens with prefix, e.g. "sk_live_", which is not encoded and not
+// covered by the check digit
+func WithTokenPrefix(prefix string) TokenOption {
+	return func(c *tokenConfig) { c.prefix = prefix }
+}
+
+// return a token encoding nBytes bytes read from crypto/rand
+func GenerateToken(nBytes int, opts ...TokenOption) (string, error) {
+	c := tokenConfig{enc: StdEncoding}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	if nBytes < 1 {
+		return "", errTokenSize
+	}
+	enc := c.enc
+	if c.width > 0 {
+		// characters needed for the largest nBytes-byte value
+		need := int(math.Ceil(float64(nBytes) * 8 / math.Log2(float64(enc.base))))
+		if c.width < need {
+			return "", fmt.Errorf("%w: width %d cannot hold %d bytes, need %d", ErrInvalidLength, c.width, nBytes, need)
+		}
+		enc = enc.WithPadWidth(c.width)
+	}
+
+	buf := make([]byte, nBytes)
+	defer clear(buf)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	encoded := enc.EncodeToBytes(buf)
+	defer clear(encoded)
+	token := append([]byte(c.prefix), encoded...)
+	defer clear(token)
+	if c.checkDigit {
+		digit, err := enc.CheckDigit(string(encoded))
+		if err != nil {
+			return "", err
+		}
+		token = append(token, digit)
+	}
+	return string(token), nil
+}
@@ -0,0 +1,56 @@
+package base58_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestGenerateToken(t *testing.T) {
+	seen := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		tok, err := base58.GenerateToken(16)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if seen[tok] {
+			t.Fatalf("GenerateToken: repeated token %q", tok)
+		}
+		seen[tok] = true
+		if decoded, err := base58.StdEncoding.DecodeString(tok); err != nil || len(decoded) != 16 {
+			t.Errorf("GenerateToken: %q decodes to %x, %v", tok, decoded, err)
+		}
+	}
+}
+
+func TestGenerateTokenOptions(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		tok, err := base58.GenerateToken(32,
+			base58.WithTokenPrefix("sk_"), base58.WithTokenWidth(44), base58.WithTokenCheckDigit())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(tok) != 3+44+1 || !strings.HasPrefix(tok, "sk_") {
+			t.Fatalf("GenerateToken: got %q", tok)
+		}
+		if _, err := base58.StdEncoding.DecodeCheckDigit(tok[3:]); err != nil {
+			t.Errorf("DecodeCheckDigit(%q): %v", tok[3:], err)
+		}
+	}
+
+	tok, err := base58.GenerateToken(8, base58.WithTokenEncoding(base58.FlickrEncoding), base58.WithTokenWidth(11))
+	if err != nil || len(tok) != 11 || base58.FlickrEncoding.Validate(tok) != nil {
+		t.Errorf("GenerateToken flickr: got %q, %v", tok, err)
+	}
+}
+
+func TestGenerateTokenErrors(t *testing.T) {
+	if _, err := base58.GenerateToken(0); err == nil {
+		t.Error("GenerateToken(0): no error")
+	}
+	if _, err := base58.GenerateToken(32, base58.WithTokenWidth(43)); !errors.Is(err, base58.ErrInvalidLength) {
+		t.Errorf("GenerateToken with short width: got %v, want ErrInvalidLength", err)
+	}
+}
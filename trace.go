@@ -0,0 +1,76 @@
+package base58
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+/*
+Debug Tracing
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+A traced Encoding logs one debug record per operation with the input and
+output sizes, the code path taken and the elapsed time, for diagnosing
+performance regressions in production without a profiler:
+
+	enc := base58.StdEncoding.WithTrace(slog.Default())
+	dec := base58.NewDecoder(enc, r) // streams inherit the trace
+
+Paths are "radix" (repeated division), "padded" (fixed pad width),
+"in-place" (EncodeInPlace, DecodeInPlace), "into" (DecodeInto), "prefix"
+(DecodePrefix) and "stream" (NewDecoder).
+Records are only built when the logger is enabled for slog.LevelDebug. The
+constant-time functions are not traced, so their timing stays private.
+*/
+
+// return a copy of enc that logs each operation to logger at debug level,
+// nil disables tracing. Tracing does not change the encoding, so Equal
+// ignores it and it is not serialized.
+func (enc *Encoding) WithTrace(logger *slog.Logger) *Encoding {
+	e := *enc
+	e.trace = logger
+	return &e
+}
+
+// report whether enc logs operations
+func (enc *Encoding) tracing() bool {
+	return enc.trace != nil && enc.trace.Enabled(context.Background(), slog.LevelDebug)
+}
+
+// return the start time of a traced operation, zero if not tracing
+func (enc *Encoding) traceStart() time.Time {
+	if !enc.tracing() {
+		return time.Time{}
+	}
+	return time.Now()
+}
+
+// log an operation begun at start, unless start is zero
+func (enc *Encoding) traceDone(op, path string, start time.Time, inLen, outLen int, err error) {
+	if start.IsZero() {
+		return
+	}
+	attrs := []slog.Attr{
+		slog.String("op", op),
+		slog.String("path", path),
+		slog.String("encoding", enc.String()),
+		slog.Int("input_len", inLen),
+		slog.Int("output_len", outLen),
+		slog.Duration("elapsed", time.Since(start)),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+	enc.trace.LogAttrs(context.Background(), slog.LevelDebug, "base58 "+op, attrs...)
+}
+
+// code path of the radix conversion for enc
+func (enc *Encoding) radixPath() string {
+	if enc.padWidth > 0 {
+		return "padded"
+	}
+	return "radix"
+}
@@ -0,0 +1,75 @@
+package base58_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestTrace(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	enc := base58.StdEncoding.WithTrace(logger)
+	if !enc.Equal(base58.StdEncoding) {
+		t.Fatal("WithTrace changed the encoding")
+	}
+
+	s := enc.EncodeToString([]byte("hello world"))
+	enc.DecodeString(s)
+	enc.DecodeString("0")
+	enc.WithPadWidth(20).EncodeToString([]byte{1})
+	enc.DecodeInto(make([]byte, 16), []byte(s))
+	enc.DecodePrefix([]byte(s + "!"))
+	io.ReadAll(base58.NewDecoder(enc, strings.NewReader(s)))
+	enc.ConstantTimeEncodeToString([]byte{1})
+
+	type record struct {
+		Msg       string `json:"msg"`
+		Op        string `json:"op"`
+		Path      string `json:"path"`
+		InputLen  int    `json:"input_len"`
+		OutputLen int    `json:"output_len"`
+		Elapsed   int64  `json:"elapsed"`
+		Error     string `json:"error"`
+	}
+	want := []record{
+		{Msg: "base58 encode", Op: "encode", Path: "radix", InputLen: 11, OutputLen: 15},
+		{Msg: "base58 decode", Op: "decode", Path: "radix", InputLen: 15, OutputLen: 11},
+		{Msg: "base58 decode", Op: "decode", Path: "radix", InputLen: 1, Error: "base58: invalid character"},
+		{Msg: "base58 encode", Op: "encode", Path: "padded", InputLen: 1, OutputLen: 20},
+		{Msg: "base58 decode", Op: "decode", Path: "into", InputLen: 15, OutputLen: 11},
+		{Msg: "base58 decode", Op: "decode", Path: "prefix", InputLen: 16, OutputLen: 11, Error: "base58: illegal data at input byte 15"},
+		{Msg: "base58 decode", Op: "decode", Path: "stream", InputLen: 15, OutputLen: 11},
+	}
+	dec := json.NewDecoder(&buf)
+	for i, w := range want {
+		var got record
+		if err := dec.Decode(&got); err != nil {
+			t.Fatalf("record %d: %v", i, err)
+		}
+		if got.Elapsed < 0 {
+			t.Errorf("record %d: negative elapsed time", i)
+		}
+		got.Elapsed = 0
+		if got != w {
+			t.Errorf("record %d: got %+v, want %+v", i, got, w)
+		}
+	}
+	if dec.More() {
+		t.Error("unexpected extra trace records")
+	}
+}
+
+func TestTraceDisabled(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+	base58.StdEncoding.WithTrace(logger).EncodeToString([]byte("quiet"))
+	if buf.Len() != 0 {
+		t.Errorf("trace at info level: got %q", buf.String())
+	}
+}
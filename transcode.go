@@ -0,0 +1,53 @@
+package base58
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+)
+
+/*
+Hex and Base64 Transcoding
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+One-call conversions between base58 and the hex and base64 forms it is
+usually displayed or stored next to. Base64 uses the padded standard
+alphabet of encoding/base64.
+*/
+
+// convert hex string h to base58
+func (enc *Encoding) FromHex(h string) (string, error) {
+	b, err := hex.DecodeString(h)
+	if err != nil {
+		return "", err
+	}
+	return enc.EncodeToString(b), nil
+}
+
+// convert base58 string s to lowercase hex
+func (enc *Encoding) ToHex(s string) (string, error) {
+	b, err := enc.DecodeString(s)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// convert standard base64 string b64 to base58
+func (enc *Encoding) FromBase64(b64 string) (string, error) {
+	b, err := base64.StdEncoding.DecodeString(b64)
+	if err != nil {
+		return "", err
+	}
+	return enc.EncodeToString(b), nil
+}
+
+// convert base58 string s to standard base64
+func (enc *Encoding) ToBase64(s string) (string, error) {
+	b, err := enc.DecodeString(s)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
+}
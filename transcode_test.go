@@ -0,0 +1,53 @@
+package base58_test
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestTranscode(t *testing.T) {
+	for _, p := range append(pairs, bigtest) {
+		h := hex.EncodeToString([]byte(p.decoded))
+		b64 := base64.StdEncoding.EncodeToString([]byte(p.decoded))
+
+		got, err := base58.StdEncoding.FromHex(h)
+		msg := fmt.Sprintf("FromHex(%q): got %%q, want %%q", h)
+		testEqual(t, msg, p.encoded, got)
+		if err != nil {
+			t.Errorf("FromHex(%q) failed: %v", h, err)
+		}
+		got, err = base58.StdEncoding.ToHex(p.encoded)
+		msg = fmt.Sprintf("ToHex(%q): got %%q, want %%q", p.encoded)
+		testEqual(t, msg, h, got)
+		if err != nil {
+			t.Errorf("ToHex(%q) failed: %v", p.encoded, err)
+		}
+
+		got, err = base58.StdEncoding.FromBase64(b64)
+		msg = fmt.Sprintf("FromBase64(%q): got %%q, want %%q", b64)
+		testEqual(t, msg, p.encoded, got)
+		if err != nil {
+			t.Errorf("FromBase64(%q) failed: %v", b64, err)
+		}
+		got, err = base58.StdEncoding.ToBase64(p.encoded)
+		msg = fmt.Sprintf("ToBase64(%q): got %%q, want %%q", p.encoded)
+		testEqual(t, msg, b64, got)
+		if err != nil {
+			t.Errorf("ToBase64(%q) failed: %v", p.encoded, err)
+		}
+	}
+
+	if _, err := base58.StdEncoding.FromHex("abc"); err == nil {
+		t.Errorf("FromHex(odd length): expected error")
+	}
+	if _, err := base58.StdEncoding.FromBase64("a"); err == nil {
+		t.Errorf("FromBase64(truncated): expected error")
+	}
+	if _, err := base58.StdEncoding.ToHex("0OIl"); err == nil {
+		t.Errorf("ToHex(%q): expected invalid character error", "0OIl")
+	}
+}
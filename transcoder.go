@@ -0,0 +1,222 @@
+package base58
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"unicode"
+)
+
+/*
+Streaming Transcoding
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+NewTranscoder and NewTranscodeWriter convert newline-delimited values from
+one Codec to another on the fly, e.g. a hex dump of hashes to base58 or
+addresses from one base58 alphabet to another:
+
+	r := base58.NewTranscoder(base58.StdEncoding, base58.Hex, f)
+	io.Copy(os.Stdout, r)
+
+A base58 value is one big number, so each value is converted whole, but
+only one line is held at a time and memory stays bounded by MaxLineLen no
+matter how long the stream is. Surrounding whitespace and line endings are
+copied unchanged and blank lines are kept, so line numbers still match the
+input. Between two plain encodings of the same radix (no formatting, decode
+or limit options) the digits are substituted one for one instead of
+converted, in linear time.
+*/
+
+// converter of one line at a time, shared by the reader and the writer
+type lineTranscoder struct {
+	dst, src Codec
+	subst    *[256]byte // digit substitution, 0 for invalid characters, nil to convert
+	line     int
+	out      []byte
+}
+
+func newLineTranscoder(dst, src Codec) *lineTranscoder {
+	return &lineTranscoder{dst: dst, src: src, subst: substitution(dst, src)}
+}
+
+// return the digit substitution table from src to dst if both are plain
+// encodings of the same radix, else nil
+func substitution(dst, src Codec) *[256]byte {
+	d, ok := dst.(*Encoding)
+	s, ok2 := src.(*Encoding)
+	if !ok || !ok2 || d.base != s.base || !d.plain() || !s.plain() {
+		return nil
+	}
+	t := new([256]byte)
+	for c, v := range s.reverse {
+		if v != -1 {
+			t[c] = d.encode[v]
+		}
+	}
+	return t
+}
+
+// report whether enc has no options that change its text beyond the
+// alphabet or that need to see every conversion
+func (enc *Encoding) plain() bool {
+	return enc.padWidth == 0 && enc.groupSize == 0 && !enc.hasIgnore && enc.lineLen == 0 &&
+		!enc.lenient && enc.onInvalid == nil && enc.zero == 0 && enc.maxInput == 0 &&
+		enc.maxDecoded == 0 && !enc.littleEndian && enc.instr == nil && enc.trace == nil
+}
+
+// convert the value of line, keeping the whitespace around it. The result
+// is valid until the next call.
+func (t *lineTranscoder) convert(line []byte) ([]byte, error) {
+	t.line++
+	rest := bytes.TrimLeftFunc(line, unicode.IsSpace)
+	value := bytes.TrimRightFunc(rest, unicode.IsSpace)
+	t.out = append(t.out[:0], line[:len(line)-len(rest)]...)
+	if len(value) > 0 {
+		var err error
+		if t.out, err = t.appendValue(t.out, value); err != nil {
+			return nil, &LineError{Line: t.line, Text: string(value), Err: err}
+		}
+	}
+	return append(t.out, rest[len(value):]...), nil
+}
+
+// append value converted from src to dst to b
+func (t *lineTranscoder) appendValue(b, value []byte) ([]byte, error) {
+	if t.subst != nil {
+		for _, c := range value {
+			if t.subst[c] == 0 {
+				return b, errInvalidCharacter
+			}
+			b = append(b, t.subst[c])
+		}
+		return b, nil
+	}
+	data, err := t.src.DecodeString(string(value))
+	if err != nil {
+		return b, err
+	}
+	return append(b, t.dst.EncodeToString(data)...), nil
+}
+
+type transcoder struct {
+	t   *lineTranscoder
+	r   *bufio.Reader
+	buf []byte
+	out []byte
+	err error
+}
+
+// return a reader of the newline-delimited values of r converted from src
+// to dst. A value that fails to convert stops the stream with a
+// *LineError, and lines longer than MaxLineLen with bufio.ErrTooLong.
+func NewTranscoder(dst, src Codec, r io.Reader) io.Reader {
+	return &transcoder{t: newLineTranscoder(dst, src), r: bufio.NewReader(r)}
+}
+
+// read converted lines, one line at a time
+func (tr *transcoder) Read(p []byte) (int, error) {
+	for len(tr.out) == 0 {
+		if tr.err != nil {
+			return 0, tr.err
+		}
+		tr.out, tr.err = tr.next()
+	}
+	n := copy(p, tr.out)
+	tr.out = tr.out[n:]
+	return n, nil
+}
+
+// read and convert the next line
+func (tr *transcoder) next() ([]byte, error) {
+	tr.buf = tr.buf[:0]
+	for {
+		chunk, err := tr.r.ReadSlice('\n')
+		if len(tr.buf)+len(chunk) > MaxLineLen {
+			return nil, bufio.ErrTooLong
+		}
+		tr.buf = append(tr.buf, chunk...)
+		if err == bufio.ErrBufferFull {
+			continue
+		}
+		if len(tr.buf) == 0 {
+			return nil, err
+		}
+		out, cerr := tr.t.convert(tr.buf)
+		if cerr != nil {
+			return nil, cerr
+		}
+		return out, err
+	}
+}
+
+type transcodeWriter struct {
+	t      *lineTranscoder
+	w      io.Writer
+	buf    []byte
+	closed bool
+	err    error
+}
+
+// return a writer converting the newline-delimited values written to it
+// from src to dst and writing them to w. Close converts a final line
+// without a newline. Errors are as for NewTranscoder and sticky.
+func NewTranscodeWriter(dst, src Codec, w io.Writer) io.WriteCloser {
+	return &transcodeWriter{t: newLineTranscoder(dst, src), w: w}
+}
+
+// buffer p, converting and writing every completed line
+func (tw *transcodeWriter) Write(p []byte) (int, error) {
+	if tw.closed {
+		return 0, ErrClosed
+	}
+	if tw.err != nil {
+		return 0, tw.err
+	}
+	n := 0
+	for len(p) > 0 {
+		i := bytes.IndexByte(p, '\n')
+		if i < 0 {
+			if len(tw.buf)+len(p) > MaxLineLen {
+				tw.err = bufio.ErrTooLong
+				return n, tw.err
+			}
+			tw.buf = append(tw.buf, p...)
+			return n + len(p), nil
+		}
+		if len(tw.buf)+i+1 > MaxLineLen {
+			tw.err = bufio.ErrTooLong
+			return n, tw.err
+		}
+		tw.buf = append(tw.buf, p[:i+1]...)
+		p = p[i+1:]
+		n += i + 1
+		if err := tw.flushLine(); err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// convert and write the buffered line
+func (tw *transcodeWriter) flushLine() error {
+	out, err := tw.t.convert(tw.buf)
+	tw.buf = tw.buf[:0]
+	if err == nil {
+		_, err = tw.w.Write(out)
+	}
+	tw.err = err
+	return err
+}
+
+// convert and write a final line without a newline, if any. Close is
+// idempotent and later writes fail with ErrClosed.
+func (tw *transcodeWriter) Close() error {
+	if tw.closed || tw.err != nil || len(tw.buf) == 0 {
+		tw.closed = true
+		return tw.err
+	}
+	tw.closed = true
+	return tw.flushLine()
+}
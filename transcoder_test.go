@@ -0,0 +1,133 @@
+package base58_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+// newline-delimited random values with leading zero bytes, surrounding
+// whitespace, CRLF and blank lines, formatted by from, and the same lines
+// with the values formatted by to
+func transcodeInput(from, to func([]byte) string) (input, want string) {
+	rng := rand.New(rand.NewSource(1))
+	var in, out strings.Builder
+	for i := 0; i < 40; i++ {
+		v := make([]byte, 1+rng.Intn(40))
+		rng.Read(v)
+		clear(v[:rng.Intn(len(v)+1)])
+		layout := [...]string{"%s\n", "  %s\t\r\n", "%s\n\n", "%s\r\n"}[i%4]
+		in.WriteString(fmt.Sprintf(layout, from(v)))
+		out.WriteString(fmt.Sprintf(layout, to(v)))
+	}
+	return in.String(), out.String()
+}
+
+func TestTranscoder(t *testing.T) {
+	flickrSep := base58.FlickrEncoding.WithSeparator('-', 4)
+	tests := []struct {
+		name     string
+		dst, src base58.Codec
+		from, to func([]byte) string
+	}{
+		{"hex to bitcoin", base58.StdEncoding, base58.Hex, hex.EncodeToString, base58.StdEncoding.EncodeToString},
+		{"base64 to bitcoin", base58.StdEncoding, base64.StdEncoding, base64.StdEncoding.EncodeToString, base58.StdEncoding.EncodeToString},
+		{"bitcoin to base64", base64.StdEncoding, base58.StdEncoding, base58.StdEncoding.EncodeToString, base64.StdEncoding.EncodeToString},
+		{"bitcoin to flickr", base58.FlickrEncoding, base58.StdEncoding, base58.StdEncoding.EncodeToString, base58.FlickrEncoding.EncodeToString},
+		{"bitcoin to separated flickr", flickrSep, base58.StdEncoding, base58.StdEncoding.EncodeToString, flickrSep.EncodeToString},
+		{"ripple to gmp", base58.GMPEncoding, base58.RippleEncoding, base58.RippleEncoding.EncodeToString, base58.GMPEncoding.EncodeToString},
+	}
+	for _, tt := range tests {
+		input, want := transcodeInput(tt.from, tt.to)
+		got, err := io.ReadAll(base58.NewTranscoder(tt.dst, tt.src, strings.NewReader(input)))
+		if err != nil {
+			t.Fatalf("%s: NewTranscoder: %v", tt.name, err)
+		}
+		if string(got) != want {
+			t.Errorf("%s: NewTranscoder: got %q, want %q", tt.name, got, want)
+		}
+
+		var buf bytes.Buffer
+		w := base58.NewTranscodeWriter(tt.dst, tt.src, &buf)
+		for i := 0; i < len(input); i += 7 {
+			if _, err := w.Write([]byte(input[i:min(i+7, len(input))])); err != nil {
+				t.Fatalf("%s: NewTranscodeWriter Write: %v", tt.name, err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("%s: NewTranscodeWriter Close: %v", tt.name, err)
+		}
+		if buf.String() != want {
+			t.Errorf("%s: NewTranscodeWriter: got %q, want %q", tt.name, buf.String(), want)
+		}
+	}
+}
+
+func TestTranscoderFinalLine(t *testing.T) {
+	got, err := io.ReadAll(base58.NewTranscoder(base58.StdEncoding, base58.Hex, strings.NewReader("0001ff\n00ff")))
+	testEqual(t, "NewTranscoder(no final newline) = %q, want %q", "19p\n15Q", string(got))
+	if err != nil {
+		t.Errorf("NewTranscoder(no final newline): %v", err)
+	}
+
+	var buf bytes.Buffer
+	w := base58.NewTranscodeWriter(base58.StdEncoding, base58.Hex, &buf)
+	io.WriteString(w, "0001ff\n00ff")
+	testEqual(t, "NewTranscodeWriter before Close = %q, want %q", "19p\n", buf.String())
+	w.Close()
+	testEqual(t, "NewTranscodeWriter after Close = %q, want %q", "19p\n15Q", buf.String())
+	if _, err := w.Write([]byte("00")); err != base58.ErrClosed {
+		t.Errorf("Write after Close: got error %v, want %v", err, base58.ErrClosed)
+	}
+}
+
+func TestTranscoderErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		dst, src base58.Codec
+		input    string
+		want     error // nil for the unexported invalid character error
+	}{
+		{"substitution", base58.FlickrEncoding, base58.StdEncoding, "2g\n\n  2gO2\n", nil},
+		{"conversion", base58.StdEncoding.WithSeparator('-', 3), base58.StdEncoding, "2g\n\n  2gO2\n", nil},
+		{"hex", base58.StdEncoding, base58.Hex, "00ff\n\n  00fg\n", hex.InvalidByteError('g')},
+	}
+	for _, tt := range tests {
+		got, err := io.ReadAll(base58.NewTranscoder(tt.dst, tt.src, strings.NewReader(tt.input)))
+		var le *base58.LineError
+		if !errors.As(err, &le) || tt.want != nil && !errors.Is(err, tt.want) {
+			t.Fatalf("%s: NewTranscoder: got error %v, want %v", tt.name, err, tt.want)
+		}
+		testEqual(t, tt.name+": LineError.Line = %v, want %v", 3, le.Line)
+		testEqual(t, tt.name+": LineError.Text = %q, want %q", strings.TrimSpace(strings.Split(tt.input, "\n")[2]), le.Text)
+		if lines := strings.Count(string(got), "\n"); lines != 2 {
+			t.Errorf("%s: NewTranscoder output before the error: got %q", tt.name, got)
+		}
+
+		w := base58.NewTranscodeWriter(tt.dst, tt.src, io.Discard)
+		if _, err := io.WriteString(w, tt.input); !errors.As(err, &le) || tt.want != nil && !errors.Is(err, tt.want) {
+			t.Errorf("%s: NewTranscodeWriter: got error %v, want %v", tt.name, err, tt.want)
+		}
+		if err := w.Close(); !errors.As(err, &le) {
+			t.Errorf("%s: NewTranscodeWriter Close: got error %v, want %v", tt.name, err, tt.want)
+		}
+	}
+
+	long := strings.Repeat("2", base58.MaxLineLen+1)
+	if _, err := io.ReadAll(base58.NewTranscoder(base58.FlickrEncoding, base58.StdEncoding, strings.NewReader(long))); err != bufio.ErrTooLong {
+		t.Errorf("NewTranscoder(long line): got error %v, want %v", err, bufio.ErrTooLong)
+	}
+	w := base58.NewTranscodeWriter(base58.FlickrEncoding, base58.StdEncoding, io.Discard)
+	if _, err := io.WriteString(w, long); err != bufio.ErrTooLong {
+		t.Errorf("NewTranscodeWriter(long line): got error %v, want %v", err, bufio.ErrTooLong)
+	}
+}
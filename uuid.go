@@ -0,0 +1,38 @@
+package base58
+
+import (
+	"fmt"
+)
+
+/*
+UUID Encoding
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+A 128-bit UUID always fits in 22 base58 characters. Encoded UUIDs are
+left-padded with '1' to exactly 22 characters so they have a fixed width in
+URLs and database columns.
+*/
+
+// length of a base58 encoded UUID
+const UUIDEncodedLen = 22
+
+// return the fixed-width 22-character base58 encoding of uuid
+func EncodeUUID(uuid [16]byte) string {
+	return string(StdEncoding.encodeWidth(uuid[:], UUIDEncodedLen))
+}
+
+// decode a 22-character base58 UUID
+func DecodeUUID(s string) ([16]byte, error) {
+	var uuid [16]byte
+	if len(s) != UUIDEncodedLen {
+		return uuid, fmt.Errorf("%w: UUID string is %d characters, want %d", ErrInvalidLength, len(s), UUIDEncodedLen)
+	}
+	decoded, err := StdEncoding.decodeWidth([]byte(s), len(uuid))
+	if err != nil {
+		return uuid, err
+	}
+	copy(uuid[:], decoded)
+	return uuid, nil
+}
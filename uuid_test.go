@@ -0,0 +1,39 @@
+package base58_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestUUID(t *testing.T) {
+	tests := []struct {
+		uuid    [16]byte
+		encoded string
+	}{
+		{[16]byte{}, "1111111111111111111111"},
+		{[16]byte{15: 1}, "1111111111111111111112"},
+		{[16]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, "YcVfxkQb6JRzqk5kF2tNLv"},
+		{[16]byte{0x12, 0x3e, 0x45, 0x67, 0xe8, 0x9b, 0x12, 0xd3, 0xa4, 0x56, 0x42, 0x66, 0x14, 0x17, 0x40, 0x00}, "3FfGK34vwMvVFDedyb2nkf"},
+	}
+	for _, tt := range tests {
+		got := base58.EncodeUUID(tt.uuid)
+		testEqual(t, "EncodeUUID: got %q, want %q", tt.encoded, got)
+		testEqual(t, "EncodeUUID length: got %d, want %d", base58.UUIDEncodedLen, len(got))
+		uuid, err := base58.DecodeUUID(tt.encoded)
+		if err != nil {
+			t.Errorf("DecodeUUID(%q) failed: %v", tt.encoded, err)
+			continue
+		}
+		testEqual(t, "DecodeUUID: got %x, want %x", tt.uuid, uuid)
+	}
+}
+
+func TestDecodeUUIDErrors(t *testing.T) {
+	for _, s := range []string{"", "111111111111111111111", "YcVfxkQb6JRzqk5kF2tNLw", "zzzzzzzzzzzzzzzzzzzzzz"} {
+		if _, err := base58.DecodeUUID(s); !errors.Is(err, base58.ErrInvalidLength) {
+			t.Errorf("DecodeUUID(%q): got error %v, want %v", s, err, base58.ErrInvalidLength)
+		}
+	}
+}
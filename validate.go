@@ -0,0 +1,71 @@
+package base58
+
+import (
+	"fmt"
+)
+
+/*
+Validation Fast Path
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Validate checks characters against the reverse table without performing the
+radix conversion, and does not allocate for valid input. A nil error means
+the characters and length are valid. DecodeString can still fail on what
+Validate cannot see without the conversion: a decoded length limit and the
+validators added with WithValidator, which check the decoded payload. Any
+invalid character handler is not consulted either. An input length limit
+set with WithMaxInputLen is enforced.
+*/
+
+// report whether s is valid for enc, returning a CorruptInputError for the
+// first invalid character
+func (enc *Encoding) Validate(s string) error {
+	return validate(enc, s, -1)
+}
+
+// report whether src is valid for enc, see Validate
+func (enc *Encoding) ValidBytes(src []byte) error {
+	return validate(enc, src, -1)
+}
+
+// report whether s is valid for enc and at most maxLen characters long,
+// rejecting oversized input before scanning it
+func (enc *Encoding) ValidateLen(s string, maxLen int) error {
+	return validate(enc, s, maxLen)
+}
+
+func validate[T string | []byte](enc *Encoding, s T, maxLen int) error {
+	if maxLen >= 0 && len(s) > maxLen {
+		return fmt.Errorf("%w: got %d characters, max %d", ErrInvalidLength, len(s), maxLen)
+	}
+	if err := enc.checkInputLen(len(s)); err != nil {
+		return err
+	}
+	n, zeros := 0, 0
+	leading := true
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		val := enc.reverse[c]
+		switch {
+		case leading && enc.zero != 0 && c == enc.zero:
+			zeros++
+		case val != -1:
+			if val != 0 {
+				leading = false
+			} else if leading {
+				zeros++
+			}
+		case enc.ignored(c):
+			continue
+		default:
+			return CorruptInputError(i)
+		}
+		n++
+	}
+	if enc.padWidth > 0 {
+		return enc.checkPadCount(n, zeros > 0)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package base58_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestValidate(t *testing.T) {
+	for _, p := range pairs {
+		if err := base58.StdEncoding.Validate(p.encoded); err != nil {
+			t.Errorf("Validate(%q) failed: %v", p.encoded, err)
+		}
+		if err := base58.StdEncoding.ValidBytes([]byte(p.encoded)); err != nil {
+			t.Errorf("ValidBytes(%q) failed: %v", p.encoded, err)
+		}
+	}
+	if err := base58.StdEncoding.Validate("E2XF0Ryo"); err != base58.CorruptInputError(4) {
+		t.Errorf("Validate(%q): got %v, want %v", "E2XF0Ryo", err, base58.CorruptInputError(4))
+	}
+	if err := base58.StdEncoding.ValidateLen("E2XFRyo", 6); !errors.Is(err, base58.ErrInvalidLength) {
+		t.Errorf("ValidateLen(%q, 6): got %v, want %v", "E2XFRyo", err, base58.ErrInvalidLength)
+	}
+	if err := base58.StdEncoding.ValidateLen("E2XFRyo", 7); err != nil {
+		t.Errorf("ValidateLen(%q, 7) failed: %v", "E2XFRyo", err)
+	}
+	if err := base58.StdEncoding.WithSeparator('-', 3).Validate("E2X-FRy-o"); err != nil {
+		t.Errorf("Validate with separator failed: %v", err)
+	}
+	if err := base58.StdEncoding.WithPadWidth(8).Validate("E2XFRyo"); !errors.Is(err, base58.ErrInvalidLength) {
+		t.Errorf("Validate with pad width: got %v, want %v", err, base58.ErrInvalidLength)
+	}
+
+	s := bigtest.encoded
+	if n := testing.AllocsPerRun(100, func() { base58.StdEncoding.Validate(s) }); n != 0 {
+		t.Errorf("Validate allocates %v times, want 0", n)
+	}
+}
+
+func BenchmarkValidate(b *testing.B) {
+	s := bigtest.encoded
+	for i := 0; i < b.N; i++ {
+		base58.StdEncoding.Validate(s)
+	}
+}
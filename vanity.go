@@ -0,0 +1,154 @@
+package base58
+
+import (
+	"errors"
+	"math/big"
+)
+
+/*
+Vanity Prefixes
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+An encoded string starting with a given prefix corresponds to a set of
+intervals of the decoded number, one per possible encoded length. For a
+fixed decoded length (25 bytes for a Bitcoin address: version, hash160 and
+checksum) VanityPrefix intersects those intervals with the range allowed by
+the version bytes and the leading zero bytes implied by the prefix, giving
+the exact ranges a vanity search can target and the odds of a random hit:
+
+	v, err := base58.StdEncoding.VanityPrefix("1Love", 25, []byte{0x00})
+	fmt.Printf("1 in %.0f\n", v.Difficulty)
+
+Formatting options of the encoding are not considered.
+*/
+
+// no decoded value of the requested length and version encodes with the
+// prefix
+var ErrImpossiblePrefix = errors.New("base58: prefix cannot occur")
+
+// inclusive range of decoded values, as big-endian bytes of the decoded
+// length
+type VanityRange struct {
+	Min, Max []byte
+}
+
+// decoded values whose encoding starts with a prefix
+type VanityPrefix struct {
+	Prefix  string
+	Length  int    // decoded length in bytes
+	Version []byte // fixed leading bytes, if any
+	Ranges  []VanityRange
+
+	// chance that a uniformly random value of Length bytes starting with
+	// Version encodes with Prefix, and its inverse, the expected number
+	// of attempts to find one
+	Probability float64
+	Difficulty  float64
+}
+
+// compute the decoded values of length bytes, starting with version, whose
+// encoding starts with prefix. Returns a CorruptInputError for a prefix
+// character outside the alphabet and ErrImpossiblePrefix if no value
+// matches.
+func (enc *Encoding) VanityPrefix(prefix string, length int, version []byte) (*VanityPrefix, error) {
+	if length < 1 || len(version) > length {
+		return nil, errors.New("base58: invalid vanity length")
+	}
+	// leading zero digits stand for leading zero bytes, the rest is the
+	// top of the number
+	zeros := 0
+	for zeros < len(prefix) && prefix[zeros] == enc.zeroDigit() {
+		zeros++
+	}
+	top := new(big.Int)
+	radix := big.NewInt(int64(enc.base))
+	for i := zeros; i < len(prefix); i++ {
+		val := enc.reverse[prefix[i]]
+		if val == -1 {
+			return nil, CorruptInputError(i)
+		}
+		if i == zeros && val == 0 {
+			// only reachable with WithZeroDigit: the number never starts
+			// with a zero digit
+			return nil, ErrImpossiblePrefix
+		}
+		top.Mul(top, radix)
+		top.Add(top, big.NewInt(int64(val)))
+	}
+
+	// allowed values: the version bytes, then exactly zeros leading zero
+	// bytes, or at least zeros if nothing follows them in the prefix
+	lo, hi := new(big.Int), bytePower(length)
+	if len(version) > 0 {
+		lo.SetBytes(version)
+		lo.Mul(lo, bytePower(length-len(version)))
+		hi.Add(lo, bytePower(length-len(version)))
+	}
+	space := new(big.Int).Sub(hi, lo)
+	if zeros > length {
+		return nil, ErrImpossiblePrefix
+	}
+	hi = minBig(hi, bytePower(length-zeros))
+	if top.Sign() > 0 && zeros < length {
+		lo = maxBig(lo, bytePower(length-zeros-1))
+	}
+
+	v := &VanityPrefix{Prefix: prefix, Length: length, Version: version}
+	total := new(big.Int)
+	if top.Sign() == 0 {
+		v.addRange(lo, hi, total)
+	} else {
+		// numbers whose digits start with those of top: [top, top+1) * radix^m
+		scale := big.NewInt(1)
+		for {
+			start := new(big.Int).Mul(top, scale)
+			if start.Cmp(hi) >= 0 {
+				break
+			}
+			end := new(big.Int).Add(top, big.NewInt(1))
+			end.Mul(end, scale)
+			v.addRange(maxBig(start, lo), minBig(end, hi), total)
+			scale.Mul(scale, radix)
+		}
+	}
+	if len(v.Ranges) == 0 {
+		return nil, ErrImpossiblePrefix
+	}
+	v.Probability, _ = new(big.Rat).SetFrac(total, space).Float64()
+	v.Difficulty = 1 / v.Probability
+	return v, nil
+}
+
+// append [lo, hi) to v if it is not empty and add its size to total
+func (v *VanityPrefix) addRange(lo, hi, total *big.Int) {
+	if lo.Cmp(hi) >= 0 {
+		return
+	}
+	total.Add(total, new(big.Int).Sub(hi, lo))
+	max := new(big.Int).Sub(hi, big.NewInt(1))
+	v.Ranges = append(v.Ranges, VanityRange{
+		Min: lo.FillBytes(make([]byte, v.Length)),
+		Max: max.FillBytes(make([]byte, v.Length)),
+	})
+}
+
+// return 256^n
+func bytePower(n int) *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(8*n))
+}
+
+func minBig(a, b *big.Int) *big.Int {
+	if a.Cmp(b) < 0 {
+		return a
+	}
+	return b
+}
+
+func maxBig(a, b *big.Int) *big.Int {
+	if a.Cmp(b) > 0 {
+		return a
+	}
+	return b
+}
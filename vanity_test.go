@@ -0,0 +1,96 @@
+package base58_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+// compare the ranges with an exhaustive search over all 2-byte values
+func TestVanityPrefixExhaustive(t *testing.T) {
+	for _, tt := range []struct {
+		enc     *base58.Encoding
+		prefix  string
+		version []byte
+	}{
+		{base58.StdEncoding, "", nil},
+		{base58.StdEncoding, "1", nil},
+		{base58.StdEncoding, "11", nil},
+		{base58.StdEncoding, "2", nil},
+		{base58.StdEncoding, "z", nil},
+		{base58.StdEncoding, "Ab", nil},
+		{base58.StdEncoding, "1z", nil},
+		{base58.StdEncoding, "5", []byte{0x10}},
+		{base58.StdEncoding, "1", []byte{0x00}},
+		{base58.FlickrEncoding, "9", nil},
+		{base58.StdEncoding.WithZeroDigit('_'), "_2", nil},
+	} {
+		matches := 0
+		for x := 0; x < 1<<16; x++ {
+			b := []byte{byte(x >> 8), byte(x)}
+			if bytes.HasPrefix(b, tt.version) && strings.HasPrefix(tt.enc.EncodeToString(b), tt.prefix) {
+				matches++
+			}
+		}
+		v, err := tt.enc.VanityPrefix(tt.prefix, 2, tt.version)
+		if matches == 0 {
+			if !errors.Is(err, base58.ErrImpossiblePrefix) {
+				t.Errorf("VanityPrefix(%q, %x): got %v, want ErrImpossiblePrefix", tt.prefix, tt.version, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("VanityPrefix(%q, %x): %v", tt.prefix, tt.version, err)
+			continue
+		}
+		counted := 0
+		for _, r := range v.Ranges {
+			lo, hi := int(r.Min[0])<<8|int(r.Min[1]), int(r.Max[0])<<8|int(r.Max[1])
+			for x := lo; x <= hi; x++ {
+				s := tt.enc.EncodeToString([]byte{byte(x >> 8), byte(x)})
+				if !strings.HasPrefix(s, tt.prefix) {
+					t.Errorf("VanityPrefix(%q, %x): %04x in range encodes as %q", tt.prefix, tt.version, x, s)
+				}
+			}
+			counted += hi - lo + 1
+		}
+		if counted != matches {
+			t.Errorf("VanityPrefix(%q, %x): ranges hold %d values, want %d", tt.prefix, tt.version, counted, matches)
+		}
+		space := 1 << 16 >> (8 * len(tt.version))
+		if want := float64(matches) / float64(space); v.Probability != want {
+			t.Errorf("VanityPrefix(%q, %x): probability %v, want %v", tt.prefix, tt.version, v.Probability, want)
+		}
+	}
+}
+
+func TestVanityPrefixAddress(t *testing.T) {
+	v, err := base58.StdEncoding.VanityPrefix("1", 25, []byte{0x00})
+	if err != nil || v.Probability != 1 || len(v.Ranges) != 1 {
+		t.Errorf("VanityPrefix(1): got %+v, %v", v, err)
+	}
+	v, err = base58.StdEncoding.VanityPrefix("1Love", 25, []byte{0x00})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// about one in 58^4, skewed by the uneven top digit of 25-byte numbers
+	if v.Difficulty < 58*58*58*58/4 || v.Difficulty > 58*58*58*58*2 {
+		t.Errorf("VanityPrefix(1Love): difficulty %v", v.Difficulty)
+	}
+	for _, r := range v.Ranges {
+		for _, b := range [][]byte{r.Min, r.Max} {
+			if s := base58.StdEncoding.EncodeToString(b); !strings.HasPrefix(s, "1Love") {
+				t.Errorf("VanityPrefix(1Love): range bound encodes as %q", s)
+			}
+		}
+	}
+	if _, err := base58.StdEncoding.VanityPrefix("3", 25, []byte{0x00}); !errors.Is(err, base58.ErrImpossiblePrefix) {
+		t.Errorf("VanityPrefix(3): got %v, want ErrImpossiblePrefix", err)
+	}
+	if _, err := base58.StdEncoding.VanityPrefix("1O", 25, nil); err != base58.CorruptInputError(1) {
+		t.Errorf("VanityPrefix(1O): got %v, want CorruptInputError(1)", err)
+	}
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/cyclone-github/base58"
+)
+
+/*
+base58 WebAssembly bindings
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+Exposes the package to JavaScript as a global base58 object, so web
+frontends run exactly the same implementation and alphabets as a Go
+backend. Build with:
+
+	GOOS=js GOARCH=wasm go build -o base58.wasm ./wasm
+
+and load it with the wasm_exec.js shipped in $(go env GOROOT)/lib/wasm, or
+through the typed wrapper in base58.ts. The functions below hold the
+conversions and are shared with the syscall/js glue in main_js.go.
+*/
+
+// resolve a registered encoding name or a literal alphabet, empty for
+// bitcoin
+func lookupEncoding(name string) (enc *base58.Encoding, err error) {
+	if name == "" {
+		return base58.StdEncoding, nil
+	}
+	for _, e := range base58.Encodings() {
+		if e.Name() == name {
+			return e, nil
+		}
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			enc, err = nil, fmt.Errorf("invalid alphabet %q: %v", name, r)
+		}
+	}()
+	return base58.NewRadixEncoding(name), nil
+}
+
+// double-SHA256 checked encoding over the named alphabet
+func lookupCheckEncoding(name string) (*base58.CheckEncoding, error) {
+	enc, err := lookupEncoding(name)
+	if err != nil {
+		return nil, err
+	}
+	return base58.NewCheckEncoding(enc, base58.NewDoubleSHA256, 4), nil
+}
+
+func encode(alphabet string, data []byte) (string, error) {
+	enc, err := lookupEncoding(alphabet)
+	if err != nil {
+		return "", err
+	}
+	return enc.EncodeToString(data), nil
+}
+
+func decode(alphabet, s string) ([]byte, error) {
+	enc, err := lookupEncoding(alphabet)
+	if err != nil {
+		return nil, err
+	}
+	return enc.DecodeString(s)
+}
+
+func checkEncode(alphabet string, version, payload []byte) (string, error) {
+	ce, err := lookupCheckEncoding(alphabet)
+	if err != nil {
+		return "", err
+	}
+	return ce.EncodeVersion(version, payload), nil
+}
+
+func checkDecode(alphabet, s string, versionLen int) (version, payload []byte, err error) {
+	ce, err := lookupCheckEncoding(alphabet)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ce.DecodeVersion(s, versionLen)
+}
+
+// names of the registered encodings
+func alphabets() []string {
+	var names []string
+	for _, e := range base58.Encodings() {
+		names = append(names, e.Name())
+	}
+	return names
+}
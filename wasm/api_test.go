@@ -0,0 +1,46 @@
+package main
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	for _, alphabet := range []string{"", "bitcoin", "flickr", "0123456789abcdefghijklmnopqrstuvwxyz"} {
+		s, err := encode(alphabet, []byte("hello world"))
+		if err != nil {
+			t.Fatalf("encode(%q): %v", alphabet, err)
+		}
+		got, err := decode(alphabet, s)
+		if err != nil || string(got) != "hello world" {
+			t.Errorf("decode(%q, %q): got %q, %v", alphabet, s, got, err)
+		}
+	}
+	if s, _ := encode("", []byte("hello world")); s != "StV1DL6CwTryKyV" {
+		t.Errorf("encode: got %q", s)
+	}
+	if _, err := decode("", "0OIl"); err == nil {
+		t.Error("decode(0OIl): no error")
+	}
+	if _, err := encode("aa", nil); err == nil {
+		t.Error("encode with duplicate alphabet: no error")
+	}
+}
+
+func TestCheck(t *testing.T) {
+	s, err := checkEncode("", []byte{0}, make([]byte, 20))
+	if err != nil || s != "1111111111111111111114oLvT2" {
+		t.Fatalf("checkEncode: got %q, %v", s, err)
+	}
+	version, payload, err := checkDecode("", s, 1)
+	if err != nil || !bytes.Equal(version, []byte{0}) || !bytes.Equal(payload, make([]byte, 20)) {
+		t.Errorf("checkDecode(%q): got %x, %x, %v", s, version, payload, err)
+	}
+	if _, _, err := checkDecode("", s[:len(s)-1]+"3", 1); err == nil {
+		t.Error("checkDecode of damaged input: no error")
+	}
+	if names := alphabets(); !slices.Contains(names, "ripple") {
+		t.Errorf("alphabets: got %v", names)
+	}
+}
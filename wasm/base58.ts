@@ -0,0 +1,58 @@
+// Typed wrapper for the base58 WebAssembly bindings.
+//
+// BSD 3-Clause License, Copyright (c) 2025, cyclone
+// https://github.com/cyclone-github/base58/blob/main/LICENSE
+//
+// Load wasm_exec.js (from $(go env GOROOT)/lib/wasm) first, which defines
+// the global Go class, then:
+//
+//	const b58 = await loadBase58("base58.wasm");
+//	const s = b58.encode(new TextEncoder().encode("hello"));
+//
+// Alphabet arguments take a registered name ("bitcoin", "ripple", "flickr",
+// "gmp") or the alphabet characters, and default to bitcoin. Invalid input
+// throws an Error with the Go error message.
+
+declare const Go: { new (): { importObject: WebAssembly.Imports; run(instance: WebAssembly.Instance): Promise<void> } };
+
+// functions installed by the Go program on globalThis.base58
+interface RawBase58 {
+  encode(data: Uint8Array, alphabet?: string): string | Error;
+  decode(s: string, alphabet?: string): Uint8Array | Error;
+  checkEncode(payload: Uint8Array, version: Uint8Array, alphabet?: string): string | Error;
+  checkDecode(s: string, versionLen?: number, alphabet?: string): { version: Uint8Array; payload: Uint8Array } | Error;
+  alphabets(): string[];
+}
+
+export interface Base58 {
+  encode(data: Uint8Array, alphabet?: string): string;
+  decode(s: string, alphabet?: string): Uint8Array;
+  // Base58Check with a double-SHA256 4-byte checksum
+  checkEncode(payload: Uint8Array, version: Uint8Array, alphabet?: string): string;
+  checkDecode(s: string, versionLen?: number, alphabet?: string): { version: Uint8Array; payload: Uint8Array };
+  // names of the registered encodings
+  alphabets(): string[];
+}
+
+// throw results the bindings returned as errors
+function unwrap<T>(v: T | Error): T {
+  if (v instanceof Error) {
+    throw v;
+  }
+  return v;
+}
+
+// fetch and start the WebAssembly module at url
+export async function loadBase58(url: string | URL): Promise<Base58> {
+  const go = new Go();
+  const { instance } = await WebAssembly.instantiateStreaming(fetch(url), go.importObject);
+  void go.run(instance);
+  const raw = (globalThis as unknown as { base58: RawBase58 }).base58;
+  return {
+    encode: (data, alphabet) => unwrap(raw.encode(data, alphabet)),
+    decode: (s, alphabet) => unwrap(raw.decode(s, alphabet)),
+    checkEncode: (payload, version, alphabet) => unwrap(raw.checkEncode(payload, version, alphabet)),
+    checkDecode: (s, versionLen, alphabet) => unwrap(raw.checkDecode(s, versionLen, alphabet)),
+    alphabets: () => raw.alphabets(),
+  };
+}
@@ -0,0 +1,81 @@
+//go:build js && wasm
+
+package main
+
+import "syscall/js"
+
+// install the global base58 object and keep the program alive to serve
+// calls
+func main() {
+	js.Global().Set("base58", js.ValueOf(map[string]any{
+		"encode": js.FuncOf(func(this js.Value, args []js.Value) any {
+			s, err := encode(stringArg(args, 1), bytesArg(args, 0))
+			if err != nil {
+				return jsError(err)
+			}
+			return s
+		}),
+		"decode": js.FuncOf(func(this js.Value, args []js.Value) any {
+			b, err := decode(stringArg(args, 1), stringArg(args, 0))
+			if err != nil {
+				return jsError(err)
+			}
+			return uint8Array(b)
+		}),
+		"checkEncode": js.FuncOf(func(this js.Value, args []js.Value) any {
+			s, err := checkEncode(stringArg(args, 2), bytesArg(args, 1), bytesArg(args, 0))
+			if err != nil {
+				return jsError(err)
+			}
+			return s
+		}),
+		"checkDecode": js.FuncOf(func(this js.Value, args []js.Value) any {
+			versionLen := 1
+			if len(args) > 1 && args[1].Type() == js.TypeNumber {
+				versionLen = args[1].Int()
+			}
+			version, payload, err := checkDecode(stringArg(args, 2), stringArg(args, 0), versionLen)
+			if err != nil {
+				return jsError(err)
+			}
+			return js.ValueOf(map[string]any{"version": uint8Array(version), "payload": uint8Array(payload)})
+		}),
+		"alphabets": js.FuncOf(func(this js.Value, args []js.Value) any {
+			var names []any
+			for _, name := range alphabets() {
+				names = append(names, name)
+			}
+			return js.ValueOf(names)
+		}),
+	}))
+	select {}
+}
+
+// return argument i as a string, empty if missing or not a string
+func stringArg(args []js.Value, i int) string {
+	if i >= len(args) || args[i].Type() != js.TypeString {
+		return ""
+	}
+	return args[i].String()
+}
+
+// return argument i, a Uint8Array, as bytes, nil if missing
+func bytesArg(args []js.Value, i int) []byte {
+	if i >= len(args) || args[i].Type() != js.TypeObject {
+		return nil
+	}
+	b := make([]byte, args[i].Get("length").Int())
+	js.CopyBytesToGo(b, args[i])
+	return b
+}
+
+func uint8Array(b []byte) js.Value {
+	a := js.Global().Get("Uint8Array").New(len(b))
+	js.CopyBytesToJS(a, b)
+	return a
+}
+
+// return err as a JavaScript Error for the wrapper to throw
+func jsError(err error) js.Value {
+	return js.Global().Get("Error").New(err.Error())
+}
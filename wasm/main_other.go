@@ -0,0 +1,14 @@
+//go:build !(js && wasm)
+
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// the bindings only run in a JavaScript host
+func main() {
+	fmt.Fprintln(os.Stderr, "base58 wasm: build with GOOS=js GOARCH=wasm")
+	os.Exit(2)
+}
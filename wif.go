@@ -0,0 +1,89 @@
+package base58
+
+import (
+	"bytes"
+	"errors"
+)
+
+/*
+Wallet Import Format (WIF)
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+WIF is the Base58Check encoding of a network version byte, a 32-byte private
+key and an optional 0x01 flag byte indicating that the matching public key is
+serialized in compressed form.
+*/
+
+// WIF network version bytes
+const (
+	WIFMainnet byte = 0x80
+	WIFTestnet byte = 0xef
+)
+
+const (
+	privKeyLen         = 32
+	compressPubKeyFlag = 0x01
+)
+
+// decoded data is not a well-formed WIF private key
+var ErrMalformedPrivateKey = errors.New("base58: malformed WIF private key")
+
+// decoded WIF private key
+type WIF struct {
+	Version    byte   // network version byte
+	PrivKey    []byte // 32-byte private key
+	Compressed bool   // public key is serialized compressed
+}
+
+// return the first registered network using the WIF version byte
+func (w *WIF) Network() (*Network, bool) {
+	for _, net := range Networks() {
+		if bytes.Equal(net.PrivateKeyID, []byte{w.Version}) {
+			return net, true
+		}
+	}
+	return nil, false
+}
+
+// return the WIF string for w
+func (w *WIF) String() string {
+	s, err := EncodeWIF(w.Version, w.PrivKey, w.Compressed)
+	if err != nil {
+		return ""
+	}
+	return s
+}
+
+// encode a 32-byte private key in wallet import format
+func EncodeWIF(version byte, privKey []byte, compressed bool) (string, error) {
+	if len(privKey) != privKeyLen {
+		return "", ErrMalformedPrivateKey
+	}
+	if !compressed {
+		return CheckEncodeVersion([]byte{version}, privKey), nil
+	}
+	payload := make([]byte, 0, privKeyLen+1)
+	payload = append(payload, privKey...)
+	payload = append(payload, compressPubKeyFlag)
+	return CheckEncodeVersion([]byte{version}, payload), nil
+}
+
+// decode and checksum-verify a wallet import format private key
+func DecodeWIF(s string) (*WIF, error) {
+	version, payload, err := CheckDecodeVersion(s, 1)
+	if err != nil {
+		return nil, err
+	}
+	w := &WIF{Version: version[0]}
+	switch {
+	case len(payload) == privKeyLen:
+	case len(payload) == privKeyLen+1 && payload[privKeyLen] == compressPubKeyFlag:
+		w.Compressed = true
+	default:
+		return nil, ErrMalformedPrivateKey
+	}
+	w.PrivKey = payload[:privKeyLen]
+	return w, nil
+}
@@ -0,0 +1,54 @@
+package base58_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+func TestWIF(t *testing.T) {
+	key, _ := hex.DecodeString("0c28fca386c7a227600b2fe50b7cae11ec86d3bf1fbe471be89827e19d72aa1d")
+	tests := []struct {
+		version    byte
+		compressed bool
+		network    *base58.Network
+		encoded    string
+	}{
+		{base58.WIFMainnet, false, base58.BitcoinMainNet, "5HueCGU8rMjxEXxiPuD5BDku4MkFqeZyd4dZ1jvhTVqvbTLvyTJ"},
+		{base58.WIFMainnet, true, base58.BitcoinMainNet, "KwdMAjGmerYanjeui5SHS7JkmpZvVipYvB2LJGU1ZxJwYvP98617"},
+	}
+	for _, tt := range tests {
+		got, err := base58.EncodeWIF(tt.version, key, tt.compressed)
+		if err != nil {
+			t.Fatalf("EncodeWIF failed: %v", err)
+		}
+		testEqual(t, "EncodeWIF: got %q, want %q", tt.encoded, got)
+
+		w, err := base58.DecodeWIF(tt.encoded)
+		if err != nil {
+			t.Fatalf("DecodeWIF(%q) failed: %v", tt.encoded, err)
+		}
+		testEqual(t, "DecodeWIF key: got %x, want %x", string(key), string(w.PrivKey))
+		testEqual(t, "DecodeWIF compressed: got %v, want %v", tt.compressed, w.Compressed)
+		net, ok := w.Network()
+		if !ok || net != tt.network {
+			t.Errorf("DecodeWIF network: got %v, want %s", net, tt.network.Name)
+		}
+		testEqual(t, "WIF.String: got %q, want %q", tt.encoded, w.String())
+	}
+}
+
+func TestWIFErrors(t *testing.T) {
+	if _, err := base58.EncodeWIF(base58.WIFMainnet, make([]byte, 31), false); !errors.Is(err, base58.ErrMalformedPrivateKey) {
+		t.Errorf("EncodeWIF(31-byte key): got error %v, want %v", err, base58.ErrMalformedPrivateKey)
+	}
+	bad := base58.CheckEncodeVersion([]byte{base58.WIFMainnet}, append(make([]byte, 32), 0x02))
+	if _, err := base58.DecodeWIF(bad); !errors.Is(err, base58.ErrMalformedPrivateKey) {
+		t.Errorf("DecodeWIF(bad flag byte): got error %v, want %v", err, base58.ErrMalformedPrivateKey)
+	}
+	if _, err := base58.DecodeWIF("5HueCGU8rMjxEXxiPuD5BDku4MkFqeZyd4dZ1jvhTVqvbTLvyTK"); !errors.Is(err, base58.ErrChecksumMismatch) {
+		t.Errorf("DecodeWIF(corrupted): got error %v, want %v", err, base58.ErrChecksumMismatch)
+	}
+}
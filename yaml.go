@@ -0,0 +1,59 @@
+package base58
+
+/*
+YAML and TOML Marshaling
+
+BSD 3-Clause License, Copyright (c) 2025, cyclone
+https://github.com/cyclone-github/base58/blob/main/LICENSE
+
+TOML libraries (BurntSushi/toml, pelletier/go-toml) encode and decode
+through encoding.TextMarshaler, which Bytes and CheckBytes already
+implement. go-yaml would otherwise write a byte slice as a sequence of
+integers, so the wrapper types also implement its Marshaler and the
+func-based Unmarshaler, which gopkg.in/yaml.v2 and v3 both accept, without
+importing either. Nil slices marshal to null.
+
+	key: 2ukVBARx4fMCUZXaHR1XvNbb3HgzmGYFEEThDa86tN2q8oU
+*/
+
+// return b as a YAML string, or nil (null) if b is nil
+func (b BytesWith[S]) MarshalYAML() (interface{}, error) {
+	if b == nil {
+		return nil, nil
+	}
+	return b.String(), nil
+}
+
+// decode a YAML string into b, null sets b to nil
+func (b *BytesWith[S]) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAML(unmarshal, b)
+}
+
+// return b with its checksum as a YAML string, or nil (null) if b is nil
+func (b CheckBytesWith[S]) MarshalYAML() (interface{}, error) {
+	if b == nil {
+		return nil, nil
+	}
+	return b.String(), nil
+}
+
+// verify and decode a YAML string into b, null sets b to nil
+func (b *CheckBytesWith[S]) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAML(unmarshal, b)
+}
+
+// decode a YAML string or null into the byte slice behind u
+func unmarshalYAML[T ~[]byte, P interface {
+	*T
+	UnmarshalText([]byte) error
+}](unmarshal func(interface{}) error, u P) error {
+	var s *string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	if s == nil {
+		*u = nil
+		return nil
+	}
+	return u.UnmarshalText([]byte(*s))
+}
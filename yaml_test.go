@@ -0,0 +1,64 @@
+package base58_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cyclone-github/base58"
+)
+
+// yaml.Unmarshaler as defined by gopkg.in/yaml.v2 and accepted by v3
+type yamlUnmarshaler interface {
+	UnmarshalYAML(unmarshal func(interface{}) error) error
+}
+
+// unmarshal func as go-yaml passes it, decoding the scalar value into a
+// *string target, nil for null
+func yamlScalar(value *string) func(interface{}) error {
+	return func(v interface{}) error {
+		p, ok := v.(**string)
+		if !ok {
+			return errors.New("unexpected target type")
+		}
+		*p = value
+		return nil
+	}
+}
+
+func TestBytesYAML(t *testing.T) {
+	v, err := base58.Bytes("sure.").MarshalYAML()
+	if err != nil || v != "E2XFRyo" {
+		t.Errorf("Bytes.MarshalYAML: got %v, %v", v, err)
+	}
+	if v, err := base58.Bytes(nil).MarshalYAML(); err != nil || v != nil {
+		t.Errorf("Bytes(nil).MarshalYAML: got %v, %v", v, err)
+	}
+	v, err = base58.CheckBytes{0x00}.MarshalYAML()
+	if err != nil || v != base58.StdCheckEncoding.EncodeToString([]byte{0}) {
+		t.Errorf("CheckBytes.MarshalYAML: got %v, %v", v, err)
+	}
+
+	s := "E2XFRyo"
+	b := base58.BytesWith[base58.Bitcoin]("old")
+	var u yamlUnmarshaler = &b
+	if err := u.UnmarshalYAML(yamlScalar(&s)); err != nil || string(b) != "sure." {
+		t.Errorf("Bytes.UnmarshalYAML: got %q, %v", b, err)
+	}
+	if err := u.UnmarshalYAML(yamlScalar(nil)); err != nil || b != nil {
+		t.Errorf("Bytes.UnmarshalYAML null: got %q, %v", b, err)
+	}
+
+	var cb base58.CheckBytes
+	addr := "1BoatSLRHtKNngkdXEeobR76b53LETtpyT"
+	if err := cb.UnmarshalYAML(yamlScalar(&addr)); err != nil || len(cb) != 21 {
+		t.Errorf("CheckBytes.UnmarshalYAML: got %x, %v", cb, err)
+	}
+	bad := "1BoatSLRHtKNngkdXEeobR76b53LETtpyU"
+	if err := cb.UnmarshalYAML(yamlScalar(&bad)); !errors.Is(err, base58.ErrChecksumMismatch) {
+		t.Errorf("CheckBytes.UnmarshalYAML bad checksum: got %v", err)
+	}
+	wantErr := errors.New("yaml: cannot unmarshal !!seq into string")
+	if err := cb.UnmarshalYAML(func(interface{}) error { return wantErr }); err != wantErr {
+		t.Errorf("CheckBytes.UnmarshalYAML unmarshal error: got %v", err)
+	}
+}